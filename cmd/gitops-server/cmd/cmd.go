@@ -4,6 +4,7 @@ import (
 	"context"
 	"crypto/tls"
 	"crypto/x509"
+	"errors"
 	"fmt"
 	"io/fs"
 	"net"
@@ -11,6 +12,7 @@ import (
 	"os"
 	"os/signal"
 	"path"
+	"path/filepath"
 	"strings"
 	"syscall"
 	"time"
@@ -18,6 +20,8 @@ import (
 	"github.com/NYTimes/gziphandler"
 	"github.com/alexedwards/scs/v2"
 	"github.com/go-logr/logr"
+	"github.com/minio/minio-go/v7"
+	"github.com/minio/minio-go/v7/pkg/credentials"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
 	metrics "github.com/slok/go-http-metrics/metrics/prometheus"
@@ -25,12 +29,16 @@ import (
 	httpmiddlewarestd "github.com/slok/go-http-metrics/middleware/std"
 	"github.com/spf13/cobra"
 	"github.com/weaveworks/weave-gitops/cmd/gitops/cmderrors"
+	"github.com/weaveworks/weave-gitops/core/audit"
 	"github.com/weaveworks/weave-gitops/core/clustersmngr"
 	"github.com/weaveworks/weave-gitops/core/clustersmngr/cluster"
 	"github.com/weaveworks/weave-gitops/core/clustersmngr/fetcher"
+	"github.com/weaveworks/weave-gitops/core/freeze"
+	"github.com/weaveworks/weave-gitops/core/history"
 	"github.com/weaveworks/weave-gitops/core/logger"
 	"github.com/weaveworks/weave-gitops/core/nsaccess"
 	core "github.com/weaveworks/weave-gitops/core/server"
+	"github.com/weaveworks/weave-gitops/core/violations"
 	"github.com/weaveworks/weave-gitops/pkg/featureflags"
 	"github.com/weaveworks/weave-gitops/pkg/health"
 	"github.com/weaveworks/weave-gitops/pkg/kube"
@@ -38,6 +46,7 @@ import (
 	"github.com/weaveworks/weave-gitops/pkg/server/auth"
 	"github.com/weaveworks/weave-gitops/pkg/server/middleware"
 	"github.com/weaveworks/weave-gitops/pkg/telemetry"
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/client-go/tools/clientcmd"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/config"
@@ -48,8 +57,20 @@ const (
 	// Allowed login requests per second
 	loginRequestRateLimit            = 20
 	InsecureNoAuthenticationUserFlag = "insecure-no-authentication-user"
+	// How long the users' access to namespaces is cached when reading from
+	// the shared cache
+	rulesReviewTTL = 30 * time.Second
+
+	sessionStoreMemory = "memory"
+	sessionStoreSecret = "secret"
+	sessionStoreRedis  = "redis"
+
+	sessionRedisUsernameEnv = "WEAVE_GITOPS_SESSION_REDIS_USERNAME"
+	sessionRedisPasswordEnv = "WEAVE_GITOPS_SESSION_REDIS_PASSWORD"
 )
 
+var sessionStores = []string{sessionStoreMemory, sessionStoreSecret, sessionStoreRedis}
+
 // Options contains all the options for the gitops-server command.
 type Options struct {
 	// System config
@@ -69,8 +90,10 @@ type Options struct {
 	HelmRepoName      string
 	HelmRepoNamespace string
 	// OIDC
-	OIDC       auth.OIDCConfig
-	OIDCSecret string
+	OIDC                  auth.OIDCConfig
+	OIDCSecret            string
+	OIDCProviderSecrets   []string
+	GroupMappingConfigMap string
 	// Auth
 	NoAuthUser string
 	// Dev mode
@@ -78,8 +101,45 @@ type Options struct {
 	// Metrics
 	EnableMetrics  bool
 	MetricsAddress string
+	// Health checks
+	HealthChecksConfigMap string
+	// History
+	HistoryDBPath    string
+	HistoryRetention time.Duration
+	// Policy violations
+	PolicyViolationsDBPath    string
+	PolicyViolationsRetention time.Duration
+	// Freezes
+	FreezesConfigMap string
+	// Audit
+	AuditLogFile      string
+	AuditStdout       bool
+	AuditWebhookURL   string
+	AuditS3Endpoint   string
+	AuditS3Bucket     string
+	AuditS3Prefix     string
+	AuditS3Insecure   bool
+	AuditAdminUsers   []string
+	AuditAdminGroups  []string
+	AuditRetainEvents int
+	AuditDBPath       string
+	AuditRetention    time.Duration
+	// Access tokens
+	AccessTokensSecret string
+	// Sessions
+	SessionStore         string
+	SessionSecret        string
+	SessionRedisAddress  string
+	SessionRedisDB       int
+	SessionRedisTLS      bool
+	SessionRefreshWindow time.Duration
+	SessionAdminUsers    []string
+	SessionAdminGroups   []string
+	// Multi-cluster
+	ClustersNamespace string
 
 	UseK8sCachedClients bool
+	UseSharedCache      bool
 }
 
 var options Options
@@ -104,6 +164,7 @@ func NewCommand() *cobra.Command {
 	cmd.Flags().StringVar(&options.Port, "port", server.DefaultPort, "UI port")
 	cmd.Flags().StringSliceVar(&options.AuthMethods, "auth-methods", auth.DefaultAuthMethodStrings(), fmt.Sprintf("Which auth methods to use, valid values are %s", strings.Join(auth.AllUserAuthMethods(), ",")))
 	cmd.Flags().BoolVar(&options.UseK8sCachedClients, "use-k8s-cached-clients", false, "Enables the use of cached clients")
+	cmd.Flags().BoolVar(&options.UseSharedCache, "use-shared-cache", false, "Reads the Flux objects from informer caches kept by the server and shared by all users, showing each user only the objects their RBAC allows them to read. Takes precedence over --use-k8s-cached-clients")
 	//  TLS
 	cmd.Flags().BoolVar(&options.Insecure, "insecure", false, "do not attempt to read TLS certificates")
 	cmd.Flags().BoolVar(&options.MTLS, "mtls", false, "disable enforce mTLS")
@@ -122,6 +183,8 @@ func NewCommand() *cobra.Command {
 	// OIDC prefixes
 	cmd.Flags().StringVar(&options.OIDC.UsernamePrefix, "oidc-username-prefix", "", "Prefix to add to the username when impersonating")
 	cmd.Flags().StringVar(&options.OIDC.GroupsPrefix, "oidc-group-prefix", "", "Prefix to add to the groups when impersonating")
+	cmd.Flags().StringSliceVar(&options.OIDCProviderSecrets, "oidc-provider-secrets", nil, "Names of the secrets, with the keys of the OIDC secret, configuring the other OIDC providers users can sign in with. Their oidcUsernamePrefix and oidcGroupsPrefix, required and distinct from those of the other providers, apply to their users")
+	cmd.Flags().StringVar(&options.GroupMappingConfigMap, "group-mapping-configmap", "", fmt.Sprintf("Name of a ConfigMap in the server's namespace mapping the groups of the users before they're impersonated, under the %q key", auth.GroupMappingKey))
 	// auth
 	cmd.Flags().StringVar(&options.NoAuthUser, InsecureNoAuthenticationUserFlag, "", "A kubernetes user to impersonate for all requests, no authentication will be performed")
 
@@ -129,6 +192,50 @@ func NewCommand() *cobra.Command {
 	cmd.Flags().BoolVar(&options.EnableMetrics, "enable-metrics", false, "Starts the metrics listener")
 	cmd.Flags().StringVar(&options.MetricsAddress, "metrics-address", ":2112", "If the metrics listener is enabled, bind to this address")
 
+	// Health checks
+	cmd.Flags().StringVar(&options.HealthChecksConfigMap, "health-checks-configmap", "", fmt.Sprintf("Name of a ConfigMap in the server's namespace with custom health checks for arbitrary kinds under the %q key", health.CustomHealthChecksKey))
+
+	// History
+	cmd.Flags().StringVar(&options.HistoryDBPath, "history-db-path", "", "Path of the database recording the timeline of Flux objects, object history is disabled if empty. The server fails to start if it can't be opened")
+	cmd.Flags().DurationVar(&options.HistoryRetention, "history-retention", 90*24*time.Hour, "How long the events of the timeline of Flux objects are kept for, forever if zero")
+
+	// Policy violations
+	cmd.Flags().StringVar(&options.PolicyViolationsDBPath, "policy-violations-db-path", filepath.Join(os.TempDir(), "weave-gitops-policy-violations.db"), "Path of the database keeping the policy violations reported by the policy agent, the policy validations summary is disabled if empty. The server fails to start if it's set and can't be opened")
+	cmd.Flags().DurationVar(&options.PolicyViolationsRetention, "policy-violations-retention", 90*24*time.Hour, "How long the policy violations are kept for, forever if zero")
+
+	// Freezes
+	cmd.Flags().StringVar(&options.FreezesConfigMap, "freezes-configmap", freeze.DefaultConfigMapName, "Name of the ConfigMap in the server's namespace the change freezes are kept in, change freezes are disabled if empty")
+
+	// Audit
+	cmd.Flags().StringVar(&options.AuditLogFile, "audit-log-file", "", "Path of a file the audit events are appended to as JSON lines")
+	cmd.Flags().BoolVar(&options.AuditStdout, "audit-stdout", false, "Write the audit events to stdout as JSON lines")
+	cmd.Flags().StringVar(&options.AuditWebhookURL, "audit-webhook-url", "", "URL the audit events are posted to as JSON")
+	cmd.Flags().StringVar(&options.AuditS3Endpoint, "audit-s3-endpoint", "", "Endpoint of the S3 compatible storage the audit events are uploaded to, with the credentials of the AWS_ACCESS_KEY_ID and AWS_SECRET_ACCESS_KEY environment variables")
+	cmd.Flags().StringVar(&options.AuditS3Bucket, "audit-s3-bucket", "", "Bucket the audit events are uploaded to")
+	cmd.Flags().StringVar(&options.AuditS3Prefix, "audit-s3-prefix", "", "Prefix of the names of the audit events uploaded")
+	cmd.Flags().BoolVar(&options.AuditS3Insecure, "audit-s3-insecure", false, "Upload the audit events over plain HTTP")
+	cmd.Flags().StringSliceVar(&options.AuditAdminUsers, "audit-admin-users", nil, "Users allowed to list the audit events, with the username prefix of their OIDC provider if it has its own")
+	cmd.Flags().StringSliceVar(&options.AuditAdminGroups, "audit-admin-groups", nil, "Groups whose members are allowed to list the audit events, with the groups prefix of their OIDC provider if it has its own")
+	cmd.Flags().IntVar(&options.AuditRetainEvents, "audit-retain-events", audit.DefaultRetained, "How many of the recent audit events are kept in memory to be listed, when there's no audit database")
+	cmd.Flags().StringVar(&options.AuditDBPath, "audit-db-path", "", "Path of a database the audit events are kept in to be listed, only the recent events kept in memory are listed if empty. Each replica keeps the events it handled, use the other audit sinks for a complete audit log")
+	cmd.Flags().DurationVar(&options.AuditRetention, "audit-retention", 90*24*time.Hour, "How long the audit events are kept for in the audit database, forever if zero")
+
+	// Access tokens
+	cmd.Flags().StringVar(&options.AccessTokensSecret, "access-tokens-secret", auth.DefaultAccessTokensSecretName, "Name of the Secret in the server's namespace the personal access tokens are kept in, access tokens are disabled if empty")
+
+	// Sessions
+	cmd.Flags().StringVar(&options.SessionStore, "session-store", sessionStoreMemory, fmt.Sprintf("Where the sessions are kept, one of %s. Sessions kept in memory are lost when the server restarts and aren't shared by its replicas", strings.Join(sessionStores, ",")))
+	cmd.Flags().StringVar(&options.SessionSecret, "session-secret-name", auth.DefaultSessionsSecretName, "Name of the Secret in the server's namespace the sessions are kept in by the secret session store")
+	cmd.Flags().StringVar(&options.SessionRedisAddress, "session-redis-address", "", fmt.Sprintf("host:port of the Redis server the sessions are kept in by the redis session store, authenticating with the %s and %s environment variables if set", sessionRedisUsernameEnv, sessionRedisPasswordEnv))
+	cmd.Flags().IntVar(&options.SessionRedisDB, "session-redis-db", 0, "Redis database the sessions are kept in")
+	cmd.Flags().BoolVar(&options.SessionRedisTLS, "session-redis-tls", false, "Connect to Redis with TLS")
+	cmd.Flags().DurationVar(&options.SessionRefreshWindow, "session-refresh-window", auth.DefaultSessionRefreshWindow, "How long before the OIDC ID token expires the session is refreshed with the refresh token")
+	cmd.Flags().StringSliceVar(&options.SessionAdminUsers, "session-admin-users", nil, "Users allowed to list and revoke the sessions of the other users, with the username prefix of their OIDC provider if it has its own")
+	cmd.Flags().StringSliceVar(&options.SessionAdminGroups, "session-admin-groups", nil, "Groups whose members are allowed to list and revoke the sessions of the other users, with the groups prefix of their OIDC provider if it has its own")
+
+	// Multi-cluster
+	cmd.Flags().StringVar(&options.ClustersNamespace, "clusters-namespace", "", fmt.Sprintf("Namespace of the secrets labelled %s, and of the Cluster API clusters, whose kubeconfigs are used to add clusters to the dashboard. Only the server's cluster is shown if empty", fetcher.KubeconfigSecretLabel))
+
 	return cmd
 }
 
@@ -184,16 +291,47 @@ func runCmd(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("couldn't get current namespace")
 	}
 
+	auditRecorder, auditEvents, closeAudit, err := newAuditRecorder(log, options)
+	if err != nil {
+		return err
+	}
+	defer closeAudit()
+
+	var accessTokens auth.AccessTokenStore
+	if options.AccessTokensSecret != "" {
+		accessTokens = auth.NewSecretAccessTokenStore(rawClient, namespace, options.AccessTokensSecret)
+	}
+
+	sessionStore, err := newSessionStore(rawClient, namespace, options)
+	if err != nil {
+		return err
+	}
+
+	groupMapping, err := newGroupMapping(cmd.Context(), rawClient, namespace, options.GroupMappingConfigMap)
+	if err != nil {
+		return err
+	}
+
 	sessionManager := scs.New()
 	// TODO: Make this configurable
 	sessionManager.Lifetime = 24 * time.Hour
+
+	if sessionStore != nil {
+		sessionManager.Store = sessionStore
+	}
+
 	authServer, err := auth.InitAuthServer(cmd.Context(), log, rawClient, auth.AuthParams{
-		OIDCConfig:        options.OIDC,
-		OIDCSecretName:    options.OIDCSecret,
-		AuthMethodStrings: options.AuthMethods,
-		NoAuthUser:        options.NoAuthUser,
-		Namespace:         namespace,
-		SessionManager:    sessionManager,
+		OIDCConfig:              options.OIDC,
+		OIDCSecretName:          options.OIDCSecret,
+		AuthMethodStrings:       options.AuthMethods,
+		NoAuthUser:              options.NoAuthUser,
+		Namespace:               namespace,
+		SessionManager:          sessionManager,
+		AuditRecorder:           auditRecorder,
+		AccessTokens:            accessTokens,
+		SessionRefreshWindow:    options.SessionRefreshWindow,
+		OIDCProviderSecretNames: options.OIDCProviderSecrets,
+		GroupMapping:            groupMapping,
 	})
 	if err != nil {
 		return fmt.Errorf("could not initialise authentication server: %w", err)
@@ -207,19 +345,10 @@ func runCmd(cmd *cobra.Command, args []string) error {
 
 	ctx := context.Background()
 
+	// The auth server resolved the prefixes of the CLI and of the secret.
 	oidcPrefixes := kube.UserPrefixes{
-		UsernamePrefix: options.OIDC.UsernamePrefix,
-		GroupsPrefix:   options.OIDC.GroupsPrefix,
-	}
-
-	// Incorporate values from authServer.AuthConfig.OIDCConfig
-	if authServer.OIDCConfig.UsernamePrefix != "" {
-		log.V(logger.LogLevelWarn).Info("OIDC username prefix configured by both CLI and secret. Secret values will take precedence.")
-		oidcPrefixes.UsernamePrefix = authServer.OIDCConfig.UsernamePrefix
-	}
-	if authServer.OIDCConfig.GroupsPrefix != "" {
-		log.V(logger.LogLevelWarn).Info("OIDC groups prefix configured by both CLI and secret. Secret values will take precedence.")
-		oidcPrefixes.GroupsPrefix = authServer.OIDCConfig.GroupsPrefix
+		UsernamePrefix: authServer.OIDCConfig.UsernamePrefix,
+		GroupsPrefix:   authServer.OIDCConfig.GroupsPrefix,
 	}
 
 	cl, err := cluster.NewSingleCluster(cluster.DefaultCluster, rest, scheme, oidcPrefixes, cluster.DefaultKubeConfigOptions...)
@@ -237,23 +366,112 @@ func runCmd(cmd *cobra.Command, args []string) error {
 	}
 
 	log.Info("Using cached clients", "enabled", options.UseK8sCachedClients)
+	log.Info("Using shared cache", "enabled", options.UseSharedCache)
+
+	rulesReviewer := nsaccess.NewRulesReviewer(rulesReviewTTL)
 
-	if options.UseK8sCachedClients {
+	if options.UseSharedCache {
+		cl = cluster.NewSharedCacheCluster(cl, scheme, cluster.FluxKinds, rulesReviewer)
+	} else if options.UseK8sCachedClients {
 		cl = cluster.NewDelegatingCacheCluster(cl, rest, scheme)
 	}
 
-	fetcher := fetcher.NewSingleClusterFetcher(cl)
+	fetchers := []clustersmngr.ClusterFetcher{fetcher.NewSingleClusterFetcher(cl)}
+
+	var secretsFetcher *fetcher.SecretsClusterFetcher
+
+	if options.ClustersNamespace != "" {
+		watchClient, err := client.NewWithWatch(rest, client.Options{
+			Scheme: scheme,
+		})
+		if err != nil {
+			return fmt.Errorf("could not create kube watch client: %w", err)
+		}
 
-	clustersManager := clustersmngr.NewClustersManager([]clustersmngr.ClusterFetcher{fetcher}, nsaccess.NewChecker(nsaccess.DefautltWegoAppRules), log)
+		secretsFetcher = fetcher.NewSecretsClusterFetcher(log, watchClient, fetcher.SecretsFetcherConfig{
+			Namespace:         options.ClustersNamespace,
+			Scheme:            scheme,
+			UserPrefixes:      oidcPrefixes,
+			KubeConfigOptions: cluster.DefaultKubeConfigOptions,
+			UseCachedClients:  options.UseK8sCachedClients,
+			UseSharedCache:    options.UseSharedCache,
+			RulesReviewer:     rulesReviewer,
+		})
+
+		fetchers = append(fetchers, secretsFetcher)
+	}
+
+	clustersManager := clustersmngr.NewClustersManager(fetchers, nsaccess.NewChecker(nsaccess.DefautltWegoAppRules), log)
 	clustersManager.Start(ctx)
 
-	healthChecker := health.NewHealthChecker()
+	if secretsFetcher != nil {
+		secretsFetcher.Start(ctx, clustersManager)
+	}
+
+	healthChecker, err := newHealthChecker(ctx, rawClient, namespace, options.HealthChecksConfigMap)
+	if err != nil {
+		return err
+	}
 
 	coreConfig, err := core.NewCoreConfig(log, rest, clusterName, clustersManager, healthChecker)
 	if err != nil {
 		return fmt.Errorf("could not create core config: %w", err)
 	}
 
+	coreConfig.RulesReviewer = rulesReviewer
+	coreConfig.AuditRecorder = auditRecorder
+	coreConfig.AuditEvents = auditEvents
+	coreConfig.AuditAdmins = audit.Admins{Users: options.AuditAdminUsers, Groups: options.AuditAdminGroups}
+	coreConfig.AccessTokens = accessTokens
+	coreConfig.Sessions = authServer
+	coreConfig.SessionAdmins = audit.Admins{Users: options.SessionAdminUsers, Groups: options.SessionAdminGroups}
+
+	if options.HistoryDBPath != "" {
+		store, err := history.NewBoltStore(options.HistoryDBPath)
+		if err != nil {
+			return fmt.Errorf("could not enable object history: %w", err)
+		}
+		defer store.Close()
+
+		recorder := history.NewRecorder(log, store, clustersManager, history.DefaultKinds, options.HistoryRetention)
+		recorder.Start(ctx)
+		// the recorder stops writing before the store is closed
+		defer recorder.Stop()
+
+		coreConfig.HistoryRecorder = recorder
+	}
+
+	if options.PolicyViolationsDBPath != "" {
+		store, err := violations.NewBoltStore(options.PolicyViolationsDBPath)
+		if err != nil && cmd.Flags().Changed("policy-violations-db-path") {
+			return fmt.Errorf("could not enable the policy validations summary: %w", err)
+		}
+
+		if err != nil {
+			log.Error(err, "Couldn't enable the policy validations summary")
+		} else {
+			defer store.Close()
+
+			ingester := violations.NewIngester(log, store, clustersManager, options.PolicyViolationsRetention)
+			ingester.Start(ctx)
+			// the ingester stops writing before the store is closed
+			defer ingester.Stop()
+
+			coreConfig.PolicyViolations = store
+		}
+	}
+
+	if options.FreezesConfigMap != "" {
+		coreConfig.FreezeStore = freeze.NewConfigMapStore(rawClient, namespace, options.FreezesConfigMap)
+
+		freezeController, err := core.NewFreezeController(coreConfig)
+		if err != nil {
+			return fmt.Errorf("could not create freeze controller: %w", err)
+		}
+
+		freezeController.Start(ctx)
+	}
+
 	appAndProfilesHandlers, err := server.NewHandlers(ctx, log,
 		&server.Config{
 			CoreServerConfig: coreConfig,
@@ -265,7 +483,7 @@ func runCmd(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("could not create handler: %w", err)
 	}
 
-	mux.Handle("/v1/", gziphandler.GzipHandler(appAndProfilesHandlers))
+	mux.Handle("/v1/", server.WithCompression(appAndProfilesHandlers))
 
 	// Static asset handling
 	assetFS := getAssets()
@@ -289,7 +507,7 @@ func runCmd(cmd *cobra.Command, args []string) error {
 
 	handler = middleware.WithLogging(log, handler)
 
-	handler = sessionManager.LoadAndSave(handler)
+	handler = server.WithSessions(sessionManager, handler)
 
 	addr := net.JoinHostPort(options.Host, options.Port)
 	srv := &http.Server{
@@ -314,6 +532,7 @@ func runCmd(cmd *cobra.Command, args []string) error {
 			prometheus.DefaultGatherer,
 			k8sMetrics.Registry,
 			clustersmngr.Registry,
+			audit.Registry,
 		}
 		metricsMux.Handle("/metrics", promhttp.HandlerFor(gatherers, promhttp.HandlerOpts{}))
 
@@ -353,6 +572,152 @@ func runCmd(cmd *cobra.Command, args []string) error {
 	return nil
 }
 
+// newHealthChecker returns a health checker with the custom health checks
+// from the named ConfigMap, or only the built-in checks if no name is given.
+func newHealthChecker(ctx context.Context, c client.Client, namespace, configMapName string) (health.HealthChecker, error) {
+	if configMapName == "" {
+		return health.NewHealthChecker(), nil
+	}
+
+	cm := &corev1.ConfigMap{}
+	if err := c.Get(ctx, client.ObjectKey{Namespace: namespace, Name: configMapName}, cm); err != nil {
+		return nil, fmt.Errorf("could not get health checks configmap: %w", err)
+	}
+
+	checks, err := health.ParseCustomHealthChecks([]byte(cm.Data[health.CustomHealthChecksKey]))
+	if err != nil {
+		return nil, err
+	}
+
+	healthChecker, err := health.NewHealthCheckerWithCustomChecks(checks)
+	if err != nil {
+		return nil, fmt.Errorf("could not load custom health checks: %w", err)
+	}
+
+	return healthChecker, nil
+}
+
+// newGroupMapping returns the group mapping of the ConfigMap, or nil if
+// there isn't one.
+func newGroupMapping(ctx context.Context, c client.Client, namespace, configMapName string) (*auth.GroupMapping, error) {
+	if configMapName == "" {
+		return nil, nil
+	}
+
+	cm := &corev1.ConfigMap{}
+	if err := c.Get(ctx, client.ObjectKey{Namespace: namespace, Name: configMapName}, cm); err != nil {
+		return nil, fmt.Errorf("could not get group mapping configmap: %w", err)
+	}
+
+	return auth.ParseGroupMapping([]byte(cm.Data[auth.GroupMappingKey]))
+}
+
+// newSessionStore returns the store the sessions are kept in, or nil to keep
+// them in memory.
+func newSessionStore(c client.Client, namespace string, options Options) (scs.Store, error) {
+	switch options.SessionStore {
+	case sessionStoreMemory:
+		return nil, nil
+	case sessionStoreSecret:
+		return auth.NewSecretSessionStore(c, namespace, options.SessionSecret), nil
+	case sessionStoreRedis:
+		if options.SessionRedisAddress == "" {
+			return nil, errors.New("--session-redis-address is required by the redis session store")
+		}
+
+		redis := auth.RedisOptions{
+			Address:  options.SessionRedisAddress,
+			Username: os.Getenv(sessionRedisUsernameEnv),
+			Password: os.Getenv(sessionRedisPasswordEnv),
+			DB:       options.SessionRedisDB,
+		}
+
+		if options.SessionRedisTLS {
+			redis.TLS = &tls.Config{MinVersion: tls.VersionTLS12}
+		}
+
+		return auth.NewRedisSessionStore(redis), nil
+	default:
+		return nil, fmt.Errorf("unknown session store %q, valid values are %s", options.SessionStore, strings.Join(sessionStores, ","))
+	}
+}
+
+// newAuditRecorder returns an audit recorder writing to the sinks configured,
+// the store the events are listed from if there's an audit database, and a
+// function closing them.
+func newAuditRecorder(log logr.Logger, options Options) (*audit.Recorder, audit.Store, func(), error) {
+	var (
+		sinks   []audit.Sink
+		closers []func() error
+		store   audit.Store
+	)
+
+	closeSinks := func() {
+		for _, c := range closers {
+			if err := c(); err != nil {
+				log.Error(err, "failed closing audit sink")
+			}
+		}
+	}
+
+	if options.AuditLogFile != "" {
+		sink, err := audit.NewFileSink(options.AuditLogFile)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+
+		sinks = append(sinks, sink)
+		closers = append(closers, sink.Close)
+	}
+
+	if options.AuditDBPath != "" {
+		sink, err := audit.NewBoltSink(options.AuditDBPath, options.AuditRetention)
+		if err != nil {
+			closeSinks()
+			return nil, nil, nil, err
+		}
+
+		sinks = append(sinks, sink)
+		closers = append(closers, sink.Close)
+		store = sink
+	}
+
+	if options.AuditStdout {
+		sinks = append(sinks, audit.NewWriterSink(os.Stdout))
+	}
+
+	if options.AuditWebhookURL != "" {
+		sinks = append(sinks, audit.NewWebhookSink(options.AuditWebhookURL, nil))
+	}
+
+	if options.AuditS3Endpoint != "" {
+		if options.AuditS3Bucket == "" {
+			closeSinks()
+			return nil, nil, nil, errors.New("--audit-s3-bucket is required to upload the audit events")
+		}
+
+		minioClient, err := minio.New(options.AuditS3Endpoint, &minio.Options{
+			Creds:        credentials.NewEnvAWS(),
+			Secure:       !options.AuditS3Insecure,
+			BucketLookup: minio.BucketLookupPath,
+		})
+		if err != nil {
+			closeSinks()
+			return nil, nil, nil, fmt.Errorf("could not create audit S3 client: %w", err)
+		}
+
+		sinks = append(sinks, audit.NewS3Sink(minioClient, options.AuditS3Bucket, options.AuditS3Prefix))
+	}
+
+	recorder := audit.NewRecorder(log, options.AuditRetainEvents, sinks...)
+
+	// the queued events are written before the sinks are closed
+	return recorder, store, func() {
+		recorder.Close()
+		closeSinks()
+	}, nil
+}
+
 func listenAndServe(log logr.Logger, srv *http.Server, options Options) error {
 	if options.Insecure {
 		log.Info("TLS connections disabled")
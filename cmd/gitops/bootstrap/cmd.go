@@ -0,0 +1,427 @@
+package bootstrap
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/go-git/go-git/v5/plumbing/transport"
+	"github.com/go-git/go-git/v5/plumbing/transport/http"
+	"github.com/go-git/go-git/v5/plumbing/transport/ssh"
+	"github.com/spf13/cobra"
+	"github.com/weaveworks/weave-gitops/cmd/gitops/config"
+	"github.com/weaveworks/weave-gitops/pkg/fluxexec"
+	"github.com/weaveworks/weave-gitops/pkg/fluxinstall"
+	"github.com/weaveworks/weave-gitops/pkg/git"
+	"github.com/weaveworks/weave-gitops/pkg/git/wrapper"
+	"github.com/weaveworks/weave-gitops/pkg/logger"
+	"github.com/weaveworks/weave-gitops/pkg/run"
+	"github.com/weaveworks/weave-gitops/pkg/run/install"
+	"github.com/weaveworks/weave-gitops/pkg/version"
+)
+
+const (
+	providerGitHub          = "github"
+	providerGitLab          = "gitlab"
+	providerBitbucketServer = "bitbucket-server"
+	providerGit             = "git"
+)
+
+// dashboardFile is the file the dashboard manifests are committed to, in the
+// path of the cluster.
+const dashboardFile = "weave-gitops-dashboard.yaml"
+
+// tokenEnvVars are the environment variables flux reads the tokens of the
+// providers from.
+var tokenEnvVars = map[string]string{
+	providerGitHub:          "GITHUB_TOKEN",
+	providerGitLab:          "GITLAB_TOKEN",
+	providerBitbucketServer: "BITBUCKET_TOKEN",
+}
+
+type bootstrapFlags struct {
+	provider       string
+	owner          string
+	repository     string
+	hostname       string
+	personal       bool
+	private        bool
+	url            string
+	branch         string
+	path           string
+	tokenAuth      bool
+	readWriteKey   bool
+	gitUsername    string
+	gitPassword    string
+	privateKeyFile string
+	authorName     string
+	authorEmail    string
+	fluxVersion    string
+	timeout        time.Duration
+
+	dashboard         bool
+	dashboardName     string
+	dashboardUsername string
+	dashboardPassword string
+	dashboardValues   []string
+}
+
+func Command(opts *config.Options) *cobra.Command {
+	flags := bootstrapFlags{}
+
+	kubeConfigArgs := run.GetKubeConfigArgs()
+
+	cmd := &cobra.Command{
+		Use:   "bootstrap",
+		Short: "Bootstrap Flux, and optionally the GitOps Dashboard, on a cluster from a git repository",
+		Long: `Install Flux on the current cluster and have it sync from a git repository,
+which is created on GitHub, GitLab and Bitbucket Server when it doesn't exist.
+The Flux CLI of --flux-version is downloaded, checksum verified and cached
+when it isn't cached already, and runs 'flux bootstrap' with the provider.
+
+The cluster authenticates to the repository with a deploy key, unless
+--token-auth is set. The tokens of the providers are read from the
+GITHUB_TOKEN, GITLAB_TOKEN and BITBUCKET_TOKEN environment variables. A plain
+git repository is authenticated to with --private-key-file over SSH, or
+--git-password over HTTPS.
+
+With --dashboard, the HelmRepository and HelmRelease of the GitOps Dashboard
+are committed to the path of the cluster in the repository, for Flux to
+deploy them.`,
+		Example: `
+# Bootstrap a cluster from a GitHub repository, with the dashboard whose admin
+# password is prompted for
+export GITHUB_TOKEN=<token>
+gitops bootstrap --owner=my-org --repository=fleet --path=clusters/my-cluster \
+  --dashboard
+
+# Bootstrap a cluster from a GitLab repository of a user, with token auth
+export GITLAB_TOKEN=<token>
+gitops bootstrap --provider=gitlab --owner=my-user --personal \
+  --repository=fleet --path=clusters/my-cluster --token-auth
+
+# Bootstrap a cluster from a plain git repository over SSH
+gitops bootstrap --provider=git --url=ssh://git@example.com/fleet.git \
+  --private-key-file=./identity --path=clusters/my-cluster
+`,
+		Args:          cobra.NoArgs,
+		SilenceUsage:  true,
+		SilenceErrors: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := flags.validate(); err != nil {
+				return err
+			}
+
+			namespace, err := cmd.Flags().GetString("namespace")
+			if err != nil {
+				return err
+			}
+
+			log := logger.NewCLILogger(os.Stdout)
+
+			ctx, cancel := context.WithTimeout(cmd.Context(), flags.timeout)
+			defer cancel()
+
+			// Ask for the password before the long-running bootstrap.
+			var passwordHash string
+
+			if flags.dashboard {
+				password := flags.dashboardPassword
+				if password == "" {
+					if password, err = install.ReadPassword(log); err != nil {
+						return err
+					}
+				}
+
+				if passwordHash, err = install.GeneratePasswordHash(log, password); err != nil {
+					return err
+				}
+			}
+
+			log.Actionf("Ensuring Flux %s ...", flags.fluxVersion)
+
+			execPath, err := fluxinstall.NewInstaller().Ensure(ctx, fluxinstall.NewProduct(flags.fluxVersion))
+			if err != nil {
+				return fmt.Errorf("failed getting the Flux CLI: %w", err)
+			}
+
+			workingDir, err := os.Getwd()
+			if err != nil {
+				return err
+			}
+
+			flux, err := fluxexec.NewFlux(workingDir, execPath)
+			if err != nil {
+				return err
+			}
+
+			flux.SetLogger(log.L())
+
+			globalOpts := []fluxexec.GlobalOption{
+				fluxexec.Namespace(namespace),
+				fluxexec.Timeout(flags.timeout),
+			}
+
+			if opts.Kubeconfig != "" {
+				globalOpts = append(globalOpts, fluxexec.Kubeconfig(opts.Kubeconfig))
+			}
+
+			if *kubeConfigArgs.Context != "" {
+				globalOpts = append(globalOpts, fluxexec.KubeContext(*kubeConfigArgs.Context))
+			}
+
+			log.Actionf("Bootstrapping Flux with %s ...", flags.provider)
+
+			if err := flags.bootstrap(ctx, flux, globalOpts); err != nil {
+				return fmt.Errorf("flux bootstrap failed: %w", err)
+			}
+
+			log.Successf("Bootstrapped Flux")
+
+			if !flags.dashboard {
+				return nil
+			}
+
+			dashboardObjects, err := install.CreateDashboardObjects(log, flags.dashboardName, namespace, flags.dashboardUsername, passwordHash, "", "", flags.dashboardValues)
+			if err != nil {
+				return fmt.Errorf("error creating dashboard objects: %w", err)
+			}
+
+			if err := flags.commitDashboard(ctx, log, dashboardObjects.Manifests); err != nil {
+				return fmt.Errorf("failed committing the dashboard: %w", err)
+			}
+
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&flags.provider, "provider", providerGitHub, "The git provider of the repository, one of github, gitlab, bitbucket-server or git")
+	cmd.Flags().StringVar(&flags.owner, "owner", "", "The user or organization of the repository, for github, gitlab and bitbucket-server")
+	cmd.Flags().StringVar(&flags.repository, "repository", "", "The name of the repository, for github, gitlab and bitbucket-server")
+	cmd.Flags().StringVar(&flags.hostname, "hostname", "", "The hostname of the provider, github.com and gitlab.com by default, required for bitbucket-server")
+	cmd.Flags().BoolVar(&flags.personal, "personal", false, "The owner is a user rather than an organization")
+	cmd.Flags().BoolVar(&flags.private, "private", true, "Whether the repository is private, when it's created")
+	cmd.Flags().StringVar(&flags.url, "url", "", "The URL of the repository, for git")
+	cmd.Flags().StringVar(&flags.branch, "branch", "main", "The branch of the repository")
+	cmd.Flags().StringVar(&flags.path, "path", "", "The path of the cluster in the repository, the root of the repository if not set")
+	cmd.Flags().BoolVar(&flags.tokenAuth, "token-auth", false, "Authenticate the cluster to the repository with the token of the provider over HTTPS, instead of a deploy key")
+	cmd.Flags().BoolVar(&flags.readWriteKey, "read-write-key", false, "Give the deploy key write access to the repository")
+	cmd.Flags().StringVar(&flags.gitUsername, "git-username", "git", "The username to authenticate to the repository with, for git and bitbucket-server")
+	cmd.Flags().StringVar(&flags.gitPassword, "git-password", "", "The password to authenticate to the repository with over HTTPS, for git")
+	cmd.Flags().StringVar(&flags.privateKeyFile, "private-key-file", "", "The SSH private key to authenticate to the repository with, for git")
+	cmd.Flags().StringVar(&flags.authorName, "author-name", "Flux", "The author of the commits")
+	cmd.Flags().StringVar(&flags.authorEmail, "author-email", "", "The email of the author of the commits")
+	cmd.Flags().StringVar(&flags.fluxVersion, "flux-version", version.FluxVersion, "The version of Flux to bootstrap")
+	cmd.Flags().DurationVar(&flags.timeout, "timeout", 10*time.Minute, "The timeout of the bootstrap")
+
+	cmd.Flags().BoolVar(&flags.dashboard, "dashboard", false, "Commit the GitOps Dashboard to the path of the cluster in the repository")
+	cmd.Flags().StringVar(&flags.dashboardName, "dashboard-name", "ww-gitops", "The name of the HelmRepository and HelmRelease of the dashboard")
+	cmd.Flags().StringVar(&flags.dashboardUsername, "dashboard-username", "admin", "The username of the dashboard admin user")
+	cmd.Flags().StringVar(&flags.dashboardPassword, "dashboard-password", "", "The password of the dashboard admin user, prompted for if not set. Prefer the prompt, the flags end up in the shell history")
+	cmd.Flags().StringSliceVar(&flags.dashboardValues, "dashboard-values", nil, "Local paths to values.yaml files for the HelmRelease of the dashboard")
+
+	kubeConfigArgs.AddFlags(cmd.Flags())
+
+	return cmd
+}
+
+func (f *bootstrapFlags) validate() error {
+	f.fluxVersion = strings.TrimPrefix(f.fluxVersion, "v")
+	if f.fluxVersion == "" || f.fluxVersion == "latest" {
+		return errors.New("the version of Flux isn't known in this build of gitops, set it with --flux-version")
+	}
+
+	switch f.provider {
+	case providerGitHub, providerGitLab, providerBitbucketServer:
+		if f.owner == "" || f.repository == "" {
+			return fmt.Errorf("--owner and --repository are required with %s", f.provider)
+		}
+
+		if f.provider == providerBitbucketServer && f.hostname == "" {
+			return fmt.Errorf("--hostname is required with %s", f.provider)
+		}
+
+		if os.Getenv(tokenEnvVars[f.provider]) == "" {
+			return fmt.Errorf("%s must be set to bootstrap with %s", tokenEnvVars[f.provider], f.provider)
+		}
+	case providerGit:
+		if f.url == "" {
+			return fmt.Errorf("--url is required with %s", f.provider)
+		}
+
+		// flux would prompt for the deploy key to be added otherwise.
+		if (f.privateKeyFile == "") == (f.gitPassword == "") {
+			return fmt.Errorf("one of --private-key-file or --git-password is required with %s", f.provider)
+		}
+	default:
+		return fmt.Errorf("unknown provider %q, valid providers are github, gitlab, bitbucket-server and git", f.provider)
+	}
+
+	return nil
+}
+
+// bootstrap runs the flux bootstrap command of the provider.
+func (f *bootstrapFlags) bootstrap(ctx context.Context, flux *fluxexec.Flux, globalOpts []fluxexec.GlobalOption) error {
+	bootstrapOpts := []fluxexec.BootstrapOption{
+		fluxexec.Branch(f.branch),
+		fluxexec.AuthorName(f.authorName),
+		fluxexec.TokenAuth(f.tokenAuth),
+	}
+
+	if f.authorEmail != "" {
+		bootstrapOpts = append(bootstrapOpts, fluxexec.AuthorEmail(f.authorEmail))
+	}
+
+	if f.privateKeyFile != "" {
+		bootstrapOpts = append(bootstrapOpts, fluxexec.PrivateKeyFile(f.privateKeyFile))
+	}
+
+	switch f.provider {
+	case providerGitHub:
+		opts := []fluxexec.BootstrapGitHubOption{
+			fluxexec.WithGlobalOptions(globalOpts...),
+			fluxexec.WithBootstrapOptions(bootstrapOpts...),
+			fluxexec.Owner(f.owner),
+			fluxexec.Repository(f.repository),
+			fluxexec.Path(f.path),
+			fluxexec.Personal(f.personal),
+			fluxexec.Private(f.private),
+			fluxexec.ReadWriteKey(f.readWriteKey),
+		}
+
+		if f.hostname != "" {
+			opts = append(opts, fluxexec.Hostname(f.hostname))
+		}
+
+		return flux.BootstrapGitHub(ctx, opts...)
+	case providerGitLab:
+		opts := []fluxexec.BootstrapGitLabOption{
+			fluxexec.WithGlobalOptions(globalOpts...),
+			fluxexec.WithBootstrapOptions(bootstrapOpts...),
+			fluxexec.Owner(f.owner),
+			fluxexec.Repository(f.repository),
+			fluxexec.Path(f.path),
+			fluxexec.Personal(f.personal),
+			fluxexec.Private(f.private),
+			fluxexec.ReadWriteKey(f.readWriteKey),
+		}
+
+		if f.hostname != "" {
+			opts = append(opts, fluxexec.Hostname(f.hostname))
+		}
+
+		return flux.BootstrapGitlab(ctx, opts...)
+	case providerBitbucketServer:
+		return flux.BootstrapBitbucketServer(ctx,
+			fluxexec.WithGlobalOptions(globalOpts...),
+			fluxexec.WithBootstrapOptions(bootstrapOpts...),
+			fluxexec.Hostname(f.hostname),
+			fluxexec.Owner(f.owner),
+			fluxexec.Repository(f.repository),
+			fluxexec.Path(f.path),
+			fluxexec.Personal(f.personal),
+			fluxexec.Private(f.private),
+			fluxexec.ReadWriteKey(f.readWriteKey),
+			fluxexec.Username(f.gitUsername),
+		)
+	default:
+		return flux.BootstrapGit(ctx,
+			fluxexec.WithGlobalOptions(globalOpts...),
+			fluxexec.WithBootstrapOptions(bootstrapOpts...),
+			fluxexec.URL(f.url),
+			fluxexec.Path(f.path),
+			fluxexec.Username(f.gitUsername),
+			fluxexec.Password(f.gitPassword),
+		)
+	}
+}
+
+// commitDashboard commits the manifests of the dashboard to the path of the
+// cluster in the repository, and pushes them for Flux to deploy.
+func (f *bootstrapFlags) commitDashboard(ctx context.Context, log logger.Logger, manifests []byte) error {
+	url, auth, err := f.repositoryAccess()
+	if err != nil {
+		return err
+	}
+
+	dir, err := os.MkdirTemp("", "gitops-bootstrap-")
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(dir)
+
+	log.Actionf("Cloning %s ...", url)
+
+	gitClient := git.New(auth, wrapper.NewGoGit())
+	if _, err := gitClient.Clone(ctx, dir, url, f.branch); err != nil {
+		return fmt.Errorf("failed cloning the repository: %w", err)
+	}
+
+	path := filepath.Join(f.path, dashboardFile)
+
+	if _, err := gitClient.Read(path); err == nil {
+		log.Warningf("%s exists in the repository already, the GitOps Dashboard isn't committed", path)
+		return nil
+	}
+
+	if err := gitClient.Write(path, manifests); err != nil {
+		return err
+	}
+
+	if _, err := gitClient.Commit(git.Commit{
+		Author:  git.Author{Name: f.authorName, Email: f.authorEmail},
+		Message: "Add the Weave GitOps Dashboard",
+	}); err != nil {
+		return err
+	}
+
+	log.Actionf("Pushing the GitOps Dashboard to %s ...", f.branch)
+
+	if err := gitClient.Push(ctx); err != nil {
+		return fmt.Errorf("failed pushing the dashboard: %w", err)
+	}
+
+	log.Successf("Committed the GitOps Dashboard to %s, Flux deploys it on its next reconciliation", path)
+
+	return nil
+}
+
+// repositoryAccess returns the URL of the repository and the auth to clone
+// it with, which is the provider's token over HTTPS for the providers.
+func (f *bootstrapFlags) repositoryAccess() (string, transport.AuthMethod, error) {
+	token := os.Getenv(tokenEnvVars[f.provider])
+
+	switch f.provider {
+	case providerGitHub, providerGitLab:
+		hostname := f.hostname
+		if hostname == "" {
+			hostname = map[string]string{providerGitHub: "github.com", providerGitLab: "gitlab.com"}[f.provider]
+		}
+
+		return fmt.Sprintf("https://%s/%s/%s.git", hostname, f.owner, f.repository),
+			&http.BasicAuth{Username: "git", Password: token}, nil
+	case providerBitbucketServer:
+		owner := f.owner
+		if f.personal {
+			owner = "~" + owner
+		}
+
+		return fmt.Sprintf("https://%s/scm/%s/%s.git", f.hostname, owner, f.repository),
+			&http.BasicAuth{Username: f.gitUsername, Password: token}, nil
+	default:
+		if f.privateKeyFile != "" {
+			auth, err := ssh.NewPublicKeysFromFile(f.gitUsername, f.privateKeyFile, "")
+			if err != nil {
+				return "", nil, fmt.Errorf("failed reading the private key: %w", err)
+			}
+
+			return f.url, auth, nil
+		}
+
+		return f.url, &http.BasicAuth{Username: f.gitUsername, Password: f.gitPassword}, nil
+	}
+}
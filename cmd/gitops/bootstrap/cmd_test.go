@@ -0,0 +1,209 @@
+package bootstrap
+
+import (
+	"bytes"
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"encoding/pem"
+	"os"
+	"path/filepath"
+	"time"
+
+	gogit "github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/go-git/go-git/v5/plumbing/transport/http"
+	"github.com/go-git/go-git/v5/plumbing/transport/ssh"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/weaveworks/weave-gitops/pkg/logger"
+	gossh "golang.org/x/crypto/ssh"
+)
+
+// unsetTokens unsets the token environment variables of the providers for
+// the spec, restoring them afterwards.
+func unsetTokens() {
+	for _, name := range tokenEnvVars {
+		if value, ok := os.LookupEnv(name); ok {
+			DeferCleanup(os.Setenv, name, value)
+		} else {
+			DeferCleanup(os.Unsetenv, name)
+		}
+
+		Expect(os.Unsetenv(name)).To(Succeed())
+	}
+}
+
+var _ = Describe("validate", func() {
+	BeforeEach(unsetTokens)
+
+	DescribeTable("the flags of the providers",
+		func(flags bootstrapFlags, env map[string]string, expectedErr string) {
+			for name, value := range env {
+				Expect(os.Setenv(name, value)).To(Succeed())
+			}
+
+			flags.fluxVersion = "v2.1.0"
+
+			err := flags.validate()
+			if expectedErr == "" {
+				Expect(err).NotTo(HaveOccurred())
+			} else {
+				Expect(err).To(MatchError(expectedErr))
+			}
+
+			Expect(flags.fluxVersion).To(Equal("2.1.0"))
+		},
+		Entry("github",
+			bootstrapFlags{provider: providerGitHub, owner: "my-org", repository: "fleet"},
+			map[string]string{"GITHUB_TOKEN": "t"}, ""),
+		Entry("github without an owner",
+			bootstrapFlags{provider: providerGitHub, repository: "fleet"},
+			map[string]string{"GITHUB_TOKEN": "t"}, "--owner and --repository are required with github"),
+		Entry("github without a token",
+			bootstrapFlags{provider: providerGitHub, owner: "my-org", repository: "fleet"},
+			nil, "GITHUB_TOKEN must be set to bootstrap with github"),
+		Entry("gitlab",
+			bootstrapFlags{provider: providerGitLab, owner: "my-user", repository: "fleet", personal: true},
+			map[string]string{"GITLAB_TOKEN": "t"}, ""),
+		Entry("gitlab without a repository",
+			bootstrapFlags{provider: providerGitLab, owner: "my-user"},
+			map[string]string{"GITLAB_TOKEN": "t"}, "--owner and --repository are required with gitlab"),
+		Entry("gitlab with the token of another provider",
+			bootstrapFlags{provider: providerGitLab, owner: "my-user", repository: "fleet"},
+			map[string]string{"GITHUB_TOKEN": "t"}, "GITLAB_TOKEN must be set to bootstrap with gitlab"),
+		Entry("bitbucket-server",
+			bootstrapFlags{provider: providerBitbucketServer, owner: "PROJ", repository: "fleet", hostname: "stash.example.com"},
+			map[string]string{"BITBUCKET_TOKEN": "t"}, ""),
+		Entry("bitbucket-server without a hostname",
+			bootstrapFlags{provider: providerBitbucketServer, owner: "PROJ", repository: "fleet"},
+			map[string]string{"BITBUCKET_TOKEN": "t"}, "--hostname is required with bitbucket-server"),
+		Entry("bitbucket-server without a token",
+			bootstrapFlags{provider: providerBitbucketServer, owner: "PROJ", repository: "fleet", hostname: "stash.example.com"},
+			nil, "BITBUCKET_TOKEN must be set to bootstrap with bitbucket-server"),
+		Entry("git with a private key",
+			bootstrapFlags{provider: providerGit, url: "ssh://git@example.com/fleet.git", privateKeyFile: "identity"},
+			nil, ""),
+		Entry("git with a password",
+			bootstrapFlags{provider: providerGit, url: "https://example.com/fleet.git", gitPassword: "p"},
+			nil, ""),
+		Entry("git without a URL",
+			bootstrapFlags{provider: providerGit, gitPassword: "p"},
+			nil, "--url is required with git"),
+		Entry("git without credentials",
+			bootstrapFlags{provider: providerGit, url: "https://example.com/fleet.git"},
+			nil, "one of --private-key-file or --git-password is required with git"),
+		Entry("git with both a private key and a password",
+			bootstrapFlags{provider: providerGit, url: "https://example.com/fleet.git", privateKeyFile: "identity", gitPassword: "p"},
+			nil, "one of --private-key-file or --git-password is required with git"),
+		Entry("an unknown provider",
+			bootstrapFlags{provider: "gitea"},
+			nil, `unknown provider "gitea", valid providers are github, gitlab, bitbucket-server and git`),
+	)
+
+	It("should require a version of Flux", func() {
+		flags := bootstrapFlags{provider: providerGitHub, fluxVersion: "latest"}
+
+		Expect(flags.validate()).To(MatchError(ContainSubstring("set it with --flux-version")))
+	})
+})
+
+var _ = Describe("repositoryAccess", func() {
+	BeforeEach(func() {
+		unsetTokens()
+
+		Expect(os.Setenv("GITHUB_TOKEN", "github-token")).To(Succeed())
+		Expect(os.Setenv("GITLAB_TOKEN", "gitlab-token")).To(Succeed())
+		Expect(os.Setenv("BITBUCKET_TOKEN", "bitbucket-token")).To(Succeed())
+	})
+
+	DescribeTable("the URL and the token of the providers",
+		func(flags bootstrapFlags, expectedURL string, expectedAuth *http.BasicAuth) {
+			url, auth, err := flags.repositoryAccess()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(url).To(Equal(expectedURL))
+			Expect(auth).To(Equal(expectedAuth))
+		},
+		Entry("github",
+			bootstrapFlags{provider: providerGitHub, owner: "my-org", repository: "fleet"},
+			"https://github.com/my-org/fleet.git", &http.BasicAuth{Username: "git", Password: "github-token"}),
+		Entry("github enterprise",
+			bootstrapFlags{provider: providerGitHub, owner: "my-org", repository: "fleet", hostname: "github.example.com"},
+			"https://github.example.com/my-org/fleet.git", &http.BasicAuth{Username: "git", Password: "github-token"}),
+		Entry("gitlab",
+			bootstrapFlags{provider: providerGitLab, owner: "my-user", repository: "fleet", personal: true},
+			"https://gitlab.com/my-user/fleet.git", &http.BasicAuth{Username: "git", Password: "gitlab-token"}),
+		Entry("a project of bitbucket-server",
+			bootstrapFlags{provider: providerBitbucketServer, owner: "PROJ", repository: "fleet", hostname: "stash.example.com", gitUsername: "jane"},
+			"https://stash.example.com/scm/PROJ/fleet.git", &http.BasicAuth{Username: "jane", Password: "bitbucket-token"}),
+		Entry("a user of bitbucket-server",
+			bootstrapFlags{provider: providerBitbucketServer, owner: "jane", repository: "fleet", hostname: "stash.example.com", gitUsername: "jane", personal: true},
+			"https://stash.example.com/scm/~jane/fleet.git", &http.BasicAuth{Username: "jane", Password: "bitbucket-token"}),
+		Entry("git with a password",
+			bootstrapFlags{provider: providerGit, url: "https://example.com/fleet.git", gitUsername: "git", gitPassword: "p"},
+			"https://example.com/fleet.git", &http.BasicAuth{Username: "git", Password: "p"}),
+	)
+
+	It("should authenticate to a git repository with the private key", func() {
+		_, key, err := ed25519.GenerateKey(rand.Reader)
+		Expect(err).NotTo(HaveOccurred())
+
+		block, err := gossh.MarshalPrivateKey(key, "")
+		Expect(err).NotTo(HaveOccurred())
+
+		keyFile := filepath.Join(GinkgoT().TempDir(), "identity")
+		Expect(os.WriteFile(keyFile, pem.EncodeToMemory(block), 0o600)).To(Succeed())
+
+		flags := bootstrapFlags{provider: providerGit, url: "ssh://git@example.com/fleet.git", gitUsername: "git", privateKeyFile: keyFile}
+
+		url, auth, err := flags.repositoryAccess()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(url).To(Equal("ssh://git@example.com/fleet.git"))
+		Expect(auth).To(BeAssignableToTypeOf(&ssh.PublicKeys{}))
+		Expect(auth.(*ssh.PublicKeys).User).To(Equal("git"))
+	})
+
+	It("should fail if the private key can't be read", func() {
+		flags := bootstrapFlags{provider: providerGit, url: "ssh://git@example.com/fleet.git", gitUsername: "git", privateKeyFile: filepath.Join(GinkgoT().TempDir(), "missing")}
+
+		_, _, err := flags.repositoryAccess()
+		Expect(err).To(MatchError(ContainSubstring("failed reading the private key")))
+	})
+})
+
+var _ = Describe("commitDashboard", func() {
+	It("should leave the dashboard file alone if it exists already", func() {
+		remote := GinkgoT().TempDir()
+
+		repo, err := gogit.PlainInit(remote, false)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(repo.Storer.SetReference(plumbing.NewSymbolicReference(plumbing.HEAD, plumbing.NewBranchReferenceName("main")))).To(Succeed())
+
+		path := filepath.Join("clusters", "my-cluster", dashboardFile)
+		Expect(os.MkdirAll(filepath.Join(remote, filepath.Dir(path)), 0o755)).To(Succeed())
+		Expect(os.WriteFile(filepath.Join(remote, path), []byte("# customised\n"), 0o644)).To(Succeed())
+
+		wt, err := repo.Worktree()
+		Expect(err).NotTo(HaveOccurred())
+		_, err = wt.Add(path)
+		Expect(err).NotTo(HaveOccurred())
+
+		head, err := wt.Commit("Add the dashboard", &gogit.CommitOptions{
+			Author: &object.Signature{Name: "Jane", Email: "jane@example.com", When: time.Now()},
+		})
+		Expect(err).NotTo(HaveOccurred())
+
+		flags := bootstrapFlags{provider: providerGit, url: remote, branch: "main", path: "clusters/my-cluster", gitUsername: "git", gitPassword: "p"}
+
+		var out bytes.Buffer
+
+		Expect(flags.commitDashboard(context.Background(), logger.NewCLILogger(&out), []byte("kind: HelmRelease\n"))).To(Succeed())
+		Expect(out.String()).To(ContainSubstring("exists in the repository already, the GitOps Dashboard isn't committed"))
+
+		ref, err := repo.Head()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(ref.Hash()).To(Equal(head))
+		Expect(os.ReadFile(filepath.Join(remote, path))).To(Equal([]byte("# customised\n")))
+	})
+})
@@ -7,6 +7,7 @@ type Options struct {
 	InsecureSkipTLSVerify bool
 	Username              string
 	Password              string
+	Token                 string
 	Kubeconfig            string
 	NoAnalytics           bool
 }
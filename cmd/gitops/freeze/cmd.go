@@ -0,0 +1,244 @@
+package freeze
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/weaveworks/weave-gitops/cmd/gitops/cmderrors"
+	"github.com/weaveworks/weave-gitops/cmd/gitops/config"
+	"github.com/weaveworks/weave-gitops/cmd/gitops/objects"
+	pb "github.com/weaveworks/weave-gitops/pkg/api/core"
+)
+
+func Command(opts *config.Options) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "freeze",
+		Short: "Manage change freezes, windows during which Flux objects are kept suspended",
+		Example: `
+# Suspend the production Kustomizations every weekend
+gitops freeze create weekends --kind kustomization -l tier=prod -A \
+  --schedule "0 18 * * FRI" --duration 62h
+
+# Suspend two HelmReleases over the holidays
+gitops freeze create holidays --kind helmrelease --name podinfo --name redis \
+  --start 2023-12-22T18:00:00Z --end 2024-01-02T08:00:00Z --comment "Happy holidays"
+
+# List the change freezes
+gitops freeze list
+
+# Delete a change freeze, resuming the objects it suspended
+gitops freeze delete holidays
+`,
+	}
+
+	cmd.AddCommand(createCommand(opts))
+	cmd.AddCommand(listCommand(opts))
+	cmd.AddCommand(deleteCommand(opts))
+
+	return cmd
+}
+
+func createCommand(opts *config.Options) *cobra.Command {
+	var (
+		selection objects.SelectionFlags
+		kind      string
+		names     []string
+		start     string
+		end       string
+		schedule  string
+		duration  time.Duration
+		comment   string
+		output    string
+	)
+
+	cmd := &cobra.Command{
+		Use:   "create NAME",
+		Short: "Create a change freeze",
+		Long: `Create a change freeze, suspending the selected objects during a one-off
+window between --start and --end, or during the windows of --duration starting
+on a cron --schedule. The objects are suspended and resumed as you, objects
+suspended by someone else are left alone.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) == 0 {
+				return cmderrors.ErrNoName
+			}
+
+			if len(args) > 1 {
+				return cmderrors.ErrMultipleNames
+			}
+
+			if err := objects.ValidateOutput(output); err != nil {
+				return err
+			}
+
+			k, err := objects.Kind(kind)
+			if err != nil {
+				return err
+			}
+
+			refs, selectors, err := selection.Selection(cmd, []string{k}, names)
+			if err != nil {
+				return err
+			}
+
+			f := &pb.Freeze{
+				Name:      args[0],
+				Objects:   refs,
+				Selectors: selectors,
+				Schedule:  schedule,
+				Comment:   comment,
+			}
+
+			if duration != 0 {
+				f.Duration = duration.String()
+			}
+
+			now := time.Now()
+
+			if start != "" {
+				t, err := objects.ParseTime(start, now)
+				if err != nil {
+					return fmt.Errorf("invalid --start: %w", err)
+				}
+
+				f.Start = t.UTC().Format(time.RFC3339)
+			}
+
+			if end != "" {
+				t, err := objects.ParseTime(end, now)
+				if err != nil {
+					return fmt.Errorf("invalid --end: %w", err)
+				}
+
+				f.End = t.UTC().Format(time.RFC3339)
+			}
+
+			c, err := objects.NewClient(opts)
+			if err != nil {
+				return err
+			}
+
+			res, err := c.CreateFreeze(cmd.Context(), &pb.CreateFreezeRequest{Freeze: f})
+			if err != nil {
+				return err
+			}
+
+			if output != objects.OutputTable {
+				return objects.PrintResults(os.Stdout, output, res, res.Results)
+			}
+
+			if res.Freeze.ActiveUntil == "" {
+				fmt.Fprintf(os.Stdout, "Created freeze %s, starting at %s\n", res.Freeze.Name, res.Freeze.NextStart)
+				return nil
+			}
+
+			fmt.Fprintf(os.Stdout, "Created freeze %s, active until %s\n", res.Freeze.Name, res.Freeze.ActiveUntil)
+
+			return objects.PrintResults(os.Stdout, output, res, res.Results)
+		},
+	}
+
+	selection.AddFlags(cmd.Flags())
+	cmd.Flags().StringVar(&kind, "kind", "", fmt.Sprintf("The kind of the objects, one of %s", strings.Join(objects.KindNames(), ", ")))
+	cmd.Flags().StringArrayVar(&names, "name", nil, "The name of an object, can be repeated")
+	cmd.Flags().StringVar(&start, "start", "", "The RFC3339 time, or the duration from now, the freeze starts at, now if empty")
+	cmd.Flags().StringVar(&end, "end", "", "The RFC3339 time, or the duration from now, the freeze ends at")
+	cmd.Flags().StringVar(&schedule, "schedule", "", `The cron schedule of recurring freezes, e.g. "0 18 * * FRI"`)
+	cmd.Flags().DurationVar(&duration, "duration", 0, "How long each recurring freeze lasts, e.g. 62h")
+	cmd.Flags().StringVar(&comment, "comment", "", "Why the objects are suspended")
+	objects.AddOutputFlag(cmd.Flags(), &output)
+	cobra.CheckErr(cmd.MarkFlagRequired("kind"))
+
+	return cmd
+}
+
+func listCommand(opts *config.Options) *cobra.Command {
+	var output string
+
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List the change freezes",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := objects.ValidateOutput(output); err != nil {
+				return err
+			}
+
+			c, err := objects.NewClient(opts)
+			if err != nil {
+				return err
+			}
+
+			res, err := c.ListFreezes(cmd.Context(), &pb.ListFreezesRequest{})
+			if err != nil {
+				return err
+			}
+
+			if output != objects.OutputTable {
+				return objects.PrintMessage(os.Stdout, output, res)
+			}
+
+			if len(res.Freezes) == 0 {
+				fmt.Fprintln(os.Stdout, "No change freezes")
+				return nil
+			}
+
+			rows := [][]string{}
+			for _, f := range res.Freezes {
+				rows = append(rows, []string{f.Name, window(f), f.ActiveUntil, f.NextStart, f.CreatedBy, f.Comment})
+			}
+
+			return objects.PrintTable(os.Stdout, []string{"NAME", "WINDOW", "ACTIVE UNTIL", "NEXT START", "CREATED BY", "COMMENT"}, rows)
+		},
+	}
+
+	objects.AddOutputFlag(cmd.Flags(), &output)
+
+	return cmd
+}
+
+func deleteCommand(opts *config.Options) *cobra.Command {
+	return &cobra.Command{
+		Use:   "delete NAME",
+		Short: "Delete a change freeze you created, resuming the objects it suspended",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) != 1 {
+				return errors.New("exactly one name is required")
+			}
+
+			c, err := objects.NewClient(opts)
+			if err != nil {
+				return err
+			}
+
+			res, err := c.DeleteFreeze(cmd.Context(), &pb.DeleteFreezeRequest{Name: args[0]})
+			if err != nil {
+				return err
+			}
+
+			fmt.Fprintf(os.Stdout, "Deleted freeze %s\n", args[0])
+
+			if len(res.Results) == 0 {
+				return nil
+			}
+
+			return objects.PrintResults(os.Stdout, objects.OutputTable, res, res.Results)
+		},
+	}
+}
+
+func window(f *pb.Freeze) string {
+	if f.Schedule != "" {
+		return fmt.Sprintf("%s for %s", f.Schedule, f.Duration)
+	}
+
+	if f.Start == "" {
+		return "until " + f.End
+	}
+
+	return f.Start + " - " + f.End
+}
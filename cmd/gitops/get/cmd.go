@@ -6,6 +6,7 @@ import (
 	"github.com/weaveworks/weave-gitops/cmd/gitops/config"
 	"github.com/weaveworks/weave-gitops/cmd/gitops/get/bcrypt"
 	configCmd "github.com/weaveworks/weave-gitops/cmd/gitops/get/config"
+	"github.com/weaveworks/weave-gitops/cmd/gitops/get/flux"
 )
 
 func GetCommand(opts *config.Options) *cobra.Command {
@@ -18,11 +19,18 @@ gitops get config
 
 # Generate a hashed secret
 PASSWORD="<your password>"
-echo -n $PASSWORD | gitops get bcrypt-hash`,
+echo -n $PASSWORD | gitops get bcrypt-hash
+
+# List the Kustomizations in the "flux-system" namespace, through the Weave GitOps API
+gitops get kustomization --endpoint https://gitops.example.com --namespace flux-system
+
+# Get a Git repository as JSON
+gitops get source git podinfo -o json`,
 	}
 
 	cmd.AddCommand(bcrypt.HashCommand(opts))
 	cmd.AddCommand(configCmd.ConfigCommand(opts))
+	cmd.AddCommand(flux.Commands(opts)...)
 
 	return cmd
 }
@@ -0,0 +1,105 @@
+package flux
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/weaveworks/weave-gitops/cmd/gitops/config"
+	"github.com/weaveworks/weave-gitops/cmd/gitops/objects"
+	pb "github.com/weaveworks/weave-gitops/pkg/api/core"
+)
+
+// Commands returns the commands getting Flux objects through the API.
+func Commands(opts *config.Options) []*cobra.Command {
+	return objects.KindCommands(func(k objects.KindCommand) *cobra.Command {
+		return command(opts, k)
+	})
+}
+
+func command(opts *config.Options, k objects.KindCommand) *cobra.Command {
+	var (
+		selection objects.SelectionFlags
+		output    string
+	)
+
+	cmd := &cobra.Command{
+		Use:     k.Name + " [NAME...]",
+		Aliases: k.Aliases,
+		Short:   fmt.Sprintf("Display %s objects", strings.Join(k.Kinds, ", ")),
+		Example: fmt.Sprintf(`
+# List the %[1]s objects in all namespaces, on all clusters
+gitops get %[1]s -A --cluster ""
+
+# Get the %[1]s objects labelled tier=prod as YAML
+gitops get %[1]s -l tier=prod -o yaml
+`, k.Path),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := objects.ValidateOutput(output); err != nil {
+				return err
+			}
+
+			c, err := objects.NewClient(opts)
+			if err != nil {
+				return err
+			}
+
+			found := []*pb.Object{}
+
+			if len(args) > 0 {
+				refs, _, err := selection.Selection(cmd, k.Kinds, args)
+				if err != nil {
+					return err
+				}
+
+				for _, ref := range refs {
+					res, err := c.GetObject(cmd.Context(), &pb.GetObjectRequest{
+						Name:        ref.Name,
+						Namespace:   ref.Namespace,
+						Kind:        ref.Kind,
+						ClusterName: ref.ClusterName,
+					})
+					if err != nil {
+						return fmt.Errorf("getting %s %s/%s: %w", ref.Kind, ref.Namespace, ref.Name, err)
+					}
+
+					found = append(found, res.Object)
+				}
+
+				return objects.PrintObjects(os.Stdout, output, found)
+			}
+
+			namespace, err := selection.Namespace(cmd)
+			if err != nil {
+				return err
+			}
+
+			for _, kind := range k.Kinds {
+				res, err := c.ListObjects(cmd.Context(), &pb.ListObjectsRequest{
+					Kind:        kind,
+					Namespace:   namespace,
+					ClusterName: selection.Cluster,
+					Labels:      selection.Selector,
+				})
+				if err != nil {
+					return fmt.Errorf("listing %s: %w", kind, err)
+				}
+
+				// The objects the user can see are still listed.
+				for _, e := range res.Errors {
+					fmt.Fprintf(os.Stderr, "Warning: couldn't list %s on cluster %s in namespace %s: %s\n", kind, e.ClusterName, e.Namespace, e.Message)
+				}
+
+				found = append(found, res.Objects...)
+			}
+
+			return objects.PrintObjects(os.Stdout, output, found)
+		},
+	}
+
+	selection.AddFlags(cmd.Flags())
+	objects.AddOutputFlag(cmd.Flags(), &output)
+
+	return cmd
+}
@@ -0,0 +1,215 @@
+// Package objects has the helpers shared by the commands acting on Flux
+// objects through the Weave GitOps HTTP API.
+package objects
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	"github.com/weaveworks/weave-gitops/cmd/gitops/cmderrors"
+	"github.com/weaveworks/weave-gitops/cmd/gitops/config"
+	pb "github.com/weaveworks/weave-gitops/pkg/api/core"
+	"github.com/weaveworks/weave-gitops/pkg/apiclient"
+)
+
+// DefaultCluster is the name of the cluster the server runs on.
+const DefaultCluster = "Default"
+
+// CommandKind is a Flux kind, as named by the commands acting on it.
+type CommandKind struct {
+	Name    string
+	Aliases []string
+	Kind    string
+}
+
+// Automations and Sources are the kinds with a command, the sources are
+// subcommands of a source command.
+var (
+	Automations = []CommandKind{
+		{Name: "kustomization", Aliases: []string{"ks"}, Kind: "Kustomization"},
+		{Name: "helmrelease", Aliases: []string{"hr"}, Kind: "HelmRelease"},
+	}
+	Sources = []CommandKind{
+		{Name: "git", Kind: "GitRepository"},
+		{Name: "oci", Kind: "OCIRepository"},
+		{Name: "helm", Kind: "HelmRepository"},
+		{Name: "chart", Kind: "HelmChart"},
+		{Name: "bucket", Kind: "Bucket"},
+	}
+)
+
+// KindCommand is a command acting on objects of some kinds.
+type KindCommand struct {
+	Name    string
+	Aliases []string
+	// Path is the name of the command after its verb, e.g. source git.
+	Path  string
+	Kinds []string
+}
+
+// KindCommands returns the commands built by newCommand for the automation
+// kinds, and a source command, acting on all the source kinds, with a
+// subcommand for each of them.
+func KindCommands(newCommand func(KindCommand) *cobra.Command) []*cobra.Command {
+	cmds := []*cobra.Command{}
+	for _, k := range Automations {
+		cmds = append(cmds, newCommand(KindCommand{Name: k.Name, Aliases: k.Aliases, Path: k.Name, Kinds: []string{k.Kind}}))
+	}
+
+	sourceKinds := []string{}
+	for _, k := range Sources {
+		sourceKinds = append(sourceKinds, k.Kind)
+	}
+
+	source := newCommand(KindCommand{Name: "source", Aliases: []string{"sources"}, Path: "source", Kinds: sourceKinds})
+	for _, k := range Sources {
+		source.AddCommand(newCommand(KindCommand{Name: k.Name, Aliases: k.Aliases, Path: "source " + k.Name, Kinds: []string{k.Kind}}))
+	}
+
+	return append(cmds, source)
+}
+
+// Kinds maps the names of the Flux kinds on the command line to their kinds.
+var Kinds = map[string]string{
+	"kustomization":  "Kustomization",
+	"helmrelease":    "HelmRelease",
+	"gitrepository":  "GitRepository",
+	"ocirepository":  "OCIRepository",
+	"helmrepository": "HelmRepository",
+	"helmchart":      "HelmChart",
+	"bucket":         "Bucket",
+}
+
+// KindNames returns the names of the Flux kinds, sorted.
+func KindNames() []string {
+	names := []string{}
+	for name := range Kinds {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	return names
+}
+
+// Kind returns the kind for a name on the command line, e.g. kustomization
+// or Kustomization.
+func Kind(name string) (string, error) {
+	kind, ok := Kinds[strings.ToLower(name)]
+	if !ok {
+		return "", fmt.Errorf("unknown kind %q, valid kinds are %s", name, strings.Join(KindNames(), ", "))
+	}
+
+	return kind, nil
+}
+
+// NewClient returns a client for the API at the --endpoint, authenticating
+// with the --token, or signing in with the --username and --password.
+func NewClient(opts *config.Options) (*apiclient.Client, error) {
+	if opts.Endpoint == "" {
+		return nil, cmderrors.ErrNoWGEEndpoint
+	}
+
+	return apiclient.New(apiclient.Options{
+		Endpoint:              opts.Endpoint,
+		Username:              opts.Username,
+		Password:              opts.Password,
+		Token:                 opts.Token,
+		InsecureSkipTLSVerify: opts.InsecureSkipTLSVerify,
+	})
+}
+
+// SelectionFlags select the objects of a kind by name or by labels.
+type SelectionFlags struct {
+	Cluster       string
+	Selector      map[string]string
+	AllNamespaces bool
+}
+
+func (f *SelectionFlags) AddFlags(flags *pflag.FlagSet) {
+	flags.StringVar(&f.Cluster, "cluster", DefaultCluster, "The cluster the objects are on")
+	flags.StringToStringVarP(&f.Selector, "selector", "l", nil, "Select the objects with these labels instead of by name, e.g. -l tier=prod")
+	flags.BoolVarP(&f.AllNamespaces, "all-namespaces", "A", false, "Select the objects in all the namespaces")
+}
+
+// Namespace returns the --namespace the objects are selected in, empty for
+// all namespaces.
+func (f *SelectionFlags) Namespace(cmd *cobra.Command) (string, error) {
+	if f.AllNamespaces {
+		return "", nil
+	}
+
+	return cmd.Flags().GetString("namespace")
+}
+
+// Selection returns the objects named in the --namespace, or the selectors
+// for the objects of the kinds with the --selector labels.
+func (f *SelectionFlags) Selection(cmd *cobra.Command, kinds []string, names []string) ([]*pb.ObjectRef, []*pb.ObjectSelector, error) {
+	namespace, err := f.Namespace(cmd)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if len(names) > 0 && len(f.Selector) > 0 {
+		return nil, nil, errors.New("either names or a selector can be given, not both")
+	}
+
+	if len(names) == 0 {
+		if len(f.Selector) == 0 {
+			return nil, nil, errors.New("no names or selector given")
+		}
+
+		selectors := []*pb.ObjectSelector{}
+		for _, kind := range kinds {
+			selectors = append(selectors, &pb.ObjectSelector{
+				Kind:        kind,
+				ClusterName: f.Cluster,
+				Namespace:   namespace,
+				Labels:      f.Selector,
+			})
+		}
+
+		return nil, selectors, nil
+	}
+
+	if len(kinds) != 1 {
+		return nil, nil, errors.New("the kind is required with names, e.g. source git NAME")
+	}
+
+	if f.AllNamespaces {
+		return nil, nil, errors.New("names can't be used with --all-namespaces")
+	}
+
+	kind := kinds[0]
+
+	refs := []*pb.ObjectRef{}
+	for _, name := range names {
+		refs = append(refs, &pb.ObjectRef{
+			Kind:        kind,
+			Name:        name,
+			Namespace:   namespace,
+			ClusterName: f.Cluster,
+		})
+	}
+
+	return refs, nil, nil
+}
+
+// ParseTime parses an RFC3339 time, or a duration from now, e.g. 2h.
+func ParseTime(value string, now time.Time) (time.Time, error) {
+	if d, err := time.ParseDuration(value); err == nil {
+		return now.Add(d), nil
+	}
+
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("%q is neither a duration nor an RFC3339 time", value)
+	}
+
+	return t, nil
+}
@@ -0,0 +1,248 @@
+package objects
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/spf13/pflag"
+	pb "github.com/weaveworks/weave-gitops/pkg/api/core"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/yaml"
+)
+
+// The output formats.
+const (
+	OutputTable = "table"
+	OutputJSON  = "json"
+	OutputYAML  = "yaml"
+)
+
+// The sync statuses of the objects that failed to sync, or couldn't be
+// synced.
+var failedSyncStatuses = map[string]bool{
+	"Failed":    true,
+	"TimedOut":  true,
+	"Forbidden": true,
+	"NotFound":  true,
+	"Conflict":  true,
+	"Error":     true,
+}
+
+func AddOutputFlag(flags *pflag.FlagSet, output *string) {
+	flags.StringVarP(output, "output", "o", OutputTable, "The output format, one of table, json or yaml")
+}
+
+// ValidateOutput returns an error for unknown output formats.
+func ValidateOutput(output string) error {
+	switch output {
+	case OutputTable, OutputJSON, OutputYAML:
+		return nil
+	default:
+		return fmt.Errorf("unknown output format %q, valid formats are table, json and yaml", output)
+	}
+}
+
+// PrintJSON prints JSON as is, or as YAML.
+func PrintJSON(w io.Writer, output string, data []byte) error {
+	if output == OutputYAML {
+		var err error
+
+		if data, err = yaml.JSONToYAML(data); err != nil {
+			return err
+		}
+
+		_, err = w.Write(data)
+
+		return err
+	}
+
+	_, err := fmt.Fprintln(w, string(data))
+
+	return err
+}
+
+// PrintMessage prints an API message as JSON or YAML.
+func PrintMessage(w io.Writer, output string, msg proto.Message) error {
+	data, err := protojson.MarshalOptions{Multiline: true}.Marshal(msg)
+	if err != nil {
+		return err
+	}
+
+	return PrintJSON(w, output, data)
+}
+
+// PrintResults prints the results of suspending or resuming objects, as a
+// table or as the response in JSON or YAML, and returns an error if any of
+// them failed.
+func PrintResults(w io.Writer, output string, res proto.Message, results []*pb.ObjectResult) error {
+	rows := [][]string{}
+	failed := 0
+
+	for _, r := range results {
+		if r.Status != "Success" {
+			failed++
+		}
+
+		rows = append(rows, []string{r.Object.ClusterName, r.Object.Namespace, objectName(r.Object), r.Status, r.Message})
+	}
+
+	if err := printRows(w, output, res, []string{"CLUSTER", "NAMESPACE", "NAME", "STATUS", "MESSAGE"}, rows); err != nil {
+		return err
+	}
+
+	if failed > 0 {
+		return fmt.Errorf("%d of %d objects failed", failed, len(results))
+	}
+
+	return nil
+}
+
+// PrintSyncResults prints the results of syncing objects like PrintResults.
+func PrintSyncResults(w io.Writer, output string, res proto.Message, results []*pb.SyncResult) error {
+	rows := [][]string{}
+	failed := 0
+
+	for _, r := range results {
+		if failedSyncStatuses[r.Status] {
+			failed++
+		}
+
+		rows = append(rows, []string{r.Object.ClusterName, r.Object.Namespace, objectName(r.Object), r.Status, r.Revision, r.Message})
+	}
+
+	if err := printRows(w, output, res, []string{"CLUSTER", "NAMESPACE", "NAME", "STATUS", "REVISION", "MESSAGE"}, rows); err != nil {
+		return err
+	}
+
+	if failed > 0 {
+		return fmt.Errorf("%d of %d objects failed to sync", failed, len(results))
+	}
+
+	return nil
+}
+
+// PrintTable prints rows under a header, aligned in columns.
+func PrintTable(w io.Writer, header []string, rows [][]string) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 3, ' ', 0)
+
+	fmt.Fprintln(tw, strings.Join(header, "\t"))
+
+	for _, row := range rows {
+		fmt.Fprintln(tw, strings.Join(row, "\t"))
+	}
+
+	return tw.Flush()
+}
+
+func printRows(w io.Writer, output string, res proto.Message, header []string, rows [][]string) error {
+	if output != OutputTable && output != "" {
+		return PrintMessage(w, output, res)
+	}
+
+	if len(rows) == 0 {
+		_, err := fmt.Fprintln(w, "No objects selected")
+		return err
+	}
+
+	return PrintTable(w, header, rows)
+}
+
+// objectName returns kind/name, or kind/* for the results about all the
+// objects of a namespace.
+func objectName(ref *pb.ObjectRef) string {
+	name := ref.Name
+	if name == "" {
+		name = "*"
+	}
+
+	return strings.ToLower(ref.Kind) + "/" + name
+}
+
+// PrintObjects prints the objects as a table, or as a Kubernetes List in
+// JSON or YAML.
+func PrintObjects(w io.Writer, output string, objects []*pb.Object) error {
+	items := []map[string]interface{}{}
+	rows := [][]string{}
+
+	for _, o := range objects {
+		obj := &unstructured.Unstructured{}
+		if err := obj.UnmarshalJSON([]byte(o.Payload)); err != nil {
+			return fmt.Errorf("decoding object: %w", err)
+		}
+
+		items = append(items, obj.Object)
+
+		ready, message := readyCondition(obj)
+		suspended, _, _ := unstructured.NestedBool(obj.Object, "spec", "suspend")
+
+		rows = append(rows, []string{
+			o.ClusterName,
+			obj.GetNamespace(),
+			objectName(&pb.ObjectRef{Kind: obj.GetKind(), Name: obj.GetName()}),
+			ready,
+			fmt.Sprint(suspended),
+			revision(obj),
+			message,
+		})
+	}
+
+	if output != OutputTable && output != "" {
+		data, err := json.MarshalIndent(map[string]interface{}{
+			"apiVersion": "v1",
+			"kind":       "List",
+			"items":      items,
+		}, "", "  ")
+		if err != nil {
+			return err
+		}
+
+		return PrintJSON(w, output, data)
+	}
+
+	if len(rows) == 0 {
+		_, err := fmt.Fprintln(w, "No objects found")
+		return err
+	}
+
+	return PrintTable(w, []string{"CLUSTER", "NAMESPACE", "NAME", "READY", "SUSPENDED", "REVISION", "MESSAGE"}, rows)
+}
+
+// readyCondition returns the status and message of the Ready condition.
+func readyCondition(obj *unstructured.Unstructured) (string, string) {
+	conditions, _, _ := unstructured.NestedSlice(obj.Object, "status", "conditions")
+
+	for _, c := range conditions {
+		condition, ok := c.(map[string]interface{})
+		if !ok || condition["type"] != "Ready" {
+			continue
+		}
+
+		status, _ := condition["status"].(string)
+		message, _ := condition["message"].(string)
+
+		return status, message
+	}
+
+	return "Unknown", ""
+}
+
+// revision returns the artifact revision of sources, and the revision last
+// applied by automations.
+func revision(obj *unstructured.Unstructured) string {
+	for _, path := range [][]string{
+		{"status", "artifact", "revision"},
+		{"status", "lastAppliedRevision"},
+		{"status", "lastAttemptedRevision"},
+	} {
+		if revision, _, _ := unstructured.NestedString(obj.Object, path...); revision != "" {
+			return revision
+		}
+	}
+
+	return ""
+}
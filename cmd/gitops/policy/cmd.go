@@ -0,0 +1,154 @@
+package policy
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+	pacv2beta2 "github.com/weaveworks/policy-agent/api/v2beta2"
+	"github.com/weaveworks/weave-gitops/cmd/gitops/config"
+	"github.com/weaveworks/weave-gitops/cmd/gitops/objects"
+	"github.com/weaveworks/weave-gitops/pkg/kube"
+	"github.com/weaveworks/weave-gitops/pkg/logger"
+	"github.com/weaveworks/weave-gitops/pkg/policies"
+	"github.com/weaveworks/weave-gitops/pkg/run"
+	"github.com/weaveworks/weave-gitops/pkg/validate"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func Command(opts *config.Options) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "policy",
+		Short: "Work with the policies of the Weave policy agent",
+		Example: `
+# Test the manifests of the clusters directory against the policies of the cluster
+gitops policy test ./clusters
+`,
+	}
+
+	cmd.AddCommand(testCommand(opts))
+
+	return cmd
+}
+
+func testCommand(opts *config.Options) *cobra.Command {
+	var (
+		renderOpts  validate.Options
+		policyPaths []string
+		clusterName string
+		output      string
+	)
+
+	kubeConfigArgs := run.GetKubeConfigArgs()
+
+	cmd := &cobra.Command{
+		Use:   "test DIR",
+		Short: "Test the manifests of a directory against policies, before they are applied",
+		Long: `Evaluate the policies of the Weave policy agent against the manifests of a
+directory locally, reporting the violations the policy agent would report
+once they are applied. The Flux Kustomizations and the kustomizations of the
+directory are built like 'gitops validate' builds them, and the resources
+they render tested too, unless --skip-build is set.
+
+The policies are those of the current cluster, unless some files or
+directories of policies are given with --policies. Like the policy agent
+does, a policy is only evaluated against the resources its targets select,
+with the values of its parameters. The policies of Terraform are left out.`,
+		Example: `
+# Test the manifests of the clusters directory against the policies of the cluster
+gitops policy test ./clusters
+
+# Test against the policies of a directory, without a cluster
+gitops policy test ./clusters --policies ./policies -o json
+`,
+		Args:          cobra.ExactArgs(1),
+		SilenceUsage:  true,
+		SilenceErrors: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := objects.ValidateOutput(output); err != nil {
+				return err
+			}
+
+			// the results are written to stdout, the logs mustn't mix with them
+			log := logger.NewCLILogger(os.Stderr)
+
+			var toTest []pacv2beta2.Policy
+
+			if len(policyPaths) > 0 {
+				read, err := policies.ReadFiles(policyPaths...)
+				if err != nil {
+					return err
+				}
+
+				toTest = read
+			} else {
+				cfg, err := kubeConfigArgs.ToRESTConfig()
+				if err != nil {
+					return err
+				}
+
+				kubeClient, err := kube.NewKubeHTTPClientWithConfig(cfg, *kubeConfigArgs.Context)
+				if err != nil {
+					return err
+				}
+
+				if toTest, err = policies.List(cmd.Context(), kubeClient); err != nil {
+					return err
+				}
+			}
+
+			rendered, err := validate.Render(log, args[0], renderOpts)
+			if err != nil {
+				return err
+			}
+
+			resources := []*unstructured.Unstructured{}
+			for _, r := range rendered {
+				resources = append(resources, r.Object)
+			}
+
+			res, err := policies.Test(cmd.Context(), toTest, resources, policies.Options{ClusterName: clusterName})
+			if err != nil {
+				return err
+			}
+
+			if output != objects.OutputTable {
+				if err := objects.PrintMessage(os.Stdout, output, res); err != nil {
+					return err
+				}
+			} else {
+				rows := [][]string{}
+
+				for _, v := range res.Violations {
+					for _, o := range v.Occurrences {
+						rows = append(rows, []string{v.PolicyId, v.Severity, v.Namespace, fmt.Sprintf("%s/%s", v.EntityKind, v.Entity), o.Message})
+					}
+				}
+
+				if len(rows) == 0 {
+					fmt.Println("No policy violations found")
+				} else if err := objects.PrintTable(os.Stdout, []string{"POLICY", "SEVERITY", "NAMESPACE", "RESOURCE", "OCCURRENCE"}, rows); err != nil {
+					return err
+				}
+			}
+
+			if res.Total > 0 {
+				return fmt.Errorf("found %d policy violations", res.Total)
+			}
+
+			return nil
+		},
+	}
+
+	cmd.Flags().StringSliceVar(&policyPaths, "policies", nil, "The files or directories of policies to test against, those of the cluster if not set")
+	cmd.Flags().StringVar(&clusterName, "cluster-name", "", "The name of the cluster the violations are reported for")
+	cmd.Flags().StringVar(&renderOpts.RootDir, "root-dir", "", "The root of the repository, whose .sourceignore file is honoured and the paths of the Flux Kustomizations are relative to. The git repository of the directory if not set")
+	cmd.Flags().StringSliceVar(&renderOpts.SourceNames, "source", nil, "The name of a Flux source of the repository, only the Flux Kustomizations of those sources are built. All of them if not set")
+	cmd.Flags().BoolVar(&renderOpts.SkipBuild, "skip-build", false, "Only test the resources of the files, without building the Flux Kustomizations and kustomizations")
+	objects.AddOutputFlag(cmd.Flags(), &output)
+
+	kubeConfigArgs.AddFlags(cmd.Flags())
+	kubeConfigArgs.KubeConfig = &opts.Kubeconfig
+
+	return cmd
+}
@@ -0,0 +1,25 @@
+package reconcile
+
+import (
+	"github.com/spf13/cobra"
+	"github.com/weaveworks/weave-gitops/cmd/gitops/config"
+	"github.com/weaveworks/weave-gitops/cmd/gitops/reconcile/flux"
+)
+
+func Command(opts *config.Options) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "reconcile",
+		Short: "Reconcile a resource",
+		Example: `
+# Reconcile a Kustomization and its source, through the Weave GitOps API
+gitops reconcile kustomization --endpoint https://gitops.example.com --namespace flux-system my-app --with-source
+
+# Reconcile all the Git repositories in the "flux-system" namespace
+gitops reconcile source git -l app.kubernetes.io/part-of=flux
+`,
+	}
+
+	cmd.AddCommand(flux.Commands(opts)...)
+
+	return cmd
+}
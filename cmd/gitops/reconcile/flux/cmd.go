@@ -0,0 +1,76 @@
+package flux
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/weaveworks/weave-gitops/cmd/gitops/config"
+	"github.com/weaveworks/weave-gitops/cmd/gitops/objects"
+	pb "github.com/weaveworks/weave-gitops/pkg/api/core"
+)
+
+// Commands returns the commands reconciling Flux objects through the API.
+func Commands(opts *config.Options) []*cobra.Command {
+	return objects.KindCommands(func(k objects.KindCommand) *cobra.Command {
+		return command(opts, k)
+	})
+}
+
+func command(opts *config.Options, k objects.KindCommand) *cobra.Command {
+	var (
+		selection  objects.SelectionFlags
+		withSource bool
+		wait       bool
+		timeout    time.Duration
+		output     string
+	)
+
+	cmd := &cobra.Command{
+		Use:     k.Name + " [NAME...]",
+		Aliases: k.Aliases,
+		Short:   fmt.Sprintf("Reconcile %s objects", strings.Join(k.Kinds, ", ")),
+		Example: fmt.Sprintf(`
+# Reconcile the %[1]s objects labelled tier=prod, and wait for them to be ready
+gitops reconcile %[1]s -l tier=prod --wait --timeout 10m
+`, k.Path),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := objects.ValidateOutput(output); err != nil {
+				return err
+			}
+
+			refs, selectors, err := selection.Selection(cmd, k.Kinds, args)
+			if err != nil {
+				return err
+			}
+
+			c, err := objects.NewClient(opts)
+			if err != nil {
+				return err
+			}
+
+			res, err := c.SyncFluxObject(cmd.Context(), &pb.SyncFluxObjectRequest{
+				Objects:    refs,
+				Selectors:  selectors,
+				WithSource: withSource,
+				Wait:       wait,
+				Timeout:    timeout.String(),
+			})
+			if err != nil {
+				return err
+			}
+
+			return objects.PrintSyncResults(os.Stdout, output, res, res.Results)
+		},
+	}
+
+	selection.AddFlags(cmd.Flags())
+	objects.AddOutputFlag(cmd.Flags(), &output)
+	cmd.Flags().BoolVar(&withSource, "with-source", false, "Reconcile the sources of Kustomizations and HelmReleases first")
+	cmd.Flags().BoolVar(&wait, "wait", false, "Wait for the objects to be ready, rather than only for the controllers to handle the request")
+	cmd.Flags().DurationVar(&timeout, "timeout", 5*time.Minute, "How long to wait for each object")
+
+	return cmd
+}
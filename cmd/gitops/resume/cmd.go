@@ -3,6 +3,7 @@ package resume
 import (
 	"github.com/spf13/cobra"
 	"github.com/weaveworks/weave-gitops/cmd/gitops/config"
+	"github.com/weaveworks/weave-gitops/cmd/gitops/resume/flux"
 	"github.com/weaveworks/weave-gitops/cmd/gitops/resume/terraform"
 )
 
@@ -13,10 +14,14 @@ func Command(opts *config.Options) *cobra.Command {
 		Example: `
 # Suspend a Terraform object from the "flux-system" namespace
 gitops resume terraform --namespace flux-system my-resource
+
+# Resume a HelmRelease, through the Weave GitOps API
+gitops resume helmrelease --endpoint https://gitops.example.com --namespace flux-system my-release
 `,
 	}
 
 	cmd.AddCommand(terraform.Command(opts))
+	cmd.AddCommand(flux.Commands(opts)...)
 
 	return cmd
 }
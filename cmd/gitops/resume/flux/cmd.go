@@ -0,0 +1,67 @@
+package flux
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/weaveworks/weave-gitops/cmd/gitops/config"
+	"github.com/weaveworks/weave-gitops/cmd/gitops/objects"
+	pb "github.com/weaveworks/weave-gitops/pkg/api/core"
+)
+
+// Commands returns the commands resuming Flux objects through the API.
+func Commands(opts *config.Options) []*cobra.Command {
+	return objects.KindCommands(func(k objects.KindCommand) *cobra.Command {
+		return command(opts, k)
+	})
+}
+
+func command(opts *config.Options, k objects.KindCommand) *cobra.Command {
+	var (
+		selection objects.SelectionFlags
+		output    string
+	)
+
+	cmd := &cobra.Command{
+		Use:     k.Name + " [NAME...]",
+		Aliases: k.Aliases,
+		Short:   fmt.Sprintf("Resume %s objects", strings.Join(k.Kinds, ", ")),
+		Example: fmt.Sprintf(`
+# Resume the %[1]s objects labelled tier=prod in all namespaces
+gitops resume %[1]s -l tier=prod -A
+`, k.Path),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := objects.ValidateOutput(output); err != nil {
+				return err
+			}
+
+			refs, selectors, err := selection.Selection(cmd, k.Kinds, args)
+			if err != nil {
+				return err
+			}
+
+			c, err := objects.NewClient(opts)
+			if err != nil {
+				return err
+			}
+
+			res, err := c.ToggleSuspendResource(cmd.Context(), &pb.ToggleSuspendResourceRequest{
+				Objects:   refs,
+				Selectors: selectors,
+				Suspend:   false,
+			})
+			if err != nil {
+				return err
+			}
+
+			return objects.PrintResults(os.Stdout, output, res, res.Results)
+		},
+	}
+
+	selection.AddFlags(cmd.Flags())
+	objects.AddOutputFlag(cmd.Flags(), &output)
+
+	return cmd
+}
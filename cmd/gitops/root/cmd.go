@@ -10,17 +10,24 @@ import (
 	"github.com/manifoldco/promptui"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
+	"github.com/weaveworks/weave-gitops/cmd/gitops/bootstrap"
 	"github.com/weaveworks/weave-gitops/cmd/gitops/check"
 	cfg "github.com/weaveworks/weave-gitops/cmd/gitops/config"
 	"github.com/weaveworks/weave-gitops/cmd/gitops/create"
 	deletepkg "github.com/weaveworks/weave-gitops/cmd/gitops/delete"
 	"github.com/weaveworks/weave-gitops/cmd/gitops/docs"
+	"github.com/weaveworks/weave-gitops/cmd/gitops/freeze"
 	"github.com/weaveworks/weave-gitops/cmd/gitops/get"
 	"github.com/weaveworks/weave-gitops/cmd/gitops/logs"
+	"github.com/weaveworks/weave-gitops/cmd/gitops/policy"
+	"github.com/weaveworks/weave-gitops/cmd/gitops/reconcile"
 	"github.com/weaveworks/weave-gitops/cmd/gitops/replan"
 	"github.com/weaveworks/weave-gitops/cmd/gitops/resume"
+	"github.com/weaveworks/weave-gitops/cmd/gitops/session"
 	"github.com/weaveworks/weave-gitops/cmd/gitops/set"
 	"github.com/weaveworks/weave-gitops/cmd/gitops/suspend"
+	"github.com/weaveworks/weave-gitops/cmd/gitops/token"
+	"github.com/weaveworks/weave-gitops/cmd/gitops/validate"
 	"github.com/weaveworks/weave-gitops/cmd/gitops/version"
 	"github.com/weaveworks/weave-gitops/pkg/analytics"
 	"github.com/weaveworks/weave-gitops/pkg/config"
@@ -94,6 +101,12 @@ func RootCmd() *cobra.Command {
 				os.Exit(1)
 			}
 
+			err = cmd.Flags().Set("token", viper.GetString("token"))
+			if err != nil {
+				fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+				os.Exit(1)
+			}
+
 			if options.NoAnalytics {
 				return
 			}
@@ -140,6 +153,7 @@ func RootCmd() *cobra.Command {
 	rootCmd.PersistentFlags().StringVarP(&options.Endpoint, "endpoint", "e", os.Getenv("WEAVE_GITOPS_ENTERPRISE_API_URL"), "The Weave GitOps Enterprise HTTP API endpoint can be set with `WEAVE_GITOPS_ENTERPRISE_API_URL` environment variable")
 	rootCmd.PersistentFlags().StringVarP(&options.Username, "username", "u", "", "The Weave GitOps Enterprise username for authentication can be set with `WEAVE_GITOPS_USERNAME` environment variable")
 	rootCmd.PersistentFlags().StringVarP(&options.Password, "password", "p", "", "The Weave GitOps Enterprise password for authentication can be set with `WEAVE_GITOPS_PASSWORD` environment variable")
+	rootCmd.PersistentFlags().StringVar(&options.Token, "token", "", "A Weave GitOps personal access token to authenticate with instead of the username and password, can be set with `WEAVE_GITOPS_TOKEN` environment variable")
 	rootCmd.PersistentFlags().BoolVar(&options.OverrideInCluster, "override-in-cluster", false, "override running in cluster check")
 	rootCmd.PersistentFlags().StringToStringVar(&options.GitHostTypes, "git-host-types", map[string]string{}, "Specify which custom domains are running what (github or gitlab)")
 	rootCmd.PersistentFlags().BoolVar(&options.InsecureSkipTLSVerify, "insecure-skip-tls-verify", false, "If true, the server's certificate will not be checked for validity. This will make your HTTPS connections insecure")
@@ -157,9 +171,16 @@ func RootCmd() *cobra.Command {
 	rootCmd.AddCommand(create.GetCommand(options))
 	rootCmd.AddCommand(deletepkg.GetCommand(options))
 	rootCmd.AddCommand(logs.GetCommand(options))
+	rootCmd.AddCommand(reconcile.Command(options))
 	rootCmd.AddCommand(replan.Command(options))
 	rootCmd.AddCommand(resume.Command(options))
 	rootCmd.AddCommand(suspend.Command(options))
+	rootCmd.AddCommand(freeze.Command(options))
+	rootCmd.AddCommand(token.Command(options))
+	rootCmd.AddCommand(session.Command(options))
+	rootCmd.AddCommand(validate.Command(options))
+	rootCmd.AddCommand(policy.Command(options))
+	rootCmd.AddCommand(bootstrap.Command(options))
 
 	return rootCmd
 }
@@ -0,0 +1,114 @@
+package session
+
+import (
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/weaveworks/weave-gitops/cmd/gitops/config"
+	"github.com/weaveworks/weave-gitops/cmd/gitops/objects"
+	pb "github.com/weaveworks/weave-gitops/pkg/api/core"
+)
+
+func Command(opts *config.Options) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "session",
+		Short: "List and revoke the sessions users are logged in to the dashboard with",
+		Example: `
+# List your sessions
+gitops session list
+
+# List the sessions of another user, as an admin
+gitops session list --user anne@example.com
+
+# Log a user out of all their sessions, as an admin
+gitops session revoke anne@example.com
+`,
+	}
+
+	cmd.AddCommand(listCommand(opts))
+	cmd.AddCommand(revokeCommand(opts))
+
+	return cmd
+}
+
+func listCommand(opts *config.Options) *cobra.Command {
+	var (
+		user   string
+		output string
+	)
+
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List the sessions of a user, yours by default",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := objects.ValidateOutput(output); err != nil {
+				return err
+			}
+
+			c, err := objects.NewClient(opts)
+			if err != nil {
+				return err
+			}
+
+			res, err := c.ListSessions(cmd.Context(), &pb.ListSessionsRequest{UserId: user})
+			if err != nil {
+				return err
+			}
+
+			if output != objects.OutputTable {
+				return objects.PrintMessage(os.Stdout, output, res)
+			}
+
+			if len(res.Sessions) == 0 {
+				fmt.Fprintln(os.Stdout, "No sessions")
+				return nil
+			}
+
+			rows := [][]string{}
+			for _, s := range res.Sessions {
+				rows = append(rows, []string{s.Id, s.UserId, s.CreatedAt, s.TokenExpiry})
+			}
+
+			return objects.PrintTable(os.Stdout, []string{"ID", "USER", "CREATED", "TOKEN EXPIRY"}, rows)
+		},
+	}
+
+	cmd.Flags().StringVar(&user, "user", "", "The user whose sessions are listed, only admins can list the sessions of others")
+	objects.AddOutputFlag(cmd.Flags(), &output)
+
+	return cmd
+}
+
+func revokeCommand(opts *config.Options) *cobra.Command {
+	return &cobra.Command{
+		Use:   "revoke [USER]",
+		Short: "Log a user out of all their sessions, you by default",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) > 1 {
+				return errors.New("at most one user can be given")
+			}
+
+			req := &pb.RevokeSessionsRequest{}
+			if len(args) == 1 {
+				req.UserId = args[0]
+			}
+
+			c, err := objects.NewClient(opts)
+			if err != nil {
+				return err
+			}
+
+			res, err := c.RevokeSessions(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			fmt.Fprintf(os.Stdout, "Revoked %d sessions\n", res.Revoked)
+
+			return nil
+		},
+	}
+}
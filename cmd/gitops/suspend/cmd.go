@@ -3,6 +3,7 @@ package suspend
 import (
 	"github.com/spf13/cobra"
 	"github.com/weaveworks/weave-gitops/cmd/gitops/config"
+	"github.com/weaveworks/weave-gitops/cmd/gitops/suspend/flux"
 	"github.com/weaveworks/weave-gitops/cmd/gitops/suspend/terraform"
 )
 
@@ -13,10 +14,17 @@ func Command(opts *config.Options) *cobra.Command {
 		Example: `
 # Suspend a Terraform object in the "flux-system" namespace
 gitops resume terraform --namespace flux-system my-resource
+
+# Suspend a Kustomization until tomorrow morning, through the Weave GitOps API
+gitops suspend kustomization --endpoint https://gitops.example.com my-app --until 2023-12-23T08:00:00Z
+
+# Suspend all the Helm repositories in the "flux-system" namespace
+gitops suspend source helm -l app.kubernetes.io/part-of=flux
 `,
 	}
 
 	cmd.AddCommand(terraform.Command(opts))
+	cmd.AddCommand(flux.Commands(opts)...)
 
 	return cmd
 }
@@ -0,0 +1,88 @@
+package flux
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/weaveworks/weave-gitops/cmd/gitops/config"
+	"github.com/weaveworks/weave-gitops/cmd/gitops/objects"
+	pb "github.com/weaveworks/weave-gitops/pkg/api/core"
+)
+
+// Commands returns the commands suspending Flux objects through the API.
+func Commands(opts *config.Options) []*cobra.Command {
+	return objects.KindCommands(func(k objects.KindCommand) *cobra.Command {
+		return command(opts, k)
+	})
+}
+
+func command(opts *config.Options, k objects.KindCommand) *cobra.Command {
+	var (
+		selection objects.SelectionFlags
+		until     string
+		comment   string
+		output    string
+	)
+
+	cmd := &cobra.Command{
+		Use:     k.Name + " [NAME...]",
+		Aliases: k.Aliases,
+		Short:   fmt.Sprintf("Suspend %s objects", strings.Join(k.Kinds, ", ")),
+		Example: fmt.Sprintf(`
+# Suspend the %[1]s objects labelled tier=prod in all namespaces for two hours
+gitops suspend %[1]s -l tier=prod -A --until 2h --comment "Database migration"
+`, k.Path),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := objects.ValidateOutput(output); err != nil {
+				return err
+			}
+
+			refs, selectors, err := selection.Selection(cmd, k.Kinds, args)
+			if err != nil {
+				return err
+			}
+
+			req := &pb.ToggleSuspendResourceRequest{
+				Objects:   refs,
+				Selectors: selectors,
+				Suspend:   true,
+				Comment:   comment,
+			}
+
+			if until != "" {
+				t, err := objects.ParseTime(until, time.Now())
+				if err != nil {
+					return fmt.Errorf("invalid --until: %w", err)
+				}
+
+				req.Until = t.UTC().Format(time.RFC3339)
+			}
+
+			c, err := objects.NewClient(opts)
+			if err != nil {
+				return err
+			}
+
+			res, err := c.ToggleSuspendResource(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			if req.Until != "" && output == objects.OutputTable {
+				fmt.Fprintf(os.Stdout, "Resuming at %s\n", req.Until)
+			}
+
+			return objects.PrintResults(os.Stdout, output, res, res.Results)
+		},
+	}
+
+	selection.AddFlags(cmd.Flags())
+	objects.AddOutputFlag(cmd.Flags(), &output)
+	cmd.Flags().StringVar(&until, "until", "", "Resume the objects at this RFC3339 time or after this duration, e.g. 2h")
+	cmd.Flags().StringVar(&comment, "comment", "", "Why the objects are suspended")
+
+	return cmd
+}
@@ -0,0 +1,188 @@
+package token
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/weaveworks/weave-gitops/cmd/gitops/cmderrors"
+	"github.com/weaveworks/weave-gitops/cmd/gitops/config"
+	"github.com/weaveworks/weave-gitops/cmd/gitops/objects"
+	pb "github.com/weaveworks/weave-gitops/pkg/api/core"
+)
+
+func Command(opts *config.Options) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "token",
+		Short: "Manage personal access tokens, to use the API from scripts and pipelines",
+		Example: `
+# Create a read-only token for a CI pipeline, lasting 90 days
+gitops token create ci --read-only --expires-in 2160h
+
+# Use the token
+export WEAVE_GITOPS_TOKEN=wgpat_...
+gitops freeze list
+
+# List your tokens
+gitops token list
+
+# Revoke a token
+gitops token delete 4gk8zq2hxm9w
+`,
+	}
+
+	cmd.AddCommand(createCommand(opts))
+	cmd.AddCommand(listCommand(opts))
+	cmd.AddCommand(deleteCommand(opts))
+
+	return cmd
+}
+
+func createCommand(opts *config.Options) *cobra.Command {
+	var (
+		expiresIn time.Duration
+		readOnly  bool
+		clusters  []string
+		output    string
+	)
+
+	cmd := &cobra.Command{
+		Use:   "create NAME",
+		Short: "Create a personal access token",
+		Long: `Create a personal access token acting as you, with the groups you have now,
+until it expires. The token can be restricted to read-only access with
+--read-only, and to some clusters with --cluster. It is only shown once.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) == 0 {
+				return cmderrors.ErrNoName
+			}
+
+			if len(args) > 1 {
+				return cmderrors.ErrMultipleNames
+			}
+
+			if err := objects.ValidateOutput(output); err != nil {
+				return err
+			}
+
+			c, err := objects.NewClient(opts)
+			if err != nil {
+				return err
+			}
+
+			req := &pb.CreateAccessTokenRequest{
+				Name:     args[0],
+				ReadOnly: readOnly,
+				Clusters: clusters,
+			}
+
+			if expiresIn != 0 {
+				req.ExpiresIn = expiresIn.String()
+			}
+
+			res, err := c.CreateAccessToken(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			if output != objects.OutputTable {
+				return objects.PrintMessage(os.Stdout, output, res)
+			}
+
+			fmt.Fprintf(os.Stderr, "Created token %s (%s), expiring at %s. It won't be shown again:\n", res.AccessToken.Name, res.AccessToken.Id, res.AccessToken.ExpiresAt)
+			fmt.Fprintln(os.Stdout, res.Token)
+
+			return nil
+		},
+	}
+
+	cmd.Flags().DurationVar(&expiresIn, "expires-in", 0, "How long the token lasts, 30 days (720h) if not set and at most a year")
+	cmd.Flags().BoolVar(&readOnly, "read-only", false, "Only allow the token to read, not to change anything")
+	cmd.Flags().StringArrayVar(&clusters, "cluster", nil, "A cluster the token can be used with, can be repeated. All the clusters if not set")
+	objects.AddOutputFlag(cmd.Flags(), &output)
+
+	return cmd
+}
+
+func listCommand(opts *config.Options) *cobra.Command {
+	var output string
+
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List your personal access tokens",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := objects.ValidateOutput(output); err != nil {
+				return err
+			}
+
+			c, err := objects.NewClient(opts)
+			if err != nil {
+				return err
+			}
+
+			res, err := c.ListAccessTokens(cmd.Context(), &pb.ListAccessTokensRequest{})
+			if err != nil {
+				return err
+			}
+
+			if output != objects.OutputTable {
+				return objects.PrintMessage(os.Stdout, output, res)
+			}
+
+			if len(res.AccessTokens) == 0 {
+				fmt.Fprintln(os.Stdout, "No access tokens")
+				return nil
+			}
+
+			rows := [][]string{}
+			for _, t := range res.AccessTokens {
+				access := "read-write"
+				if t.ReadOnly {
+					access = "read-only"
+				}
+
+				clusters := "all"
+				if len(t.Clusters) > 0 {
+					clusters = strings.Join(t.Clusters, ",")
+				}
+
+				rows = append(rows, []string{t.Id, t.Name, access, clusters, t.CreatedAt, t.ExpiresAt})
+			}
+
+			return objects.PrintTable(os.Stdout, []string{"ID", "NAME", "ACCESS", "CLUSTERS", "CREATED", "EXPIRES"}, rows)
+		},
+	}
+
+	objects.AddOutputFlag(cmd.Flags(), &output)
+
+	return cmd
+}
+
+func deleteCommand(opts *config.Options) *cobra.Command {
+	return &cobra.Command{
+		Use:   "delete ID",
+		Short: "Revoke a personal access token",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) != 1 {
+				return errors.New("exactly one ID is required")
+			}
+
+			c, err := objects.NewClient(opts)
+			if err != nil {
+				return err
+			}
+
+			if _, err := c.DeleteAccessToken(cmd.Context(), &pb.DeleteAccessTokenRequest{Id: args[0]}); err != nil {
+				return err
+			}
+
+			fmt.Fprintf(os.Stdout, "Deleted token %s\n", args[0])
+
+			return nil
+		},
+	}
+}
@@ -0,0 +1,75 @@
+package validate
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/weaveworks/weave-gitops/cmd/gitops/config"
+	"github.com/weaveworks/weave-gitops/pkg/logger"
+	"github.com/weaveworks/weave-gitops/pkg/validate"
+	"github.com/weaveworks/weave-gitops/pkg/version"
+)
+
+func Command(opts *config.Options) *cobra.Command {
+	var validateOpts validate.Options
+
+	cmd := &cobra.Command{
+		Use:   "validate DIR",
+		Short: "Validate the manifests of a directory against the Kubernetes and Flux schemas",
+		Long: `Validate the YAML manifests of a directory against the schemas of Kubernetes
+and Flux, reporting all the invalid resources. The files ignored by the
+.sourceignore file of the root directory are left out, like Flux does.
+
+The Flux Kustomizations and the kustomizations of the directory are built
+with kustomize, and the resources they render validated too, unless
+--skip-build is set. The errors of the resources rendered by Flux
+Kustomizations tell which one they belong to. The resources applied to a
+cluster by several Flux Kustomizations, and the post build substitution
+variables without a value are reported.
+
+The schemas are downloaded once and cached. With --offline only the cached
+schemas are used, which can be seeded with --schema-bundle, a tar.gz archive
+laid out as the cache:
+
+  flux/<flux version>/master-standalone-strict/*.json
+  kubernetes/<kubernetes version>-standalone-strict/*.json
+
+The resources without a schema are reported as errors, unless
+--ignore-missing-schemas is set to skip them, e.g. those of custom resources
+other than Flux ones.`,
+		Example: `
+# Validate the manifests of the clusters directory
+gitops validate ./clusters
+
+# Validate against the schemas of Kubernetes v1.28.0, for a code scanning tool
+gitops validate ./clusters --kubernetes-version v1.28.0 --output sarif > results.sarif
+
+# Validate without network access, from a bundle of schemas
+gitops validate ./clusters --offline --schema-bundle schemas.tar.gz --output junit
+`,
+		Args:          cobra.ExactArgs(1),
+		SilenceUsage:  true,
+		SilenceErrors: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			// the results are written to stdout, the logs mustn't mix with them
+			log := logger.NewCLILogger(os.Stderr)
+
+			return validate.Validate(log, args[0], validateOpts)
+		},
+	}
+
+	cmd.Flags().StringVar(&validateOpts.KubernetesVersion, "kubernetes-version", "", "The version of Kubernetes to validate against, the latest if not set")
+	cmd.Flags().StringVar(&validateOpts.FluxVersion, "flux-version", version.FluxVersion, "The version of Flux to validate against")
+	cmd.Flags().StringVarP(&validateOpts.Output, "output", "o", validate.OutputText, fmt.Sprintf("The output format, one of %s", strings.Join(validate.Outputs, ", ")))
+	cmd.Flags().StringVar(&validateOpts.RootDir, "root-dir", "", "The root of the repository, whose .sourceignore file is honoured and the paths of the Flux Kustomizations are relative to. The git repository of the directory if not set")
+	cmd.Flags().StringSliceVar(&validateOpts.SourceNames, "source", nil, "The name of a Flux source of the repository, only the Flux Kustomizations of those sources are built. All of them if not set")
+	cmd.Flags().BoolVar(&validateOpts.SkipBuild, "skip-build", false, "Only validate the files, without building the Flux Kustomizations and kustomizations")
+	cmd.Flags().StringVar(&validateOpts.CacheDir, "cache-dir", "", "The directory the schemas are cached in, in the user cache directory if not set")
+	cmd.Flags().StringVar(&validateOpts.SchemaBundle, "schema-bundle", "", "A tar.gz archive of schemas to seed the cache with")
+	cmd.Flags().BoolVar(&validateOpts.Offline, "offline", false, "Only use the cached schemas, never downloading any")
+	cmd.Flags().BoolVar(&validateOpts.IgnoreMissingSchemas, "ignore-missing-schemas", false, "Skip the resources without a schema instead of reporting them as errors")
+
+	return cmd
+}
@@ -0,0 +1,151 @@
+// Package audit records who changed what through the API, and who logged in
+// and out, to durable sinks like files, webhooks or S3 buckets.
+package audit
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc/metadata"
+)
+
+const (
+	ActionSuspend           = "Suspend"
+	ActionResume            = "Resume"
+	ActionSync              = "Sync"
+	ActionCreateFreeze      = "CreateFreeze"
+	ActionDeleteFreeze      = "DeleteFreeze"
+	ActionLogin             = "Login"
+	ActionLogout            = "Logout"
+	ActionCreateAccessToken = "CreateAccessToken"
+	ActionDeleteAccessToken = "DeleteAccessToken"
+	ActionRevokeSessions    = "RevokeSessions"
+)
+
+const (
+	OutcomeSuccess = "Success"
+	OutcomeFailure = "Failure"
+)
+
+// ObjectRef identifies an object targeted by an action.
+type ObjectRef struct {
+	ClusterName string `json:"clusterName,omitempty"`
+	Kind        string `json:"kind"`
+	Namespace   string `json:"namespace,omitempty"`
+	Name        string `json:"name"`
+}
+
+// Event is an entry of the audit log.
+type Event struct {
+	ID        string    `json:"id"`
+	Timestamp time.Time `json:"timestamp"`
+	// Principal and Groups identify the user, with the prefixes of their
+	// OIDC provider if it has its own. Principal is the user name they tried
+	// to log in with for failed logins.
+	Principal string   `json:"principal,omitempty"`
+	Groups    []string `json:"groups,omitempty"`
+	Action    string   `json:"action"`
+	// ClusterName is the cluster of the objects, when they are all on the
+	// same one.
+	ClusterName string      `json:"clusterName,omitempty"`
+	Objects     []ObjectRef `json:"objects,omitempty"`
+	Outcome     string      `json:"outcome"`
+	Message     string      `json:"message,omitempty"`
+	// SourceIP is the address of the client, followed by the proxies the
+	// request went through, as in X-Forwarded-For.
+	SourceIP string `json:"sourceIP,omitempty"`
+}
+
+// Query selects events. Zero values are ignored.
+type Query struct {
+	Principal   string
+	Action      string
+	ClusterName string
+	Since       time.Time
+	Until       time.Time
+	Limit       int
+}
+
+func (q Query) matches(e Event) bool {
+	if q.Principal != "" && e.Principal != q.Principal {
+		return false
+	}
+
+	if q.Action != "" && e.Action != q.Action {
+		return false
+	}
+
+	if q.ClusterName != "" && e.ClusterName != q.ClusterName {
+		return false
+	}
+
+	if !q.Since.IsZero() && e.Timestamp.Before(q.Since) {
+		return false
+	}
+
+	if !q.Until.IsZero() && e.Timestamp.After(q.Until) {
+		return false
+	}
+
+	return true
+}
+
+// Admins are the users allowed to read the audit log, by name or group. The
+// users of the OIDC providers with their own prefixes are named, as are
+// their groups, with those prefixes.
+type Admins struct {
+	Users  []string
+	Groups []string
+}
+
+// Allows tells whether a user is an admin.
+func (a Admins) Allows(user string, groups []string) bool {
+	for _, u := range a.Users {
+		if u == user {
+			return true
+		}
+	}
+
+	for _, g := range a.Groups {
+		for _, group := range groups {
+			if g == group {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// SourceIP returns the address of the client of an API call, which the
+// gateway passes in the X-Forwarded-For metadata.
+func SourceIP(ctx context.Context) string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ""
+	}
+
+	return strings.Join(md.Get("x-forwarded-for"), ", ")
+}
+
+// RequestSourceIP returns the address of the client of an HTTP request the
+// same way the gateway does, following the X-Forwarded-For header.
+func RequestSourceIP(r *http.Request) string {
+	remoteIP := r.RemoteAddr
+	if host, _, err := net.SplitHostPort(r.RemoteAddr); err == nil {
+		remoteIP = host
+	}
+
+	if fwd := r.Header.Get("X-Forwarded-For"); fwd != "" {
+		if remoteIP == "" {
+			return fwd
+		}
+
+		return fwd + ", " + remoteIP
+	}
+
+	return remoteIP
+}
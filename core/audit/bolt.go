@@ -0,0 +1,130 @@
+package audit
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	bolt "go.etcd.io/bbolt"
+)
+
+var eventsBucket = []byte("events")
+
+// Store is a sink the events can be listed back from.
+type Store interface {
+	Sink
+	// List returns the events matching the query, newest first.
+	List(ctx context.Context, query Query) ([]Event, error)
+}
+
+// BoltSink keeps the events in a bbolt database for the retention, where
+// they can be listed from after the server restarts.
+type BoltSink struct {
+	db        *bolt.DB
+	retention time.Duration
+}
+
+// NewBoltSink returns a sink keeping the events in a bbolt database at path,
+// creating it if it doesn't exist, for the retention, forever if it's zero.
+func NewBoltSink(path string, retention time.Duration) (*BoltSink, error) {
+	db, err := bolt.Open(path, 0o600, &bolt.Options{Timeout: 5 * time.Second})
+	if err != nil {
+		return nil, fmt.Errorf("opening audit database: %w", err)
+	}
+
+	if err := db.Update(func(tx *bolt.Tx) error {
+		_, err := tx.CreateBucketIfNotExists(eventsBucket)
+		return err
+	}); err != nil {
+		db.Close()
+		return nil, fmt.Errorf("initialising audit database: %w", err)
+	}
+
+	return &BoltSink{db: db, retention: retention}, nil
+}
+
+// Write stores the event keyed by timestamp and a sequence number, so the
+// keys sort chronologically, and deletes the events older than the
+// retention.
+func (s *BoltSink) Write(ctx context.Context, event Event) error {
+	data, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+
+	return s.db.Update(func(tx *bolt.Tx) error {
+		events := tx.Bucket(eventsBucket)
+
+		seq, err := events.NextSequence()
+		if err != nil {
+			return err
+		}
+
+		key := make([]byte, 16)
+		binary.BigEndian.PutUint64(key[:8], uint64(event.Timestamp.UnixNano()))
+		binary.BigEndian.PutUint64(key[8:], seq)
+
+		if err := events.Put(key, data); err != nil {
+			return err
+		}
+
+		if s.retention <= 0 {
+			return nil
+		}
+
+		end := make([]byte, 8)
+		binary.BigEndian.PutUint64(end, uint64(time.Now().Add(-s.retention).UnixNano()))
+
+		c := events.Cursor()
+
+		// The first key is the oldest event left after each deletion.
+		for k, _ := c.First(); k != nil && bytes.Compare(k[:8], end) < 0; k, _ = c.First() {
+			if err := c.Delete(); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
+func (s *BoltSink) List(ctx context.Context, query Query) ([]Event, error) {
+	events := []Event{}
+
+	err := s.db.View(func(tx *bolt.Tx) error {
+		c := tx.Bucket(eventsBucket).Cursor()
+
+		for k, v := c.Last(); k != nil; k, v = c.Prev() {
+			var event Event
+			if err := json.Unmarshal(v, &event); err != nil {
+				return fmt.Errorf("decoding audit event: %w", err)
+			}
+
+			if !query.Since.IsZero() && event.Timestamp.Before(query.Since) {
+				break
+			}
+
+			if !query.matches(event) {
+				continue
+			}
+
+			events = append(events, event)
+
+			if query.Limit > 0 && len(events) >= query.Limit {
+				break
+			}
+		}
+
+		return nil
+	})
+
+	return events, err
+}
+
+// Close closes the database.
+func (s *BoltSink) Close() error {
+	return s.db.Close()
+}
@@ -0,0 +1,64 @@
+package audit_test
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	"github.com/weaveworks/weave-gitops/core/audit"
+)
+
+func TestBoltSink(t *testing.T) {
+	g := NewGomegaWithT(t)
+	ctx := context.Background()
+
+	path := filepath.Join(t.TempDir(), "audit.db")
+
+	sink, err := audit.NewBoltSink(path, 24*time.Hour)
+	g.Expect(err).NotTo(HaveOccurred())
+
+	now := time.Now().UTC().Truncate(time.Second)
+
+	for i, action := range []string{audit.ActionSuspend, audit.ActionResume, audit.ActionSync} {
+		g.Expect(sink.Write(ctx, audit.Event{
+			ID:          action,
+			Timestamp:   now.Add(time.Duration(i-3) * time.Hour),
+			Principal:   "anne",
+			Action:      action,
+			ClusterName: "Default",
+		})).To(Succeed())
+	}
+
+	g.Expect(sink.Write(ctx, audit.Event{ID: "login", Timestamp: now, Principal: "bob", Action: audit.ActionLogin})).To(Succeed())
+
+	events, err := sink.List(ctx, audit.Query{})
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(actions(events)).To(Equal([]string{audit.ActionLogin, audit.ActionSync, audit.ActionResume, audit.ActionSuspend}))
+
+	events, err = sink.List(ctx, audit.Query{Principal: "anne", Since: now.Add(-150 * time.Minute), Limit: 1})
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(actions(events)).To(Equal([]string{audit.ActionSync}))
+
+	// The events survive reopening the database.
+	g.Expect(sink.Close()).To(Succeed())
+
+	sink, err = audit.NewBoltSink(path, 2*time.Hour)
+	g.Expect(err).NotTo(HaveOccurred())
+
+	defer sink.Close()
+
+	events, err = sink.List(ctx, audit.Query{Action: audit.ActionSuspend})
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(events).To(HaveLen(1))
+	g.Expect(events[0].ClusterName).To(Equal("Default"))
+	g.Expect(events[0].Timestamp.Equal(now.Add(-3 * time.Hour))).To(BeTrue())
+
+	// The events older than the retention are deleted as new ones come.
+	g.Expect(sink.Write(ctx, audit.Event{ID: "logout", Timestamp: now, Principal: "bob", Action: audit.ActionLogout})).To(Succeed())
+
+	events, err = sink.List(ctx, audit.Query{})
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(actions(events)).To(Equal([]string{audit.ActionLogout, audit.ActionLogin, audit.ActionSync}))
+}
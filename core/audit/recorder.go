@@ -0,0 +1,196 @@
+package audit
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/prometheus/client_golang/prometheus"
+	"k8s.io/apimachinery/pkg/util/rand"
+)
+
+// DefaultRetained is how many of the recent events are kept in memory to be
+// listed.
+const DefaultRetained = 1000
+
+const (
+	// queueSize is how many events can wait to be written to the sinks,
+	// the events recorded while the queue is full are dropped.
+	queueSize = 1000
+	// sinkTimeout bounds how long writing an event to a sink can take.
+	sinkTimeout = 10 * time.Second
+	// closeTimeout bounds how long closing the recorder waits for the queued
+	// events to be written.
+	closeTimeout = 10 * time.Second
+)
+
+var (
+	opsEventsDropped = prometheus.NewCounter(
+		prometheus.CounterOpts{
+			Namespace: "gitops",
+			Subsystem: "audit",
+			Name:      "events_dropped_total",
+			Help:      "The number of audit events not written to the sinks because too many were waiting to be",
+		},
+	)
+
+	Registry = prometheus.NewRegistry()
+)
+
+func registerMetrics() {
+	_ = Registry.Register(opsEventsDropped)
+}
+
+// Recorder writes the events to all its sinks in the background, and keeps
+// the most recent ones in memory to be listed. A nil Recorder records
+// nothing.
+type Recorder struct {
+	log   logr.Logger
+	sinks []Sink
+
+	queue chan Event
+	done  chan struct{}
+
+	mu       sync.Mutex
+	closed   bool
+	retained int
+	events   []Event
+	next     int
+}
+
+func NewRecorder(log logr.Logger, retained int, sinks ...Sink) *Recorder {
+	registerMetrics()
+
+	r := &Recorder{
+		log:      log.WithName("audit"),
+		sinks:    sinks,
+		queue:    make(chan Event, queueSize),
+		done:     make(chan struct{}),
+		retained: retained,
+	}
+
+	go r.writeEvents()
+
+	return r
+}
+
+// Record sets the ID and timestamp of an event if unset, and queues it to be
+// written to all the sinks, so that slow sinks don't delay the call audited.
+// The event is still written if the call is cancelled.
+func (r *Recorder) Record(_ context.Context, event Event) {
+	if r == nil {
+		return
+	}
+
+	if event.ID == "" {
+		event.ID = rand.String(16)
+	}
+
+	if event.Timestamp.IsZero() {
+		event.Timestamp = time.Now().UTC()
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.retain(event)
+
+	if r.closed || len(r.sinks) == 0 {
+		return
+	}
+
+	select {
+	case r.queue <- event:
+	default:
+		opsEventsDropped.Inc()
+		r.log.Info("dropped audit event, the sinks are too slow", "id", event.ID, "action", event.Action, "principal", event.Principal)
+	}
+}
+
+// Close stops queueing the events, and waits for those queued to be written
+// to the sinks, for at most closeTimeout.
+func (r *Recorder) Close() {
+	if r == nil {
+		return
+	}
+
+	r.mu.Lock()
+	if r.closed {
+		r.mu.Unlock()
+		return
+	}
+
+	r.closed = true
+	close(r.queue)
+	r.mu.Unlock()
+
+	select {
+	case <-r.done:
+	case <-time.After(closeTimeout):
+		r.log.Info("gave up writing the queued audit events", "queued", len(r.queue))
+	}
+}
+
+func (r *Recorder) writeEvents() {
+	defer close(r.done)
+
+	for event := range r.queue {
+		r.write(event)
+	}
+}
+
+// write writes an event to all the sinks. Failing to write to a sink is
+// logged rather than failing the call audited.
+func (r *Recorder) write(event Event) {
+	ctx, cancel := context.WithTimeout(context.Background(), sinkTimeout)
+	defer cancel()
+
+	for _, sink := range r.sinks {
+		if err := sink.Write(ctx, event); err != nil {
+			r.log.Error(err, "failed writing audit event", "id", event.ID, "action", event.Action, "principal", event.Principal)
+		}
+	}
+}
+
+func (r *Recorder) retain(event Event) {
+	if r.retained <= 0 {
+		return
+	}
+
+	if len(r.events) < r.retained {
+		r.events = append(r.events, event)
+		return
+	}
+
+	r.events[r.next] = event
+	r.next = (r.next + 1) % r.retained
+}
+
+// List returns the retained events matching the query, newest first.
+func (r *Recorder) List(query Query) []Event {
+	if r == nil {
+		return nil
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	result := []Event{}
+
+	for i := len(r.events) - 1; i >= 0; i-- {
+		e := r.events[(r.next+i)%len(r.events)]
+
+		if !query.matches(e) {
+			continue
+		}
+
+		result = append(result, e)
+
+		if query.Limit > 0 && len(result) == query.Limit {
+			break
+		}
+	}
+
+	return result
+}
@@ -0,0 +1,157 @@
+package audit_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/gomega"
+	"github.com/weaveworks/weave-gitops/core/audit"
+)
+
+func TestRecorder(t *testing.T) {
+	g := NewGomegaWithT(t)
+	ctx := context.Background()
+
+	out := &bytes.Buffer{}
+	r := audit.NewRecorder(logr.Discard(), 3, audit.NewWriterSink(out), failingSink{})
+
+	start := time.Date(2024, 1, 31, 12, 0, 0, 0, time.UTC)
+
+	for i, action := range []string{audit.ActionLogin, audit.ActionSuspend, audit.ActionSync, audit.ActionResume} {
+		r.Record(ctx, audit.Event{
+			Timestamp:   start.Add(time.Duration(i) * time.Minute),
+			Principal:   "anne",
+			Action:      action,
+			ClusterName: "Default",
+			Outcome:     audit.OutcomeSuccess,
+		})
+	}
+
+	// waits for the events to be written
+	r.Close()
+
+	t.Run("writes the events to the sinks despite failing ones", func(t *testing.T) {
+		g := NewGomegaWithT(t)
+
+		lines := strings.Split(strings.TrimSpace(out.String()), "\n")
+		g.Expect(lines).To(HaveLen(4))
+
+		var e audit.Event
+		g.Expect(json.Unmarshal([]byte(lines[0]), &e)).To(Succeed())
+		g.Expect(e.Action).To(Equal(audit.ActionLogin))
+		g.Expect(e.ID).NotTo(BeEmpty())
+	})
+
+	t.Run("lists the retained events newest first", func(t *testing.T) {
+		g := NewGomegaWithT(t)
+
+		events := r.List(audit.Query{})
+		g.Expect(actions(events)).To(Equal([]string{audit.ActionResume, audit.ActionSync, audit.ActionSuspend}))
+	})
+
+	t.Run("lists the events matching the query", func(t *testing.T) {
+		g := NewGomegaWithT(t)
+
+		g.Expect(actions(r.List(audit.Query{Action: audit.ActionSync}))).To(Equal([]string{audit.ActionSync}))
+		g.Expect(actions(r.List(audit.Query{Until: start.Add(2 * time.Minute)}))).To(Equal([]string{audit.ActionSync, audit.ActionSuspend}))
+		g.Expect(actions(r.List(audit.Query{Limit: 1}))).To(Equal([]string{audit.ActionResume}))
+		g.Expect(r.List(audit.Query{Principal: "bob"})).To(BeEmpty())
+		g.Expect(r.List(audit.Query{ClusterName: "other"})).To(BeEmpty())
+	})
+
+	t.Run("a nil recorder records nothing", func(t *testing.T) {
+		var r *audit.Recorder
+
+		r.Record(ctx, audit.Event{Action: audit.ActionLogin})
+		g.Expect(r.List(audit.Query{})).To(BeEmpty())
+	})
+}
+
+func TestRecorderStuckSink(t *testing.T) {
+	g := NewGomegaWithT(t)
+	ctx := context.Background()
+
+	release := make(chan struct{})
+	sink := &stuckSink{release: release}
+
+	r := audit.NewRecorder(logr.Discard(), 3, sink)
+
+	dropped := droppedEvents(g)
+	start := time.Now()
+
+	for i := 0; i < 2000; i++ {
+		r.Record(ctx, audit.Event{Principal: "anne", Action: audit.ActionSync})
+	}
+
+	g.Expect(time.Since(start)).To(BeNumerically("<", time.Second), "the caller isn't blocked by the sink")
+	g.Expect(droppedEvents(g)-dropped).To(BeNumerically(">=", 999), "the events over the queue size are dropped")
+	g.Expect(r.List(audit.Query{})).To(HaveLen(3), "the dropped events are still retained")
+
+	close(release)
+	r.Close()
+
+	g.Expect(sink.written()).To(BeNumerically(">=", 1000), "the queued events are written once the sink recovers")
+}
+
+func TestAdmins(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	admins := audit.Admins{Users: []string{"anne"}, Groups: []string{"auditors"}}
+
+	g.Expect(admins.Allows("anne", nil)).To(BeTrue())
+	g.Expect(admins.Allows("bob", []string{"developers", "auditors"})).To(BeTrue())
+	g.Expect(admins.Allows("bob", []string{"developers"})).To(BeFalse())
+	g.Expect(audit.Admins{}.Allows("anne", nil)).To(BeFalse())
+}
+
+func actions(events []audit.Event) []string {
+	result := []string{}
+	for _, e := range events {
+		result = append(result, e.Action)
+	}
+
+	return result
+}
+
+func droppedEvents(g *WithT) float64 {
+	families, err := audit.Registry.Gather()
+	g.Expect(err).NotTo(HaveOccurred())
+
+	for _, f := range families {
+		if f.GetName() == "gitops_audit_events_dropped_total" {
+			return f.GetMetric()[0].GetCounter().GetValue()
+		}
+	}
+
+	return 0
+}
+
+// stuckSink blocks the writes until released.
+type stuckSink struct {
+	release <-chan struct{}
+	count   int32
+}
+
+func (s *stuckSink) Write(ctx context.Context, event audit.Event) error {
+	<-s.release
+	atomic.AddInt32(&s.count, 1)
+
+	return nil
+}
+
+func (s *stuckSink) written() int32 {
+	return atomic.LoadInt32(&s.count)
+}
+
+type failingSink struct{}
+
+func (failingSink) Write(ctx context.Context, event audit.Event) error {
+	return errors.New("unavailable")
+}
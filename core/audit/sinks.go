@@ -0,0 +1,142 @@
+package audit
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path"
+	"sync"
+
+	"github.com/minio/minio-go/v7"
+)
+
+// Sink durably writes audit events.
+type Sink interface {
+	Write(ctx context.Context, event Event) error
+}
+
+// WriterSink writes the events as JSON lines, e.g. to stdout.
+type WriterSink struct {
+	mu sync.Mutex
+	w  io.Writer
+}
+
+func NewWriterSink(w io.Writer) *WriterSink {
+	return &WriterSink{w: w}
+}
+
+func (s *WriterSink) Write(ctx context.Context, event Event) error {
+	b, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	_, err = s.w.Write(append(b, '\n'))
+
+	return err
+}
+
+// FileSink appends the events as JSON lines to a file.
+type FileSink struct {
+	*WriterSink
+	f *os.File
+}
+
+// NewFileSink opens the file at path for appending, creating it if needed.
+func NewFileSink(path string) (*FileSink, error) {
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o600)
+	if err != nil {
+		return nil, fmt.Errorf("opening audit log: %w", err)
+	}
+
+	return &FileSink{WriterSink: NewWriterSink(f), f: f}, nil
+}
+
+// Close closes the file.
+func (s *FileSink) Close() error {
+	return s.f.Close()
+}
+
+// WebhookSink posts each event as JSON to a URL.
+type WebhookSink struct {
+	url    string
+	client *http.Client
+}
+
+func NewWebhookSink(url string, client *http.Client) *WebhookSink {
+	if client == nil {
+		client = http.DefaultClient
+	}
+
+	return &WebhookSink{url: url, client: client}
+}
+
+func (s *WebhookSink) Write(ctx context.Context, event Event) error {
+	b, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.url, bytes.NewReader(b))
+	if err != nil {
+		return err
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("posting audit event: %w", err)
+	}
+	defer resp.Body.Close()
+
+	_, _ = io.Copy(io.Discard, resp.Body)
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("posting audit event: unexpected status %s", resp.Status)
+	}
+
+	return nil
+}
+
+// s3Writer is the part of the minio client the S3 sink uses.
+type s3Writer interface {
+	PutObject(ctx context.Context, bucketName, objectName string, reader io.Reader, objectSize int64, opts minio.PutObjectOptions) (minio.UploadInfo, error)
+}
+
+// S3Sink writes each event as a JSON object of a bucket, named after the day
+// and time it happened, e.g. prefix/2024/01/31/12:00:00.000000000Z-<id>.json.
+type S3Sink struct {
+	client s3Writer
+	bucket string
+	prefix string
+}
+
+func NewS3Sink(client *minio.Client, bucket, prefix string) *S3Sink {
+	return &S3Sink{client: client, bucket: bucket, prefix: prefix}
+}
+
+func (s *S3Sink) Write(ctx context.Context, event Event) error {
+	b, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+
+	ts := event.Timestamp.UTC()
+	name := path.Join(s.prefix, ts.Format("2006/01/02"), fmt.Sprintf("%s-%s.json", ts.Format("15:04:05.000000000Z"), event.ID))
+
+	if _, err := s.client.PutObject(ctx, s.bucket, name, bytes.NewReader(b), int64(len(b)), minio.PutObjectOptions{
+		ContentType: "application/json",
+	}); err != nil {
+		return fmt.Errorf("uploading audit event: %w", err)
+	}
+
+	return nil
+}
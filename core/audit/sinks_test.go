@@ -0,0 +1,120 @@
+package audit
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/minio/minio-go/v7"
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc/metadata"
+)
+
+var testEvent = Event{
+	ID:        "abc",
+	Timestamp: time.Date(2024, 1, 31, 12, 0, 0, 0, time.UTC),
+	Principal: "anne",
+	Action:    ActionSync,
+	Objects:   []ObjectRef{{ClusterName: "Default", Kind: "Kustomization", Namespace: "flux-system", Name: "apps"}},
+	Outcome:   OutcomeSuccess,
+}
+
+func TestFileSink(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	path := filepath.Join(t.TempDir(), "audit.log")
+
+	for i := 0; i < 2; i++ {
+		sink, err := NewFileSink(path)
+		g.Expect(err).NotTo(HaveOccurred())
+		g.Expect(sink.Write(context.Background(), testEvent)).To(Succeed())
+		g.Expect(sink.Close()).To(Succeed())
+	}
+
+	b, err := os.ReadFile(path)
+	g.Expect(err).NotTo(HaveOccurred())
+
+	lines := strings.Split(strings.TrimSpace(string(b)), "\n")
+	g.Expect(lines).To(HaveLen(2), "the file is appended to")
+
+	var e Event
+	g.Expect(json.Unmarshal([]byte(lines[1]), &e)).To(Succeed())
+	g.Expect(e).To(Equal(testEvent))
+}
+
+func TestWebhookSink(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	var received Event
+
+	status := http.StatusOK
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		g.Expect(r.Method).To(Equal(http.MethodPost))
+		g.Expect(r.Header.Get("Content-Type")).To(Equal("application/json"))
+		g.Expect(json.NewDecoder(r.Body).Decode(&received)).To(Succeed())
+		w.WriteHeader(status)
+	}))
+	defer ts.Close()
+
+	sink := NewWebhookSink(ts.URL, nil)
+
+	g.Expect(sink.Write(context.Background(), testEvent)).To(Succeed())
+	g.Expect(received).To(Equal(testEvent))
+
+	status = http.StatusInternalServerError
+	g.Expect(sink.Write(context.Background(), testEvent)).To(MatchError(ContainSubstring("500")))
+}
+
+func TestS3Sink(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	client := &fakeS3Writer{objects: map[string]string{}}
+	sink := &S3Sink{client: client, bucket: "audit", prefix: "weave-gitops"}
+
+	g.Expect(sink.Write(context.Background(), testEvent)).To(Succeed())
+
+	body, ok := client.objects["audit/weave-gitops/2024/01/31/12:00:00.000000000Z-abc.json"]
+	g.Expect(ok).To(BeTrue(), "objects: %v", client.objects)
+
+	var e Event
+	g.Expect(json.Unmarshal([]byte(body), &e)).To(Succeed())
+	g.Expect(e).To(Equal(testEvent))
+}
+
+func TestSourceIP(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("x-forwarded-for", "10.0.0.1, 192.168.0.1"))
+	g.Expect(SourceIP(ctx)).To(Equal("10.0.0.1, 192.168.0.1"))
+	g.Expect(SourceIP(context.Background())).To(BeEmpty())
+
+	r := httptest.NewRequest(http.MethodPost, "/oauth2/sign_in", nil)
+	r.RemoteAddr = "192.168.0.1:4321"
+	g.Expect(RequestSourceIP(r)).To(Equal("192.168.0.1"))
+
+	r.Header.Set("X-Forwarded-For", "10.0.0.1")
+	g.Expect(RequestSourceIP(r)).To(Equal("10.0.0.1, 192.168.0.1"))
+}
+
+type fakeS3Writer struct {
+	objects map[string]string
+}
+
+func (f *fakeS3Writer) PutObject(ctx context.Context, bucketName, objectName string, reader io.Reader, objectSize int64, opts minio.PutObjectOptions) (minio.UploadInfo, error) {
+	b, err := io.ReadAll(reader)
+	if err != nil {
+		return minio.UploadInfo{}, err
+	}
+
+	f.objects[bucketName+"/"+objectName] = string(b)
+
+	return minio.UploadInfo{Bucket: bucketName, Key: objectName, Size: objectSize}, nil
+}
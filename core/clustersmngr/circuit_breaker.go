@@ -0,0 +1,216 @@
+package clustersmngr
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+)
+
+var (
+	// clusterRequestTimeout is how long all the lists of a ClusteredList
+	// have to complete on each cluster.
+	clusterRequestTimeout = getEnvDuration("WEAVE_GITOPS_CLUSTER_REQUEST_TIMEOUT", 30*time.Second)
+	// clusterListConcurrency is how many namespaces of a cluster are listed
+	// at the same time.
+	clusterListConcurrency = getEnvInt("WEAVE_GITOPS_CLUSTER_LIST_CONCURRENCY", 20)
+	// circuitBreakerThreshold is how many requests to a cluster need to fail
+	// in a row for the following ones to fail straight away.
+	circuitBreakerThreshold = getEnvInt("WEAVE_GITOPS_CLUSTER_CIRCUIT_BREAKER_THRESHOLD", 3)
+	// circuitBreakerCooldown is how long the requests to a cluster fail
+	// straight away before one is let through to try the cluster again.
+	circuitBreakerCooldown = getEnvDuration("WEAVE_GITOPS_CLUSTER_CIRCUIT_BREAKER_COOLDOWN", 30*time.Second)
+
+	opsClusterCircuitOpen = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "gitops",
+			Subsystem: "clustersmngr",
+			Name:      "cluster_circuit_open",
+			Help:      "Whether the requests to the cluster fail straight away after it failed recently",
+		},
+		[]string{"cluster"},
+	)
+)
+
+func getEnvInt(key string, defaultValue int) int {
+	val, err := strconv.Atoi(os.Getenv(key))
+
+	// on error, or if unset, return the default value
+	if err != nil || val <= 0 {
+		return defaultValue
+	}
+
+	return val
+}
+
+// CircuitOpenError is returned for the clusters that failed recently, rather
+// than waiting for the requests to time out again.
+type CircuitOpenError struct {
+	Cluster string
+	// Until is when a request is next let through to try the cluster again.
+	Until time.Time
+	Err   error
+}
+
+func (e CircuitOpenError) Error() string {
+	return fmt.Sprintf("cluster=%s failed recently, skipping it until %s: %s", e.Cluster, e.Until.Format(time.RFC3339), e.Err)
+}
+
+func (e CircuitOpenError) Unwrap() error {
+	return e.Err
+}
+
+// circuitBreakers keeps track of the failures of the requests to each cluster,
+// it is shared by all the clients of a manager.
+type circuitBreakers struct {
+	sync.Mutex
+	threshold int
+	cooldown  time.Duration
+	breakers  map[string]*circuitBreaker
+}
+
+type circuitBreaker struct {
+	failures  int
+	lastErr   error
+	openUntil time.Time
+	// whether a request was let through to try the cluster again after the
+	// cooldown, the others keep failing until it completes
+	trying bool
+}
+
+func newCircuitBreakers(threshold int, cooldown time.Duration) *circuitBreakers {
+	return &circuitBreakers{
+		threshold: threshold,
+		cooldown:  cooldown,
+		breakers:  map[string]*circuitBreaker{},
+	}
+}
+
+// allow returns an error if the requests to the cluster should fail straight
+// away.
+func (cb *circuitBreakers) allow(cluster string, now time.Time) error {
+	if cb == nil {
+		return nil
+	}
+
+	cb.Lock()
+	defer cb.Unlock()
+
+	b, found := cb.breakers[cluster]
+	if !found || b.failures < cb.threshold {
+		return nil
+	}
+
+	if now.Before(b.openUntil) || b.trying {
+		return CircuitOpenError{Cluster: cluster, Until: b.openUntil, Err: b.lastErr}
+	}
+
+	b.trying = true
+
+	return nil
+}
+
+// record records the outcome of a request to the cluster.
+func (cb *circuitBreakers) record(cluster string, err error, now time.Time) {
+	if cb == nil {
+		return
+	}
+
+	cb.Lock()
+	defer cb.Unlock()
+
+	b, found := cb.breakers[cluster]
+	if !found {
+		b = &circuitBreaker{}
+		cb.breakers[cluster] = b
+	}
+
+	b.trying = false
+
+	if err == nil {
+		b.failures = 0
+		b.lastErr = nil
+		b.openUntil = time.Time{}
+
+		opsClusterCircuitOpen.WithLabelValues(cluster).Set(0)
+
+		return
+	}
+
+	b.failures++
+	b.lastErr = err
+
+	if b.failures >= cb.threshold {
+		b.openUntil = now.Add(cb.cooldown)
+
+		opsClusterCircuitOpen.WithLabelValues(cluster).Set(1)
+	}
+}
+
+// release lets another request try the cluster again, when the outcome of
+// the one let through is unknown, e.g. as it was cancelled by the caller.
+func (cb *circuitBreakers) release(cluster string) {
+	if cb == nil {
+		return
+	}
+
+	cb.Lock()
+	defer cb.Unlock()
+
+	if b, found := cb.breakers[cluster]; found {
+		b.trying = false
+	}
+}
+
+// forget drops the failures of a cluster that was removed.
+func (cb *circuitBreakers) forget(cluster string) {
+	if cb == nil {
+		return
+	}
+
+	cb.Lock()
+	defer cb.Unlock()
+
+	delete(cb.breakers, cluster)
+	opsClusterCircuitOpen.DeleteLabelValues(cluster)
+}
+
+// isClusterFailure returns whether the error means the cluster is in trouble,
+// as opposed to the request being refused, e.g. for lack of permissions.
+func isClusterFailure(ctx context.Context, err error) bool {
+	if err == nil {
+		return false
+	}
+
+	// the caller gave up on the request, rather than the cluster failing it
+	if ctx.Err() != nil {
+		return false
+	}
+
+	if meta.IsNoMatchError(err) {
+		return false
+	}
+
+	if errors.Is(err, context.DeadlineExceeded) {
+		return true
+	}
+
+	var status k8serrors.APIStatus
+	if !errors.As(err, &status) {
+		// the API server couldn't be reached
+		return true
+	}
+
+	return k8serrors.IsTimeout(err) ||
+		k8serrors.IsServerTimeout(err) ||
+		k8serrors.IsServiceUnavailable(err) ||
+		k8serrors.IsInternalError(err) ||
+		k8serrors.IsTooManyRequests(err)
+}
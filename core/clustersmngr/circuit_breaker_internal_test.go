@@ -0,0 +1,300 @@
+package clustersmngr
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/gomega"
+	"github.com/weaveworks/weave-gitops/core/clustersmngr/cluster/clusterfakes"
+	v1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+)
+
+func TestCircuitBreakers(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	now := time.Now()
+	failure := errors.New("connection refused")
+
+	cb := newCircuitBreakers(2, time.Minute)
+
+	g.Expect(cb.allow("a", now)).To(Succeed())
+
+	cb.record("a", failure, now)
+	g.Expect(cb.allow("a", now)).To(Succeed(), "below the threshold")
+
+	cb.record("a", failure, now)
+
+	err := cb.allow("a", now)
+	g.Expect(err).To(BeAssignableToTypeOf(CircuitOpenError{}))
+	g.Expect(err).To(MatchError(ContainSubstring("connection refused")))
+	g.Expect(errors.Is(err, failure)).To(BeTrue())
+
+	g.Expect(cb.allow("b", now)).To(Succeed(), "other clusters aren't affected")
+
+	later := now.Add(2 * time.Minute)
+
+	g.Expect(cb.allow("a", later)).To(Succeed(), "one request is let through after the cooldown")
+	g.Expect(cb.allow("a", later)).NotTo(Succeed(), "until it completes")
+
+	cb.record("a", failure, later)
+	g.Expect(cb.allow("a", later)).NotTo(Succeed(), "the trial failed")
+
+	muchLater := later.Add(2 * time.Minute)
+
+	g.Expect(cb.allow("a", muchLater)).To(Succeed())
+	cb.release("a")
+	g.Expect(cb.allow("a", muchLater)).To(Succeed(), "the trial was cancelled")
+
+	cb.record("a", nil, muchLater)
+	g.Expect(cb.allow("a", muchLater)).To(Succeed())
+	g.Expect(cb.allow("a", muchLater)).To(Succeed())
+
+	var nilBreakers *circuitBreakers
+	g.Expect(nilBreakers.allow("a", now)).To(Succeed())
+	nilBreakers.record("a", failure, now)
+}
+
+func TestIsClusterFailure(t *testing.T) {
+	ctx := context.Background()
+
+	cancelled, cancel := context.WithCancel(ctx)
+	cancel()
+
+	gr := schema.GroupResource{Resource: "namespaces"}
+
+	tests := []struct {
+		name    string
+		ctx     context.Context
+		err     error
+		failure bool
+	}{
+		{name: "no error", ctx: ctx, err: nil, failure: false},
+		{name: "deadline exceeded", ctx: ctx, err: context.DeadlineExceeded, failure: true},
+		{name: "transport error", ctx: ctx, err: errors.New("dial tcp: connection refused"), failure: true},
+		{name: "service unavailable", ctx: ctx, err: k8serrors.NewServiceUnavailable("unavailable"), failure: true},
+		{name: "too many requests", ctx: ctx, err: k8serrors.NewTooManyRequests("slow down", 1), failure: true},
+		{name: "forbidden", ctx: ctx, err: k8serrors.NewForbidden(gr, "", errors.New("no")), failure: false},
+		{name: "not found", ctx: ctx, err: k8serrors.NewNotFound(gr, "default"), failure: false},
+		{name: "cancelled by the caller", ctx: cancelled, err: context.Canceled, failure: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewGomegaWithT(t)
+
+			g.Expect(isClusterFailure(tt.ctx, tt.err)).To(Equal(tt.failure))
+		})
+	}
+}
+
+func TestClusteredListSlowCluster(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	defer setClusterListSettings(100*time.Millisecond, 2)()
+
+	ctx := context.Background()
+
+	namespaces := []v1.Namespace{}
+	for i := 0; i < 6; i++ {
+		namespaces = append(namespaces, v1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: fmt.Sprintf("ns-%d", i)}})
+	}
+
+	var (
+		inFlight    int32
+		maxInFlight int32
+	)
+
+	fast := makeInterceptedClient(t, namespaces, interceptor.Funcs{
+		List: func(ctx context.Context, c client.WithWatch, list client.ObjectList, opts ...client.ListOption) error {
+			n := atomic.AddInt32(&inFlight, 1)
+			defer atomic.AddInt32(&inFlight, -1)
+
+			for {
+				m := atomic.LoadInt32(&maxInFlight)
+				if n <= m || atomic.CompareAndSwapInt32(&maxInFlight, m, n) {
+					break
+				}
+			}
+
+			time.Sleep(5 * time.Millisecond)
+
+			return c.List(ctx, list, opts...)
+		},
+	})
+
+	var slowCalls int32
+
+	slow := makeInterceptedClient(t, namespaces, interceptor.Funcs{
+		List: func(ctx context.Context, c client.WithWatch, list client.ObjectList, opts ...client.ListOption) error {
+			atomic.AddInt32(&slowCalls, 1)
+
+			// ignores the deadline, like the clients waiting on a cache
+			time.Sleep(time.Second)
+
+			return c.List(ctx, list, opts...)
+		},
+	})
+
+	pool := NewClustersClientsPool()
+	g.Expect(pool.Add(fast, makeNamedCluster("fast"))).To(Succeed())
+	g.Expect(pool.Add(slow, makeNamedCluster("slow"))).To(Succeed())
+
+	clustersClient := &clustersClient{
+		pool:       pool,
+		namespaces: map[string][]v1.Namespace{"fast": namespaces, "slow": namespaces},
+		log:        logr.Discard(),
+		breakers:   newCircuitBreakers(2, time.Minute),
+	}
+
+	list := func() (*ClusteredList, ClusteredListError, time.Duration) {
+		clist := NewClusteredList(func() client.ObjectList { return &v1.ConfigMapList{} }).(*ClusteredList)
+
+		start := time.Now()
+		err := clustersClient.ClusteredList(ctx, clist, true)
+		elapsed := time.Since(start)
+
+		var listErr ClusteredListError
+		g.Expect(errors.As(err, &listErr)).To(BeTrue())
+
+		return clist, listErr, elapsed
+	}
+
+	clist, listErr, elapsed := list()
+
+	g.Expect(elapsed).To(BeNumerically("<", 500*time.Millisecond), "doesn't wait on the slow cluster")
+	g.Expect(clist.Lists()["fast"]).To(HaveLen(len(namespaces)))
+	g.Expect(clist.Lists()).NotTo(HaveKey("slow"))
+	g.Expect(listErr.Errors).To(HaveLen(1))
+	g.Expect(listErr.Errors[0].Cluster).To(Equal("slow"))
+	g.Expect(errors.Is(listErr.Errors[0].Err, context.DeadlineExceeded)).To(BeTrue())
+
+	g.Expect(atomic.LoadInt32(&maxInFlight)).To(BeNumerically("<=", 2), "at most clusterListConcurrency lists at a time")
+	g.Expect(atomic.LoadInt32(&slowCalls)).To(BeNumerically("<=", 2))
+
+	_, _, _ = list()
+
+	callsBefore := atomic.LoadInt32(&slowCalls)
+
+	clist, listErr, elapsed = list()
+
+	g.Expect(elapsed).To(BeNumerically("<", 50*time.Millisecond), "fails straight away once the cluster failed repeatedly")
+	g.Expect(clist.Lists()["fast"]).To(HaveLen(len(namespaces)))
+	g.Expect(listErr.Errors).To(HaveLen(1))
+	g.Expect(listErr.Errors[0].Err).To(BeAssignableToTypeOf(CircuitOpenError{}))
+	g.Expect(atomic.LoadInt32(&slowCalls)).To(Equal(callsBefore), "the slow cluster isn't called")
+}
+
+func TestClusteredListRetriesTimedOutNamespaces(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	defer setClusterListSettings(100*time.Millisecond, 2)()
+
+	ctx := context.Background()
+
+	namespaces := []v1.Namespace{
+		{ObjectMeta: metav1.ObjectMeta{Name: "ns-a"}},
+		{ObjectMeta: metav1.ObjectMeta{Name: "ns-b"}},
+		{ObjectMeta: metav1.ObjectMeta{Name: "ns-c"}},
+	}
+
+	var (
+		mu     sync.Mutex
+		listed []string
+		slow   int32 = 1
+	)
+
+	c := makeInterceptedClient(t, namespaces, interceptor.Funcs{
+		List: func(ctx context.Context, c client.WithWatch, list client.ObjectList, opts ...client.ListOption) error {
+			listOpts := &client.ListOptions{}
+			listOpts.ApplyOptions(opts)
+
+			mu.Lock()
+			listed = append(listed, listOpts.Namespace)
+			mu.Unlock()
+
+			if listOpts.Namespace == "ns-b" && atomic.CompareAndSwapInt32(&slow, 1, 0) {
+				time.Sleep(time.Second)
+			}
+
+			return c.List(ctx, list, opts...)
+		},
+	})
+
+	pool := NewClustersClientsPool()
+	g.Expect(pool.Add(c, makeNamedCluster("leaf"))).To(Succeed())
+
+	clustersClient := &clustersClient{
+		pool:       pool,
+		namespaces: map[string][]v1.Namespace{"leaf": namespaces},
+		log:        logr.Discard(),
+		breakers:   newCircuitBreakers(10, time.Minute),
+	}
+
+	clist := NewClusteredList(func() client.ObjectList { return &v1.ConfigMapList{} })
+
+	err := clustersClient.ClusteredList(ctx, clist, true, client.Limit(10))
+	g.Expect(err).To(HaveOccurred())
+	g.Expect(clist.Lists()["leaf"]).To(HaveLen(2))
+
+	mu.Lock()
+	listed = nil
+	mu.Unlock()
+
+	next := NewClusteredList(func() client.ObjectList { return &v1.ConfigMapList{} })
+
+	err = clustersClient.ClusteredList(ctx, next, true, client.Limit(10), client.Continue(clist.GetContinue()))
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(listed).To(Equal([]string{"ns-b"}), "only the namespace that timed out is listed again")
+	g.Expect(next.Lists()["leaf"]).To(HaveLen(1))
+
+	pagination := &PaginationInfo{}
+	g.Expect(decodeFromBase64(pagination, next.GetContinue())).To(Succeed())
+	g.Expect(pagination.Incomplete("leaf", "ns-b")).To(BeFalse())
+}
+
+func setClusterListSettings(timeout time.Duration, concurrency int) func() {
+	previousTimeout, previousConcurrency := clusterRequestTimeout, clusterListConcurrency
+	clusterRequestTimeout, clusterListConcurrency = timeout, concurrency
+
+	return func() {
+		clusterRequestTimeout, clusterListConcurrency = previousTimeout, previousConcurrency
+	}
+}
+
+func makeInterceptedClient(t *testing.T, namespaces []v1.Namespace, funcs interceptor.Funcs) client.Client {
+	scheme := runtime.NewScheme()
+	if err := v1.AddToScheme(scheme); err != nil {
+		t.Fatal(err)
+	}
+
+	objects := []client.Object{}
+	for _, ns := range namespaces {
+		objects = append(objects, &v1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "cm", Namespace: ns.Name}})
+	}
+
+	return fakeclient.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(objects...).
+		WithInterceptorFuncs(funcs).
+		Build()
+}
+
+func makeNamedCluster(name string) *clusterfakes.FakeCluster {
+	c := &clusterfakes.FakeCluster{}
+	c.GetNameReturns(name)
+
+	return c
+}
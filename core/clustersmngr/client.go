@@ -13,6 +13,7 @@ import (
 
 	"github.com/go-logr/logr"
 	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/watch"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
@@ -41,6 +42,10 @@ type Client interface {
 	// that would be required to make sure the number of items returned match the limit passed.
 	ClusteredList(ctx context.Context, clist ClusteredObjectList, namespaced bool, opts ...client.ListOption) error
 
+	// Watch opens a watch for the objects of the given list type on the given cluster.
+	// The cluster client needs to implement client.WithWatch.
+	Watch(ctx context.Context, cluster string, list client.ObjectList, opts ...client.ListOption) (watch.Interface, error)
+
 	// ClientsPool returns the clients pool.
 	ClientsPool() ClientsPool
 
@@ -59,6 +64,12 @@ type clustersClient struct {
 	pool       ClientsPool
 	namespaces map[string][]v1.Namespace
 	log        logr.Logger
+	// the errors of the clusters known to be unreachable, requests to them
+	// fail straight away rather than timing out
+	unreachable map[string]error
+	// the recent failures of the lists of each cluster, the lists of those
+	// failing repeatedly fail straight away
+	breakers *circuitBreakers
 }
 
 type ListError struct {
@@ -96,6 +107,15 @@ func NewClient(clientsPool ClientsPool, namespaces map[string][]v1.Namespace, lo
 	}
 }
 
+// client returns the client of the cluster, unless it is unreachable.
+func (c *clustersClient) client(cluster string) (client.Client, error) {
+	if err, found := c.unreachable[cluster]; found {
+		return nil, err
+	}
+
+	return c.pool.Client(cluster)
+}
+
 func (c *clustersClient) ClientsPool() ClientsPool {
 	return c.pool
 }
@@ -105,7 +125,7 @@ func (c *clustersClient) Namespaces() map[string][]v1.Namespace {
 }
 
 func (c *clustersClient) Get(ctx context.Context, cluster string, key client.ObjectKey, obj client.Object) error {
-	client, err := c.pool.Client(cluster)
+	client, err := c.client(cluster)
 	if err != nil {
 		return err
 	}
@@ -117,7 +137,7 @@ func (c *clustersClient) Get(ctx context.Context, cluster string, key client.Obj
 }
 
 func (c *clustersClient) List(ctx context.Context, cluster string, list client.ObjectList, opts ...client.ListOption) error {
-	client, err := c.pool.Client(cluster)
+	client, err := c.client(cluster)
 	if err != nil {
 		return err
 	}
@@ -153,47 +173,43 @@ func (c *clustersClient) ClusteredList(ctx context.Context, clist ClusteredObjec
 	}
 
 	var (
-		errs = ClusteredListError{}
-		wg   = sync.WaitGroup{}
+		errs   = ClusteredListError{}
+		errsMu = sync.Mutex{}
+		wg     = sync.WaitGroup{}
 	)
 
+	addErrs := func(listErrs ...ListError) {
+		errsMu.Lock()
+		defer errsMu.Unlock()
+
+		for _, err := range listErrs {
+			errs.Add(err)
+		}
+	}
+
 	for clusterName, cc := range c.pool.Clients() {
+		if err, found := c.unreachable[clusterName]; found {
+			addErrs(ListError{Cluster: clusterName, Err: err})
+			continue
+		}
+
+		if err := c.breakers.allow(clusterName, time.Now()); err != nil {
+			addErrs(ListError{Cluster: clusterName, Err: err})
+			continue
+		}
+
 		namespaces := c.namespaces[clusterName]
 		if !namespaced {
 			namespaces = []v1.Namespace{{}}
 		}
 
-		for _, ns := range namespaces {
-			nsContinueToken := paginationInfo.Get(clusterName, ns.Name)
-
-			// a prior request has been made so this one comes with a previous token,
-			// but if the namespace token is empty we ignore it because all items have been returned.
-			if continueToken != "" && nsContinueToken == "" {
-				continue
-			}
-
-			listOpts := append(opts, client.Continue(nsContinueToken))
-			listOpts = append(listOpts, client.InNamespace(ns.Name))
+		wg.Add(1)
 
-			wg.Add(1)
+		go func(clusterName string, cc client.Client, namespaces []v1.Namespace) {
+			defer wg.Done()
 
-			go func(clusterName string, nsName string, c client.Client, optsWithNamespace ...client.ListOption) {
-				defer wg.Done()
-
-				list := clist.NewList()
-
-				ctx, cancel := context.WithTimeout(ctx, clientTimeout)
-				defer cancel()
-
-				if err := c.List(ctx, list, optsWithNamespace...); err != nil {
-					errs.Add(ListError{Cluster: clusterName, Namespace: nsName, Err: err})
-				}
-
-				paginationInfo.Set(clusterName, nsName, list.GetContinue())
-
-				clist.AddObjectList(clusterName, namespaces, list)
-			}(clusterName, ns.Name, cc, listOpts...)
-		}
+			addErrs(c.listCluster(ctx, clusterName, cc, namespaces, clist, paginationInfo, continueToken, opts...)...)
+		}(clusterName, cc, namespaces)
 	}
 
 	wg.Wait()
@@ -212,6 +228,145 @@ func (c *clustersClient) ClusteredList(ctx context.Context, clist ClusteredObjec
 	return nil
 }
 
+// listCluster lists the namespaces of a cluster, at most clusterListConcurrency
+// at a time. The lists not done by the cluster's deadline are given up on, so
+// a slow cluster doesn't hold up the others.
+func (c *clustersClient) listCluster(ctx context.Context, clusterName string, cc client.Client, namespaces []v1.Namespace, clist ClusteredObjectList, paginationInfo *PaginationInfo, continueToken string, opts ...client.ListOption) []ListError {
+	clusterCtx, cancel := context.WithTimeout(ctx, clusterRequestTimeout)
+	defer cancel()
+
+	type namespaceList struct {
+		namespace string
+		list      client.ObjectList
+		err       error
+	}
+
+	type namespaceRequest struct {
+		namespace     string
+		continueToken string
+	}
+
+	requests := []namespaceRequest{}
+
+	for _, ns := range namespaces {
+		nsContinueToken := paginationInfo.Get(clusterName, ns.Name)
+
+		// a prior request has been made so this one comes with a previous token,
+		// but if the namespace token is empty we ignore it because all items have been returned,
+		// unless its list didn't complete.
+		if continueToken != "" && nsContinueToken == "" && !paginationInfo.Incomplete(clusterName, ns.Name) {
+			continue
+		}
+
+		requests = append(requests, namespaceRequest{namespace: ns.Name, continueToken: nsContinueToken})
+	}
+
+	var (
+		// buffered so that the lists given up on don't block once done
+		done    = make(chan namespaceList, len(requests))
+		sem     = make(chan struct{}, clusterListConcurrency)
+		pending = len(requests)
+		lists   = []namespaceList{}
+	)
+
+start:
+	for _, req := range requests {
+		listOpts := append([]client.ListOption{}, opts...)
+		listOpts = append(listOpts, client.Continue(req.continueToken), client.InNamespace(req.namespace))
+
+		select {
+		case sem <- struct{}{}:
+		case <-clusterCtx.Done():
+			break start
+		}
+
+		go func(nsName string, listOpts []client.ListOption) {
+			defer func() { <-sem }()
+
+			list := clist.NewList()
+			err := cc.List(clusterCtx, list, listOpts...)
+
+			done <- namespaceList{namespace: nsName, list: list, err: err}
+		}(req.namespace, listOpts)
+	}
+
+wait:
+	for len(lists) < pending {
+		select {
+		case l := <-done:
+			lists = append(lists, l)
+		case <-clusterCtx.Done():
+			break wait
+		}
+	}
+
+	// keep the lists done just as the deadline passed
+	for len(lists) < pending && len(done) > 0 {
+		lists = append(lists, <-done)
+	}
+
+	var (
+		errs       = []ListError{}
+		clusterErr error
+	)
+
+	if len(lists) < pending {
+		clusterErr = fmt.Errorf("listing timed out after %s: %w", clusterRequestTimeout, clusterCtx.Err())
+		errs = append(errs, ListError{Cluster: clusterName, Err: clusterErr})
+	}
+
+	listed := map[string]bool{}
+
+	for _, l := range lists {
+		listed[l.namespace] = true
+
+		if l.err != nil {
+			errs = append(errs, ListError{Cluster: clusterName, Namespace: l.namespace, Err: l.err})
+
+			if clusterErr == nil && isClusterFailure(ctx, l.err) {
+				clusterErr = l.err
+			}
+		}
+
+		paginationInfo.Set(clusterName, l.namespace, l.list.GetContinue())
+
+		clist.AddObjectList(clusterName, namespaces, l.list)
+	}
+
+	// the namespaces given up on are listed again on the next page, from
+	// where this one started
+	for _, req := range requests {
+		if !listed[req.namespace] {
+			paginationInfo.SetIncomplete(clusterName, req.namespace, req.continueToken)
+		}
+	}
+
+	switch {
+	case ctx.Err() != nil:
+		c.breakers.release(clusterName)
+	case isClusterFailure(ctx, clusterErr):
+		c.breakers.record(clusterName, clusterErr, time.Now())
+	default:
+		c.breakers.record(clusterName, nil, time.Now())
+	}
+
+	return errs
+}
+
+func (c *clustersClient) Watch(ctx context.Context, cluster string, list client.ObjectList, opts ...client.ListOption) (watch.Interface, error) {
+	cl, err := c.client(cluster)
+	if err != nil {
+		return nil, err
+	}
+
+	wc, ok := cl.(client.WithWatch)
+	if !ok {
+		return nil, fmt.Errorf("client for cluster=%s does not support watches", cluster)
+	}
+
+	return wc.Watch(ctx, list, opts...)
+}
+
 func extractContinueToken(opts ...client.ListOption) string {
 	for _, o := range opts {
 		switch v := o.(type) {
@@ -226,7 +381,7 @@ func extractContinueToken(opts ...client.ListOption) string {
 }
 
 func (c *clustersClient) Create(ctx context.Context, cluster string, obj client.Object, opts ...client.CreateOption) error {
-	client, err := c.pool.Client(cluster)
+	client, err := c.client(cluster)
 	if err != nil {
 		return err
 	}
@@ -235,7 +390,7 @@ func (c *clustersClient) Create(ctx context.Context, cluster string, obj client.
 }
 
 func (c *clustersClient) Delete(ctx context.Context, cluster string, obj client.Object, opts ...client.DeleteOption) error {
-	client, err := c.pool.Client(cluster)
+	client, err := c.client(cluster)
 	if err != nil {
 		return err
 	}
@@ -244,7 +399,7 @@ func (c *clustersClient) Delete(ctx context.Context, cluster string, obj client.
 }
 
 func (c *clustersClient) Update(ctx context.Context, cluster string, obj client.Object, opts ...client.UpdateOption) error {
-	client, err := c.pool.Client(cluster)
+	client, err := c.client(cluster)
 	if err != nil {
 		return err
 	}
@@ -253,7 +408,7 @@ func (c *clustersClient) Update(ctx context.Context, cluster string, obj client.
 }
 
 func (c *clustersClient) Patch(ctx context.Context, cluster string, obj client.Object, patch client.Patch, opts ...client.PatchOption) error {
-	client, err := c.pool.Client(cluster)
+	client, err := c.client(cluster)
 	if err != nil {
 		return err
 	}
@@ -262,7 +417,7 @@ func (c *clustersClient) Patch(ctx context.Context, cluster string, obj client.O
 }
 
 func (c clustersClient) Scoped(cluster string) (client.Client, error) {
-	client, err := c.pool.Client(cluster)
+	client, err := c.client(cluster)
 	if err != nil {
 		return nil, err
 	}
@@ -340,12 +495,39 @@ func (cl *ClusteredList) SetContinue(continueToken string) {
 type PaginationInfo struct {
 	sync.Mutex
 	ContinueTokens map[string]map[string]string
+	// IncompleteNamespaces are the namespaces whose list timed out, they
+	// are listed again from their continue token, even if it's empty.
+	IncompleteNamespaces map[string]map[string]bool `json:",omitempty"`
 }
 
 func (pi *PaginationInfo) Set(cluster, namespace, token string) {
 	pi.Lock()
 	defer pi.Unlock()
 
+	pi.set(cluster, namespace, token)
+	delete(pi.IncompleteNamespaces[cluster], namespace)
+}
+
+// SetIncomplete keeps the token a namespace was listed from, for its list
+// to be retried.
+func (pi *PaginationInfo) SetIncomplete(cluster, namespace, token string) {
+	pi.Lock()
+	defer pi.Unlock()
+
+	pi.set(cluster, namespace, token)
+
+	if pi.IncompleteNamespaces == nil {
+		pi.IncompleteNamespaces = make(map[string]map[string]bool)
+	}
+
+	if pi.IncompleteNamespaces[cluster] == nil {
+		pi.IncompleteNamespaces[cluster] = make(map[string]bool)
+	}
+
+	pi.IncompleteNamespaces[cluster][namespace] = true
+}
+
+func (pi *PaginationInfo) set(cluster, namespace, token string) {
 	if pi.ContinueTokens == nil {
 		pi.ContinueTokens = make(map[string]map[string]string)
 	}
@@ -357,6 +539,14 @@ func (pi *PaginationInfo) Set(cluster, namespace, token string) {
 	pi.ContinueTokens[cluster][namespace] = token
 }
 
+// Incomplete returns whether the list of a namespace timed out.
+func (pi *PaginationInfo) Incomplete(cluster, namespace string) bool {
+	pi.Lock()
+	defer pi.Unlock()
+
+	return pi.IncompleteNamespaces[cluster][namespace]
+}
+
 func (pi *PaginationInfo) Get(cluster, namespace string) string {
 	pi.Lock()
 	defer pi.Unlock()
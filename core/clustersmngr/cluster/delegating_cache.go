@@ -11,6 +11,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/watch"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/rest"
 	"sigs.k8s.io/controller-runtime/pkg/cache"
@@ -85,9 +86,26 @@ func (c *delegatingCacheCluster) makeCachingClient(leafClient client.Client) (cl
 		return nil, errors.New("failed syncing client cache")
 	}
 
+	// Watches can't be served from the cache, so they go to the leaf client.
+	if watchClient, ok := leafClient.(client.WithWatch); ok {
+		return &cachingWatchClient{Client: delegatingClient, watchClient: watchClient}, nil
+	}
+
 	return delegatingClient, nil
 }
 
+// cachingWatchClient reads through the delegating cache and opens watches
+// using the leaf client.
+type cachingWatchClient struct {
+	client.Client
+
+	watchClient client.WithWatch
+}
+
+func (c *cachingWatchClient) Watch(ctx context.Context, list client.ObjectList, opts ...client.ListOption) (watch.Interface, error) {
+	return c.watchClient.Watch(ctx, list, opts...)
+}
+
 func (c *delegatingCacheCluster) GetUserClient(user *auth.UserPrincipal) (client.Client, error) {
 	client, err := c.cluster.GetUserClient(user)
 	if err != nil {
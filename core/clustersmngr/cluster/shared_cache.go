@@ -0,0 +1,347 @@
+package cluster
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+
+	helmv2 "github.com/fluxcd/helm-controller/api/v2beta2"
+	imgautomationv1 "github.com/fluxcd/image-automation-controller/api/v1beta1"
+	reflectorv1 "github.com/fluxcd/image-reflector-controller/api/v1beta2"
+	kustomizev1 "github.com/fluxcd/kustomize-controller/api/v1"
+	notificationv1 "github.com/fluxcd/notification-controller/api/v1"
+	notificationv1b2 "github.com/fluxcd/notification-controller/api/v1beta2"
+	sourcev1 "github.com/fluxcd/source-controller/api/v1"
+	sourcev1b2 "github.com/fluxcd/source-controller/api/v1beta2"
+	"github.com/weaveworks/weave-gitops/pkg/server/auth"
+	rbacv1 "k8s.io/api/rbac/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/kubernetes"
+	typedauth "k8s.io/client-go/kubernetes/typed/authorization/v1"
+	"k8s.io/client-go/rest"
+	"sigs.k8s.io/controller-runtime/pkg/cache"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+)
+
+// FluxKinds are the kinds read from the shared caches by default.
+var FluxKinds = []schema.GroupVersionKind{
+	kustomizev1.GroupVersion.WithKind(kustomizev1.KustomizationKind),
+	helmv2.GroupVersion.WithKind(helmv2.HelmReleaseKind),
+	sourcev1.GroupVersion.WithKind(sourcev1.GitRepositoryKind),
+	sourcev1b2.GroupVersion.WithKind(sourcev1b2.OCIRepositoryKind),
+	sourcev1b2.GroupVersion.WithKind(sourcev1b2.BucketKind),
+	sourcev1b2.GroupVersion.WithKind(sourcev1b2.HelmRepositoryKind),
+	sourcev1b2.GroupVersion.WithKind(sourcev1b2.HelmChartKind),
+	reflectorv1.GroupVersion.WithKind(reflectorv1.ImageRepositoryKind),
+	reflectorv1.GroupVersion.WithKind(reflectorv1.ImagePolicyKind),
+	imgautomationv1.GroupVersion.WithKind(imgautomationv1.ImageUpdateAutomationKind),
+	notificationv1b2.GroupVersion.WithKind(notificationv1b2.AlertKind),
+	notificationv1b2.GroupVersion.WithKind(notificationv1b2.ProviderKind),
+	notificationv1.GroupVersion.WithKind(notificationv1.ReceiverKind),
+}
+
+// AccessReviewer tells whether a user can do everything a rule allows in a
+// namespace, e.g. nsaccess.RulesReviewer.
+type AccessReviewer interface {
+	Can(ctx context.Context, auth typedauth.AuthorizationV1Interface, cacheKey, namespace string, rule rbacv1.PolicyRule) (bool, error)
+}
+
+// Stopper is implemented by the clusters running informers in the
+// background, which are stopped once the cluster is replaced or removed.
+type Stopper interface {
+	Stop()
+}
+
+type sharedCacheCluster struct {
+	cluster  Cluster
+	scheme   *runtime.Scheme
+	kinds    map[schema.GroupVersionKind]bool
+	reviewer AccessReviewer
+
+	// ctx is the context the informers run in, cancelled by Stop
+	ctx    context.Context
+	cancel context.CancelFunc
+
+	cacheOnce sync.Once
+	cache     cache.Cache
+	cacheErr  error
+}
+
+// NewSharedCacheCluster returns a cluster whose user clients read the given
+// kinds from informer caches kept with the server's service account, and shared
+// by all the users. Each user only gets the objects their RBAC allows them to
+// read, as checked by the reviewer, the other reads are still impersonated.
+func NewSharedCacheCluster(cluster Cluster, scheme *runtime.Scheme, kinds []schema.GroupVersionKind, reviewer AccessReviewer) Cluster {
+	kindsSet := map[schema.GroupVersionKind]bool{}
+	for _, gvk := range kinds {
+		kindsSet[gvk] = true
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	return &sharedCacheCluster{
+		cluster:  cluster,
+		scheme:   scheme,
+		kinds:    kindsSet,
+		reviewer: reviewer,
+		ctx:      ctx,
+		cancel:   cancel,
+	}
+}
+
+// Stop stops the informers of the shared caches.
+func (c *sharedCacheCluster) Stop() {
+	c.cancel()
+}
+
+func (c *sharedCacheCluster) GetName() string {
+	return c.cluster.GetName()
+}
+
+func (c *sharedCacheCluster) GetHost() string {
+	return c.cluster.GetHost()
+}
+
+// getCache starts the informer caches the first time they are needed, rather
+// than for every cluster as soon as it's found.
+func (c *sharedCacheCluster) getCache() (cache.Cache, error) {
+	c.cacheOnce.Do(func() {
+		c.cache, c.cacheErr = c.makeCache()
+	})
+
+	return c.cache, c.cacheErr
+}
+
+func (c *sharedCacheCluster) makeCache() (cache.Cache, error) {
+	restConfig, err := c.cluster.GetServerConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	httpClient, err := rest.HTTPClientFor(restConfig)
+	if err != nil {
+		return nil, fmt.Errorf("could not create HTTP client from config: %w", err)
+	}
+
+	mapper, err := apiutil.NewDynamicRESTMapper(restConfig, httpClient)
+	if err != nil {
+		return nil, fmt.Errorf("could not create RESTMapper from config: %w", err)
+	}
+
+	sharedCache, err := cache.New(restConfig, cache.Options{
+		HTTPClient: httpClient,
+		Scheme:     c.scheme,
+		Mapper:     mapper,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed creating shared cache: %w", err)
+	}
+
+	ctx := c.ctx
+
+	go sharedCache.Start(ctx) //nolint:errcheck
+
+	// Warm up the informers of the kinds installed on the cluster, the reads
+	// of those that aren't fail like they would on the API server.
+	for gvk := range c.kinds {
+		go sharedCache.GetInformerForKind(ctx, gvk) //nolint:errcheck
+	}
+
+	return sharedCache, nil
+}
+
+func (c *sharedCacheCluster) GetUserClient(user *auth.UserPrincipal) (client.Client, error) {
+	userClient, err := c.cluster.GetUserClient(user)
+	if err != nil {
+		return nil, err
+	}
+
+	sharedCache, err := c.getCache()
+	if err != nil {
+		return nil, err
+	}
+
+	clientset, err := c.cluster.GetUserClientset(user)
+	if err != nil {
+		return nil, err
+	}
+
+	cacheClient := &sharedCacheClient{
+		Client:   userClient,
+		cache:    sharedCache,
+		kinds:    c.kinds,
+		reviewer: c.reviewer,
+		auth:     clientset.AuthorizationV1(),
+		cacheKey: fmt.Sprintf("%s/%s", c.GetName(), user.Hash()),
+	}
+
+	// Watches can't be served from the cache, so they go to the user client.
+	if watchClient, ok := userClient.(client.WithWatch); ok {
+		return &cachingWatchClient{Client: cacheClient, watchClient: watchClient}, nil
+	}
+
+	return cacheClient, nil
+}
+
+func (c *sharedCacheCluster) GetServerClient() (client.Client, error) {
+	return c.cluster.GetServerClient()
+}
+
+func (c *sharedCacheCluster) GetUserClientset(user *auth.UserPrincipal) (kubernetes.Interface, error) {
+	return c.cluster.GetUserClientset(user)
+}
+
+func (c *sharedCacheCluster) GetServerClientset() (kubernetes.Interface, error) {
+	return c.cluster.GetServerClientset()
+}
+
+func (c *sharedCacheCluster) GetServerConfig() (*rest.Config, error) {
+	return c.cluster.GetServerConfig()
+}
+
+// sharedCacheClient reads the cached kinds from the shared cache, checking the
+// user can read them first, and sends all the other requests to the user
+// client.
+type sharedCacheClient struct {
+	client.Client
+
+	cache    client.Reader
+	kinds    map[schema.GroupVersionKind]bool
+	reviewer AccessReviewer
+	auth     typedauth.AuthorizationV1Interface
+	cacheKey string
+}
+
+func (c *sharedCacheClient) Get(ctx context.Context, key client.ObjectKey, obj client.Object, opts ...client.GetOption) error {
+	mapping, cached, err := c.cachedMapping(obj)
+	if err != nil {
+		return err
+	}
+
+	if !cached {
+		return c.Client.Get(ctx, key, obj, opts...)
+	}
+
+	ok, err := c.can(ctx, mapping, key.Namespace, "get")
+	if err != nil {
+		return err
+	}
+
+	if !ok {
+		return forbidden(mapping, key.Name, "get", key.Namespace)
+	}
+
+	return c.cache.Get(ctx, key, obj, opts...)
+}
+
+// List lists the cached kinds from the shared cache. Across all namespaces, it
+// leaves out the objects of the namespaces the user can't list them in, rather
+// than failing like the API server would.
+func (c *sharedCacheClient) List(ctx context.Context, list client.ObjectList, opts ...client.ListOption) error {
+	mapping, cached, err := c.cachedMapping(list)
+	if err != nil {
+		return err
+	}
+
+	if !cached {
+		return c.Client.List(ctx, list, opts...)
+	}
+
+	listOpts := &client.ListOptions{}
+	listOpts.ApplyOptions(opts)
+
+	if listOpts.Namespace != "" {
+		ok, err := c.can(ctx, mapping, listOpts.Namespace, "list")
+		if err != nil {
+			return err
+		}
+
+		if !ok {
+			return forbidden(mapping, "", "list", listOpts.Namespace)
+		}
+
+		return c.cache.List(ctx, list, opts...)
+	}
+
+	if err := c.cache.List(ctx, list, opts...); err != nil {
+		return err
+	}
+
+	items, err := meta.ExtractList(list)
+	if err != nil {
+		return err
+	}
+
+	allowed := map[string]bool{}
+	filtered := []runtime.Object{}
+
+	for _, item := range items {
+		obj, err := meta.Accessor(item)
+		if err != nil {
+			return err
+		}
+
+		ns := obj.GetNamespace()
+
+		ok, checked := allowed[ns]
+		if !checked {
+			ok, err = c.can(ctx, mapping, ns, "list")
+			if err != nil {
+				return err
+			}
+
+			allowed[ns] = ok
+		}
+
+		if ok {
+			filtered = append(filtered, item)
+		}
+	}
+
+	return meta.SetList(list, filtered)
+}
+
+// cachedMapping returns the REST mapping of the object's kind, and whether the
+// kind is read from the shared cache.
+func (c *sharedCacheClient) cachedMapping(obj runtime.Object) (*meta.RESTMapping, bool, error) {
+	gvk, err := apiutil.GVKForObject(obj, c.Scheme())
+	if err != nil {
+		return nil, false, err
+	}
+
+	if meta.IsListType(obj) {
+		gvk.Kind = strings.TrimSuffix(gvk.Kind, "List")
+	}
+
+	if !c.kinds[gvk] {
+		return nil, false, nil
+	}
+
+	mapping, err := c.RESTMapper().RESTMapping(gvk.GroupKind(), gvk.Version)
+	if err != nil {
+		return nil, false, err
+	}
+
+	return mapping, true, nil
+}
+
+func (c *sharedCacheClient) can(ctx context.Context, mapping *meta.RESTMapping, namespace, verb string) (bool, error) {
+	return c.reviewer.Can(ctx, c.auth, c.cacheKey, namespace, rbacv1.PolicyRule{
+		APIGroups: []string{mapping.Resource.Group},
+		Resources: []string{mapping.Resource.Resource},
+		Verbs:     []string{verb},
+	})
+}
+
+func forbidden(mapping *meta.RESTMapping, name, verb, namespace string) error {
+	return k8serrors.NewForbidden(
+		mapping.Resource.GroupResource(),
+		name,
+		fmt.Errorf("user cannot %s resource %q in namespace %q", verb, mapping.Resource.Resource, namespace),
+	)
+}
@@ -0,0 +1,178 @@
+package cluster
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	kustomizev1 "github.com/fluxcd/kustomize-controller/api/v1"
+	. "github.com/onsi/gomega"
+	"github.com/weaveworks/weave-gitops/core/nsaccess"
+	authorizationv1 "k8s.io/api/authorization/v1"
+	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	fakeclientset "k8s.io/client-go/kubernetes/fake"
+	typedauth "k8s.io/client-go/kubernetes/typed/authorization/v1"
+	k8stesting "k8s.io/client-go/testing"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestSharedCacheClient(t *testing.T) {
+	g := NewGomegaWithT(t)
+	ctx := context.Background()
+
+	scheme := runtime.NewScheme()
+	g.Expect(corev1.AddToScheme(scheme)).To(Succeed())
+	g.Expect(kustomizev1.AddToScheme(scheme)).To(Succeed())
+
+	objects := []client.Object{
+		&kustomizev1.Kustomization{ObjectMeta: metav1.ObjectMeta{Name: "apps", Namespace: "team-a"}},
+		&kustomizev1.Kustomization{ObjectMeta: metav1.ObjectMeta{Name: "apps", Namespace: "team-b"}},
+		&corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "config", Namespace: "team-a"}},
+	}
+
+	mapper := meta.NewDefaultRESTMapper(nil)
+	mapper.Add(kustomizev1.GroupVersion.WithKind(kustomizev1.KustomizationKind), meta.RESTScopeNamespace)
+	mapper.Add(corev1.SchemeGroupVersion.WithKind("ConfigMap"), meta.RESTScopeNamespace)
+
+	// the user client only has the config map, so the kustomizations can
+	// only come from the cache
+	userClient := fake.NewClientBuilder().WithScheme(scheme).WithRESTMapper(mapper).WithObjects(objects[2]).Build()
+	sharedCache := fake.NewClientBuilder().WithScheme(scheme).WithObjects(objects...).Build()
+
+	reviewer := &namespacesReviewer{allowed: map[string]bool{"team-a": true}}
+
+	c := &sharedCacheClient{
+		Client:   userClient,
+		cache:    sharedCache,
+		kinds:    map[schema.GroupVersionKind]bool{kustomizev1.GroupVersion.WithKind(kustomizev1.KustomizationKind): true},
+		reviewer: reviewer,
+		cacheKey: "cluster/user",
+	}
+
+	t.Run("lists the objects of the namespaces the user can read", func(t *testing.T) {
+		g := NewGomegaWithT(t)
+
+		list := &kustomizev1.KustomizationList{}
+		g.Expect(c.List(ctx, list)).To(Succeed())
+		g.Expect(list.Items).To(HaveLen(1))
+		g.Expect(list.Items[0].Namespace).To(Equal("team-a"))
+
+		g.Expect(reviewer.rules).To(ContainElement(rbacv1.PolicyRule{
+			APIGroups: []string{"kustomize.toolkit.fluxcd.io"},
+			Resources: []string{"kustomizations"},
+			Verbs:     []string{"list"},
+		}))
+	})
+
+	t.Run("listing a namespace the user can't read is forbidden", func(t *testing.T) {
+		g := NewGomegaWithT(t)
+
+		err := c.List(ctx, &kustomizev1.KustomizationList{}, client.InNamespace("team-b"))
+		g.Expect(err).To(Satisfy(k8serrors.IsForbidden))
+
+		list := &kustomizev1.KustomizationList{}
+		g.Expect(c.List(ctx, list, client.InNamespace("team-a"))).To(Succeed())
+		g.Expect(list.Items).To(HaveLen(1))
+	})
+
+	t.Run("gets the objects the user can read", func(t *testing.T) {
+		g := NewGomegaWithT(t)
+
+		g.Expect(c.Get(ctx, client.ObjectKey{Namespace: "team-a", Name: "apps"}, &kustomizev1.Kustomization{})).To(Succeed())
+
+		err := c.Get(ctx, client.ObjectKey{Namespace: "team-b", Name: "apps"}, &kustomizev1.Kustomization{})
+		g.Expect(err).To(Satisfy(k8serrors.IsForbidden))
+	})
+
+	t.Run("other kinds are read with the user client", func(t *testing.T) {
+		g := NewGomegaWithT(t)
+
+		checks := len(reviewer.rules)
+
+		list := &corev1.ConfigMapList{}
+		g.Expect(c.List(ctx, list)).To(Succeed())
+		g.Expect(list.Items).To(HaveLen(1))
+
+		g.Expect(reviewer.rules).To(HaveLen(checks))
+	})
+}
+
+func TestSharedCacheClientResourceNames(t *testing.T) {
+	g := NewGomegaWithT(t)
+	ctx := context.Background()
+
+	scheme := runtime.NewScheme()
+	g.Expect(kustomizev1.AddToScheme(scheme)).To(Succeed())
+
+	mapper := meta.NewDefaultRESTMapper(nil)
+	mapper.Add(kustomizev1.GroupVersion.WithKind(kustomizev1.KustomizationKind), meta.RESTScopeNamespace)
+
+	userClient := fake.NewClientBuilder().WithScheme(scheme).WithRESTMapper(mapper).Build()
+	sharedCache := fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+		&kustomizev1.Kustomization{ObjectMeta: metav1.ObjectMeta{Name: "apps", Namespace: "team-a"}},
+		&kustomizev1.Kustomization{ObjectMeta: metav1.ObjectMeta{Name: "infra", Namespace: "team-a"}},
+	).Build()
+
+	// the user may only read the kustomization named apps
+	clientset := fakeclientset.NewSimpleClientset()
+	clientset.PrependReactor("create", "selfsubjectrulesreviews", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		review := action.(k8stesting.CreateAction).GetObject().(*authorizationv1.SelfSubjectRulesReview)
+		review.Status.ResourceRules = []authorizationv1.ResourceRule{{
+			APIGroups:     []string{"kustomize.toolkit.fluxcd.io"},
+			Resources:     []string{"kustomizations"},
+			Verbs:         []string{"get", "list", "watch"},
+			ResourceNames: []string{"apps"},
+		}}
+
+		return true, review, nil
+	})
+
+	c := &sharedCacheClient{
+		Client:   userClient,
+		cache:    sharedCache,
+		kinds:    map[schema.GroupVersionKind]bool{kustomizev1.GroupVersion.WithKind(kustomizev1.KustomizationKind): true},
+		reviewer: nsaccess.NewRulesReviewer(time.Minute),
+		auth:     clientset.AuthorizationV1(),
+		cacheKey: "cluster/user",
+	}
+
+	err := c.Get(ctx, client.ObjectKey{Namespace: "team-a", Name: "infra"}, &kustomizev1.Kustomization{})
+	g.Expect(err).To(Satisfy(k8serrors.IsForbidden))
+
+	err = c.List(ctx, &kustomizev1.KustomizationList{}, client.InNamespace("team-a"))
+	g.Expect(err).To(Satisfy(k8serrors.IsForbidden))
+
+	list := &kustomizev1.KustomizationList{}
+	g.Expect(c.List(ctx, list)).To(Succeed())
+	g.Expect(list.Items).To(BeEmpty())
+}
+
+func TestSharedCacheClusterStop(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	c := NewSharedCacheCluster(nil, runtime.NewScheme(), FluxKinds, nil).(*sharedCacheCluster)
+	g.Expect(c.ctx.Err()).NotTo(HaveOccurred())
+
+	Stopper(c).Stop()
+
+	g.Expect(c.ctx.Err()).To(MatchError(context.Canceled), "the informers are stopped")
+}
+
+// namespacesReviewer allows everything in the allowed namespaces.
+type namespacesReviewer struct {
+	allowed map[string]bool
+	rules   []rbacv1.PolicyRule
+}
+
+func (r *namespacesReviewer) Can(ctx context.Context, auth typedauth.AuthorizationV1Interface, cacheKey, namespace string, rule rbacv1.PolicyRule) (bool, error) {
+	r.rules = append(r.rules, rule)
+
+	return r.allowed[namespace], nil
+}
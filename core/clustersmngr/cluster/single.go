@@ -65,7 +65,7 @@ func getClientFromConfig(config *rest.Config, scheme *apiruntime.Scheme) (client
 		return nil, fmt.Errorf("could not create RESTMapper from config: %w", err)
 	}
 
-	client, err := client.New(config, client.Options{
+	client, err := client.NewWithWatch(config, client.Options{
 		Scheme: scheme,
 		Mapper: mapper,
 	})
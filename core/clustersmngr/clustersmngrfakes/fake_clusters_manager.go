@@ -23,6 +23,16 @@ type FakeClustersManager struct {
 	getClustersReturnsOnCall map[int]struct {
 		result1 []cluster.Cluster
 	}
+	GetClustersHealthStub        func() []clustersmngr.ClusterHealth
+	getClustersHealthMutex       sync.RWMutex
+	getClustersHealthArgsForCall []struct {
+	}
+	getClustersHealthReturns struct {
+		result1 []clustersmngr.ClusterHealth
+	}
+	getClustersHealthReturnsOnCall map[int]struct {
+		result1 []clustersmngr.ClusterHealth
+	}
 	GetClustersNamespacesStub        func() map[string][]v1.Namespace
 	getClustersNamespacesMutex       sync.RWMutex
 	getClustersNamespacesArgsForCall []struct {
@@ -132,6 +142,11 @@ type FakeClustersManager struct {
 	updateClustersReturnsOnCall map[int]struct {
 		result1 error
 	}
+	UpdateClustersHealthStub        func(context.Context)
+	updateClustersHealthMutex       sync.RWMutex
+	updateClustersHealthArgsForCall []struct {
+		arg1 context.Context
+	}
 	UpdateNamespacesStub        func(context.Context) error
 	updateNamespacesMutex       sync.RWMutex
 	updateNamespacesArgsForCall []struct {
@@ -206,6 +221,59 @@ func (fake *FakeClustersManager) GetClustersReturnsOnCall(i int, result1 []clust
 	}{result1}
 }
 
+func (fake *FakeClustersManager) GetClustersHealth() []clustersmngr.ClusterHealth {
+	fake.getClustersHealthMutex.Lock()
+	ret, specificReturn := fake.getClustersHealthReturnsOnCall[len(fake.getClustersHealthArgsForCall)]
+	fake.getClustersHealthArgsForCall = append(fake.getClustersHealthArgsForCall, struct {
+	}{})
+	stub := fake.GetClustersHealthStub
+	fakeReturns := fake.getClustersHealthReturns
+	fake.recordInvocation("GetClustersHealth", []interface{}{})
+	fake.getClustersHealthMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeClustersManager) GetClustersHealthCallCount() int {
+	fake.getClustersHealthMutex.RLock()
+	defer fake.getClustersHealthMutex.RUnlock()
+	return len(fake.getClustersHealthArgsForCall)
+}
+
+func (fake *FakeClustersManager) GetClustersHealthCalls(stub func() []clustersmngr.ClusterHealth) {
+	fake.getClustersHealthMutex.Lock()
+	defer fake.getClustersHealthMutex.Unlock()
+	fake.GetClustersHealthStub = stub
+}
+
+func (fake *FakeClustersManager) GetClustersHealthReturns(result1 []clustersmngr.ClusterHealth) {
+	fake.getClustersHealthMutex.Lock()
+	defer fake.getClustersHealthMutex.Unlock()
+	fake.GetClustersHealthStub = nil
+	fake.getClustersHealthReturns = struct {
+		result1 []clustersmngr.ClusterHealth
+	}{result1}
+}
+
+func (fake *FakeClustersManager) GetClustersHealthReturnsOnCall(i int, result1 []clustersmngr.ClusterHealth) {
+	fake.getClustersHealthMutex.Lock()
+	defer fake.getClustersHealthMutex.Unlock()
+	fake.GetClustersHealthStub = nil
+	if fake.getClustersHealthReturnsOnCall == nil {
+		fake.getClustersHealthReturnsOnCall = make(map[int]struct {
+			result1 []clustersmngr.ClusterHealth
+		})
+	}
+	fake.getClustersHealthReturnsOnCall[i] = struct {
+		result1 []clustersmngr.ClusterHealth
+	}{result1}
+}
+
 func (fake *FakeClustersManager) GetClustersNamespaces() map[string][]v1.Namespace {
 	fake.getClustersNamespacesMutex.Lock()
 	ret, specificReturn := fake.getClustersNamespacesReturnsOnCall[len(fake.getClustersNamespacesArgsForCall)]
@@ -759,6 +827,38 @@ func (fake *FakeClustersManager) UpdateClustersReturnsOnCall(i int, result1 erro
 	}{result1}
 }
 
+func (fake *FakeClustersManager) UpdateClustersHealth(arg1 context.Context) {
+	fake.updateClustersHealthMutex.Lock()
+	fake.updateClustersHealthArgsForCall = append(fake.updateClustersHealthArgsForCall, struct {
+		arg1 context.Context
+	}{arg1})
+	stub := fake.UpdateClustersHealthStub
+	fake.recordInvocation("UpdateClustersHealth", []interface{}{arg1})
+	fake.updateClustersHealthMutex.Unlock()
+	if stub != nil {
+		fake.UpdateClustersHealthStub(arg1)
+	}
+}
+
+func (fake *FakeClustersManager) UpdateClustersHealthCallCount() int {
+	fake.updateClustersHealthMutex.RLock()
+	defer fake.updateClustersHealthMutex.RUnlock()
+	return len(fake.updateClustersHealthArgsForCall)
+}
+
+func (fake *FakeClustersManager) UpdateClustersHealthCalls(stub func(context.Context)) {
+	fake.updateClustersHealthMutex.Lock()
+	defer fake.updateClustersHealthMutex.Unlock()
+	fake.UpdateClustersHealthStub = stub
+}
+
+func (fake *FakeClustersManager) UpdateClustersHealthArgsForCall(i int) context.Context {
+	fake.updateClustersHealthMutex.RLock()
+	defer fake.updateClustersHealthMutex.RUnlock()
+	argsForCall := fake.updateClustersHealthArgsForCall[i]
+	return argsForCall.arg1
+}
+
 func (fake *FakeClustersManager) UpdateNamespaces(arg1 context.Context) error {
 	fake.updateNamespacesMutex.Lock()
 	ret, specificReturn := fake.updateNamespacesReturnsOnCall[len(fake.updateNamespacesArgsForCall)]
@@ -858,6 +958,8 @@ func (fake *FakeClustersManager) Invocations() map[string][][]interface{} {
 	defer fake.invocationsMutex.RUnlock()
 	fake.getClustersMutex.RLock()
 	defer fake.getClustersMutex.RUnlock()
+	fake.getClustersHealthMutex.RLock()
+	defer fake.getClustersHealthMutex.RUnlock()
 	fake.getClustersNamespacesMutex.RLock()
 	defer fake.getClustersNamespacesMutex.RUnlock()
 	fake.getImpersonatedClientMutex.RLock()
@@ -878,6 +980,8 @@ func (fake *FakeClustersManager) Invocations() map[string][][]interface{} {
 	defer fake.subscribeMutex.RUnlock()
 	fake.updateClustersMutex.RLock()
 	defer fake.updateClustersMutex.RUnlock()
+	fake.updateClustersHealthMutex.RLock()
+	defer fake.updateClustersHealthMutex.RUnlock()
 	fake.updateNamespacesMutex.RLock()
 	defer fake.updateNamespacesMutex.RUnlock()
 	fake.updateUserNamespacesMutex.RLock()
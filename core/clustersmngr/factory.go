@@ -122,6 +122,10 @@ func registerMetrics() {
 	_ = Registry.Register(opsNamespacesCount)
 	_ = Registry.Register(opsCreateServerClient)
 	_ = Registry.Register(opsCreateUserClient)
+	_ = Registry.Register(opsClusterUp)
+	_ = Registry.Register(opsClusterProbeLatency)
+	_ = Registry.Register(opsClusterLastSeen)
+	_ = Registry.Register(opsClusterCircuitOpen)
 }
 
 // ClientError is an error returned by the GetImpersonatedClient function which contains
@@ -166,6 +170,10 @@ type ClustersManager interface {
 	RemoveWatcher(cw *ClustersWatcher)
 	// GetClusters returns all the currently known clusters
 	GetClusters() []cluster.Cluster
+	// UpdateClustersHealth probes all the clusters
+	UpdateClustersHealth(ctx context.Context)
+	// GetClustersHealth returns the outcome of the last probe of each cluster
+	GetClustersHealth() []ClusterHealth
 }
 
 type clustersManager struct {
@@ -175,6 +183,10 @@ type clustersManager struct {
 
 	// list of clusters returned by the clusters fetcher
 	clusters *Clusters
+	// the outcome of the last probe of each cluster
+	clustersHealth *clustersHealth
+	// the recent failures of the requests to each cluster
+	circuitBreakers *circuitBreakers
 	// string containing ordered list of cluster names, used to refresh dependent caches
 	clustersHash string
 	// the lists of all namespaces of each cluster
@@ -226,6 +238,8 @@ func NewClustersManager(fetchers []ClusterFetcher, nsChecker nsaccess.Checker, l
 		clustersFetchers:           fetchers,
 		nsChecker:                  nsChecker,
 		clusters:                   &Clusters{},
+		clustersHealth:             &clustersHealth{},
+		circuitBreakers:            newCircuitBreakers(circuitBreakerThreshold, circuitBreakerCooldown),
 		clustersNamespaces:         &ClustersNamespaces{},
 		usersNamespaces:            &UsersNamespaces{Cache: ttlcache.New(userNamespaceResolution)},
 		usersClients:               &UsersClients{Cache: ttlcache.New(usersClientResolution)},
@@ -273,6 +287,8 @@ func (cf *clustersManager) watchClusters(ctx context.Context) {
 		cf.log.Error(err, "failed updating clusters")
 	}
 
+	go cf.watchClustersHealth(ctx)
+
 	cf.initialClustersLoad <- true
 
 	//nolint:staticcheck // deprecated, tracking issue: https://github.com/weaveworks/weave-gitops/issues/3812
@@ -422,6 +438,10 @@ func (cf *clustersManager) getUserClientWithNamespaces(ctx context.Context, user
 	var wg sync.WaitGroup
 
 	for _, cl := range cf.clusters.Get() {
+		if !user.AllowsCluster(cl.GetName()) {
+			continue
+		}
+
 		wg.Add(1)
 
 		go func(cluster cluster.Cluster, pool ClientsPool, errChan chan error) {
@@ -453,7 +473,7 @@ func (cf *clustersManager) getUserClientWithNamespaces(ctx context.Context, user
 		nss = cf.userNsList(ctx, user)
 	}
 
-	return NewClient(pool, nss, cf.log), result.ErrorOrNil()
+	return cf.newClient(pool, nss), result.ErrorOrNil()
 }
 
 func (cf *clustersManager) GetImpersonatedClientForCluster(ctx context.Context, user *auth.UserPrincipal, clusterName string) (Client, error) {
@@ -467,7 +487,7 @@ func (cf *clustersManager) GetImpersonatedClientForCluster(ctx context.Context,
 	clusters := cf.clusters.Get()
 
 	for _, c := range clusters {
-		if c.GetName() == clusterName {
+		if c.GetName() == clusterName && user.AllowsCluster(clusterName) {
 			cl = c
 			break
 		}
@@ -486,7 +506,7 @@ func (cf *clustersManager) GetImpersonatedClientForCluster(ctx context.Context,
 		return nil, fmt.Errorf("failed adding cluster client to pool: %w", err)
 	}
 
-	return NewClient(pool, cf.userNsList(ctx, user), cf.log), nil
+	return cf.newClient(pool, cf.userNsList(ctx, user)), nil
 }
 
 func (cf *clustersManager) GetImpersonatedDiscoveryClient(ctx context.Context, user *auth.UserPrincipal, clusterName string) (discovery.DiscoveryInterface, error) {
@@ -495,7 +515,7 @@ func (cf *clustersManager) GetImpersonatedDiscoveryClient(ctx context.Context, u
 	}
 
 	for _, cluster := range cf.clusters.Get() {
-		if cluster.GetName() == clusterName {
+		if cluster.GetName() == clusterName && user.AllowsCluster(clusterName) {
 			var err error
 
 			clientset, err := cluster.GetUserClientset(user)
@@ -543,13 +563,18 @@ func (cf *clustersManager) GetServerClient(ctx context.Context) (Client, error)
 		result = multierror.Append(result, err)
 	}
 
-	return NewClient(pool, cf.clustersNamespaces.namespaces, cf.log), result.ErrorOrNil()
+	return cf.newClient(pool, cf.clustersNamespaces.namespaces), result.ErrorOrNil()
 }
 
 func (cf *clustersManager) UpdateUserNamespaces(ctx context.Context, user *auth.UserPrincipal) {
 	wg := sync.WaitGroup{}
+	unreachable := cf.clustersHealth.unreachable()
 
 	for _, cl := range cf.clusters.Get() {
+		if _, found := unreachable[cl.GetName()]; found {
+			continue
+		}
+
 		wg.Add(1)
 
 		go func(cluster cluster.Cluster) {
@@ -600,6 +625,18 @@ func (cf *clustersManager) userNsList(ctx context.Context, user *auth.UserPrinci
 	return cf.GetUserNamespaces(user)
 }
 
+// newClient returns a client failing straight away for the clusters the last
+// probe couldn't reach, or that failed the recent requests.
+func (cf *clustersManager) newClient(pool ClientsPool, namespaces map[string][]v1.Namespace) Client {
+	return &clustersClient{
+		pool:        pool,
+		namespaces:  namespaces,
+		log:         cf.log,
+		unreachable: cf.clustersHealth.unreachable(),
+		breakers:    cf.circuitBreakers,
+	}
+}
+
 func (cf *clustersManager) getOrCreateClient(user *auth.UserPrincipal, cluster cluster.Cluster) (client.Client, error) {
 	isServer := false
 
@@ -631,6 +668,10 @@ func (cf *clustersManager) getOrCreateClient(user *auth.UserPrincipal, cluster c
 		return nil, fmt.Errorf("failed creating client for cluster=%s: %w", cluster.GetName(), err)
 	}
 
+	if user.ReadOnly {
+		client = newReadOnlyClient(client)
+	}
+
 	cf.usersClients.Set(user, cluster.GetName(), client)
 
 	return client, nil
@@ -0,0 +1,358 @@
+package fetcher
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/go-logr/logr"
+	mngr "github.com/weaveworks/weave-gitops/core/clustersmngr"
+	"github.com/weaveworks/weave-gitops/core/clustersmngr/cluster"
+	"github.com/weaveworks/weave-gitops/pkg/kube"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/clientcmd"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// KubeconfigSecretLabel marks the secrets holding the kubeconfig of a
+	// cluster, the cluster is named after the secret.
+	KubeconfigSecretLabel = "clusters.weave.works/kubeconfig"
+	// CAPIClusterNameLabel is set by Cluster API on the kubeconfig secrets
+	// of the clusters it provisions.
+	CAPIClusterNameLabel = "cluster.x-k8s.io/cluster-name"
+
+	watchRestartDelay = 5 * time.Second
+)
+
+var (
+	// CAPIClusterGVK is the kind of the Cluster API clusters whose
+	// kubeconfig secrets are used.
+	CAPIClusterGVK = schema.GroupVersionKind{Group: "cluster.x-k8s.io", Version: "v1beta1", Kind: "Cluster"}
+
+	// The keys the kubeconfig is looked up at, in order. Cluster API uses
+	// the first, Flux accepts both.
+	kubeconfigKeys = []string{"value", "value.yaml"}
+)
+
+// SecretsFetcherConfig configures how the clusters found by a
+// SecretsClusterFetcher are connected to.
+type SecretsFetcherConfig struct {
+	// Namespace is the namespace the kubeconfig secrets and the Cluster API
+	// clusters are looked up in.
+	Namespace         string
+	Scheme            *runtime.Scheme
+	UserPrefixes      kube.UserPrefixes
+	KubeConfigOptions []cluster.KubeConfigOption
+	UseCachedClients  bool
+	// UseSharedCache makes the clusters read the Flux objects from shared
+	// caches, checking the users' access with RulesReviewer.
+	UseSharedCache bool
+	RulesReviewer  cluster.AccessReviewer
+}
+
+// SecretsClusterFetcher fetches the clusters whose kubeconfigs are stored in
+// the labelled secrets of a namespace, and those of the Cluster API clusters
+// of the namespace.
+type SecretsClusterFetcher struct {
+	log    logr.Logger
+	client client.WithWatch
+	config SecretsFetcherConfig
+
+	mu sync.Mutex
+	// the clusters built from each secret, rebuilt only when the secret
+	// changes so that their clients and caches are kept
+	clusters map[string]secretCluster
+}
+
+type secretCluster struct {
+	version string
+	cluster cluster.Cluster
+}
+
+// kubeconfigSource is a secret a cluster's kubeconfig is read from.
+type kubeconfigSource struct {
+	clusterName string
+	secret      *corev1.Secret
+}
+
+func NewSecretsClusterFetcher(log logr.Logger, c client.WithWatch, config SecretsFetcherConfig) *SecretsClusterFetcher {
+	return &SecretsClusterFetcher{
+		log:      log.WithName("secrets-cluster-fetcher"),
+		client:   c,
+		config:   config,
+		clusters: map[string]secretCluster{},
+	}
+}
+
+// Fetch returns a cluster for each kubeconfig secret. Secrets that can't be
+// used are logged and skipped, so that one broken kubeconfig doesn't hide
+// all the other clusters.
+func (f *SecretsClusterFetcher) Fetch(ctx context.Context) ([]cluster.Cluster, error) {
+	sources, err := f.kubeconfigSources(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	clusters := []cluster.Cluster{}
+	built := map[string]secretCluster{}
+
+	for _, source := range sources {
+		name := source.clusterName
+
+		if name == cluster.DefaultCluster {
+			f.log.Info("Skipping kubeconfig secret, the cluster name is reserved", "secret", source.secret.Name, "cluster", name)
+			continue
+		}
+
+		if _, found := built[name]; found {
+			f.log.Info("Skipping kubeconfig secret, a cluster with the same name already exists", "secret", source.secret.Name, "cluster", name)
+			continue
+		}
+
+		version := string(source.secret.UID) + "/" + source.secret.ResourceVersion
+
+		c, found := f.clusters[name]
+		if !found || c.version != version {
+			cl, err := f.newCluster(name, source.secret)
+			if err != nil {
+				f.log.Error(err, "Skipping kubeconfig secret", "secret", source.secret.Name, "cluster", name)
+				continue
+			}
+
+			c = secretCluster{version: version, cluster: cl}
+		}
+
+		built[name] = c
+		clusters = append(clusters, c.cluster)
+	}
+
+	// stop the informers of the clusters replaced or removed
+	for name, c := range f.clusters {
+		if kept, found := built[name]; found && kept.cluster == c.cluster {
+			continue
+		}
+
+		if stopper, ok := c.cluster.(cluster.Stopper); ok {
+			stopper.Stop()
+		}
+	}
+
+	f.clusters = built
+
+	return clusters, nil
+}
+
+// kubeconfigSources lists the labelled secrets and the kubeconfig secrets of
+// the Cluster API clusters, sorted by cluster name.
+func (f *SecretsClusterFetcher) kubeconfigSources(ctx context.Context) ([]kubeconfigSource, error) {
+	secrets := &corev1.SecretList{}
+	if err := f.client.List(ctx, secrets, client.InNamespace(f.config.Namespace), client.HasLabels{KubeconfigSecretLabel}); err != nil {
+		return nil, fmt.Errorf("failed to list kubeconfig secrets: %w", err)
+	}
+
+	sources := []kubeconfigSource{}
+	for i := range secrets.Items {
+		sources = append(sources, kubeconfigSource{clusterName: secrets.Items[i].Name, secret: &secrets.Items[i]})
+	}
+
+	capiClusters := &unstructured.UnstructuredList{}
+	capiClusters.SetGroupVersionKind(CAPIClusterGVK.GroupVersion().WithKind(CAPIClusterGVK.Kind + "List"))
+
+	if err := f.client.List(ctx, capiClusters, client.InNamespace(f.config.Namespace)); err != nil {
+		// Cluster API isn't installed
+		if meta.IsNoMatchError(err) || k8serrors.IsNotFound(err) {
+			return sortSources(sources), nil
+		}
+
+		return nil, fmt.Errorf("failed to list Cluster API clusters: %w", err)
+	}
+
+	for _, capiCluster := range capiClusters.Items {
+		if capiCluster.GetDeletionTimestamp() != nil {
+			continue
+		}
+
+		secret := &corev1.Secret{}
+		key := types.NamespacedName{Namespace: f.config.Namespace, Name: capiCluster.GetName() + "-kubeconfig"}
+
+		if err := f.client.Get(ctx, key, secret); err != nil {
+			// The cluster is still being provisioned
+			if k8serrors.IsNotFound(err) {
+				continue
+			}
+
+			return nil, fmt.Errorf("failed to get the kubeconfig secret of Cluster API cluster %s: %w", capiCluster.GetName(), err)
+		}
+
+		sources = append(sources, kubeconfigSource{clusterName: capiCluster.GetName(), secret: secret})
+	}
+
+	return sortSources(sources), nil
+}
+
+func sortSources(sources []kubeconfigSource) []kubeconfigSource {
+	sort.SliceStable(sources, func(i, j int) bool {
+		return sources[i].clusterName < sources[j].clusterName
+	})
+
+	return sources
+}
+
+func (f *SecretsClusterFetcher) newCluster(name string, secret *corev1.Secret) (cluster.Cluster, error) {
+	var data []byte
+
+	for _, key := range kubeconfigKeys {
+		if value, found := secret.Data[key]; found {
+			data = value
+			break
+		}
+	}
+
+	if len(data) == 0 {
+		return nil, fmt.Errorf("no kubeconfig found in secret %s at keys %v", secret.Name, kubeconfigKeys)
+	}
+
+	restConfig, err := restConfigFromKubeconfig(data)
+	if err != nil {
+		return nil, fmt.Errorf("invalid kubeconfig in secret %s: %w", secret.Name, err)
+	}
+
+	cl, err := cluster.NewSingleCluster(name, restConfig, f.config.Scheme, f.config.UserPrefixes, f.config.KubeConfigOptions...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create cluster %s: %w", name, err)
+	}
+
+	if f.config.UseSharedCache {
+		cl = cluster.NewSharedCacheCluster(cl, f.config.Scheme, cluster.FluxKinds, f.config.RulesReviewer)
+	} else if f.config.UseCachedClients {
+		cl = cluster.NewDelegatingCacheCluster(cl, restConfig, f.config.Scheme)
+	}
+
+	return cl, nil
+}
+
+// restConfigFromKubeconfig builds the REST config of a kubeconfig read from
+// a secret. Anyone who can write to the secrets namespace controls it, so the
+// kubeconfig may not run commands, use auth provider plugins or read files,
+// all of which run or read on the server with its own credentials.
+func restConfigFromKubeconfig(data []byte) (*rest.Config, error) {
+	config, err := clientcmd.Load(data)
+	if err != nil {
+		return nil, err
+	}
+
+	for name, user := range config.AuthInfos {
+		switch {
+		case user.Exec != nil:
+			return nil, fmt.Errorf("user %q: exec credential plugins are not allowed", name)
+		case user.AuthProvider != nil:
+			return nil, fmt.Errorf("user %q: auth provider plugins are not allowed", name)
+		case user.TokenFile != "" || user.ClientCertificate != "" || user.ClientKey != "":
+			return nil, fmt.Errorf("user %q: credentials read from files are not allowed", name)
+		}
+	}
+
+	for name, c := range config.Clusters {
+		if c.CertificateAuthority != "" {
+			return nil, fmt.Errorf("cluster %q: certificate authorities read from files are not allowed", name)
+		}
+	}
+
+	return clientcmd.NewDefaultClientConfig(*config, &clientcmd.ConfigOverrides{}).ClientConfig()
+}
+
+// Start watches the kubeconfig secrets and the Cluster API clusters until the
+// context is cancelled, updating the clusters of the manager as soon as they
+// change rather than on its next poll.
+func (f *SecretsClusterFetcher) Start(ctx context.Context, clustersManager mngr.ClustersManager) {
+	changed := make(chan struct{}, 1)
+
+	go f.watch(ctx, &corev1.SecretList{}, changed, client.HasLabels{KubeconfigSecretLabel})
+	go f.watch(ctx, &corev1.SecretList{}, changed, client.HasLabels{CAPIClusterNameLabel})
+
+	capiClusters := &unstructured.UnstructuredList{}
+	capiClusters.SetGroupVersionKind(CAPIClusterGVK.GroupVersion().WithKind(CAPIClusterGVK.Kind + "List"))
+
+	go f.watch(ctx, capiClusters, changed)
+
+	go func() {
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-changed:
+				if err := clustersManager.UpdateClusters(ctx); err != nil {
+					f.log.Error(err, "Failed to update clusters")
+				}
+			}
+		}
+	}()
+}
+
+// watch signals every change of the listed objects on changed, several
+// changes made while the clusters are being updated are signalled once.
+func (f *SecretsClusterFetcher) watch(ctx context.Context, list client.ObjectList, changed chan<- struct{}, opts ...client.ListOption) {
+	opts = append(opts, client.InNamespace(f.config.Namespace))
+
+	for {
+		w, err := f.client.Watch(ctx, list, opts...)
+		if err != nil {
+			if meta.IsNoMatchError(err) {
+				// Cluster API isn't installed, the clusters it provisions
+				// once installed are picked up by the manager's poll.
+				return
+			}
+
+			f.log.Error(err, "Failed to watch kubeconfig secrets")
+		} else {
+			f.forwardChanges(ctx, w, changed)
+			w.Stop()
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(watchRestartDelay):
+		}
+	}
+}
+
+func (f *SecretsClusterFetcher) forwardChanges(ctx context.Context, w watch.Interface, changed chan<- struct{}) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case event, ok := <-w.ResultChan():
+			if !ok {
+				return
+			}
+
+			switch event.Type {
+			case watch.Bookmark:
+				continue
+			case watch.Error:
+				f.log.Error(k8serrors.FromObject(event.Object), "Failed to watch kubeconfig secrets")
+				return
+			}
+
+			select {
+			case changed <- struct{}{}:
+			default:
+			}
+		}
+	}
+}
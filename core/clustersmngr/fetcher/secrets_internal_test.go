@@ -0,0 +1,94 @@
+package fetcher
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/gomega"
+	"github.com/weaveworks/weave-gitops/core/clustersmngr/cluster"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/clientcmd"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestSecretsFetcherStopsReplacedClusters(t *testing.T) {
+	g := NewGomegaWithT(t)
+	ctx := context.Background()
+
+	scheme := runtime.NewScheme()
+	g.Expect(corev1.AddToScheme(scheme)).To(Succeed())
+
+	c := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithRESTMapper(meta.NewDefaultRESTMapper(nil)).
+		WithObjects(kubeconfigSecret(t, "dev"), kubeconfigSecret(t, "prod")).
+		Build()
+
+	f := NewSecretsClusterFetcher(logr.Discard(), c, SecretsFetcherConfig{Namespace: "clusters"})
+
+	_, err := f.Fetch(ctx)
+	g.Expect(err).NotTo(HaveOccurred())
+
+	// track whether the clusters built from the secrets get stopped
+	stoppable := map[string]*stoppableCluster{}
+
+	for name, built := range f.clusters {
+		stoppable[name] = &stoppableCluster{Cluster: built.cluster}
+		f.clusters[name] = secretCluster{version: built.version, cluster: stoppable[name]}
+	}
+
+	secret := &corev1.Secret{}
+	g.Expect(c.Get(ctx, client.ObjectKey{Namespace: "clusters", Name: "dev"}, secret)).To(Succeed())
+	secret.Annotations = map[string]string{"changed": "true"}
+	g.Expect(c.Update(ctx, secret)).To(Succeed())
+
+	_, err = f.Fetch(ctx)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(stoppable["dev"].stopped).To(BeTrue(), "the cluster is replaced")
+	g.Expect(stoppable["prod"].stopped).To(BeFalse())
+
+	g.Expect(c.Delete(ctx, &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "prod", Namespace: "clusters"}})).To(Succeed())
+
+	_, err = f.Fetch(ctx)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(stoppable["prod"].stopped).To(BeTrue(), "the cluster is removed")
+}
+
+type stoppableCluster struct {
+	cluster.Cluster
+	stopped bool
+}
+
+func (c *stoppableCluster) Stop() {
+	c.stopped = true
+}
+
+func kubeconfigSecret(t *testing.T, name string) *corev1.Secret {
+	t.Helper()
+
+	config := clientcmdapi.NewConfig()
+	config.Clusters["cluster"] = &clientcmdapi.Cluster{Server: "https://" + name + ".example.com"}
+	config.AuthInfos["user"] = &clientcmdapi.AuthInfo{Token: "token"}
+	config.Contexts["context"] = &clientcmdapi.Context{Cluster: "cluster", AuthInfo: "user"}
+	config.CurrentContext = "context"
+
+	data, err := clientcmd.Write(*config)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: "clusters",
+			Labels:    map[string]string{KubeconfigSecretLabel: "true"},
+		},
+		Data: map[string][]byte{"value": data},
+	}
+}
@@ -0,0 +1,215 @@
+package fetcher_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/gomega"
+	"github.com/weaveworks/weave-gitops/core/clustersmngr/cluster"
+	"github.com/weaveworks/weave-gitops/core/clustersmngr/clustersmngrfakes"
+	"github.com/weaveworks/weave-gitops/core/clustersmngr/fetcher"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/clientcmd"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+const clustersNamespace = "clusters"
+
+func TestSecretsFetcher(t *testing.T) {
+	g := NewGomegaWithT(t)
+	ctx := context.Background()
+
+	c := newClustersClient(t,
+		kubeconfigSecret(t, "dev", "https://dev.example.com", map[string]string{fetcher.KubeconfigSecretLabel: "true"}),
+		kubeconfigSecret(t, "prod", "https://prod.example.com", map[string]string{fetcher.KubeconfigSecretLabel: "true"}),
+		// not labelled
+		kubeconfigSecret(t, "unlabelled", "https://unlabelled.example.com", nil),
+		// reserved for the cluster the server runs in
+		kubeconfigSecret(t, cluster.DefaultCluster, "https://default.example.com", map[string]string{fetcher.KubeconfigSecretLabel: "true"}),
+		&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "broken", Namespace: clustersNamespace, Labels: map[string]string{fetcher.KubeconfigSecretLabel: "true"}},
+			Data:       map[string][]byte{"value": []byte("not a kubeconfig")},
+		},
+		// Cluster API cluster and its kubeconfig
+		capiCluster("staging"),
+		kubeconfigSecret(t, "staging-kubeconfig", "https://staging.example.com", map[string]string{fetcher.CAPIClusterNameLabel: "staging"}),
+		// Cluster API cluster still being provisioned
+		capiCluster("provisioning"),
+	)
+
+	f := fetcher.NewSecretsClusterFetcher(logr.Discard(), c, fetcher.SecretsFetcherConfig{Namespace: clustersNamespace})
+
+	clusters, err := f.Fetch(ctx)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(hosts(clusters)).To(Equal(map[string]string{
+		"dev":     "https://dev.example.com",
+		"prod":    "https://prod.example.com",
+		"staging": "https://staging.example.com",
+	}))
+
+	t.Run("clusters are kept until their secret changes", func(t *testing.T) {
+		g := NewGomegaWithT(t)
+
+		secret := &corev1.Secret{}
+		g.Expect(c.Get(ctx, client.ObjectKey{Namespace: clustersNamespace, Name: "prod"}, secret)).To(Succeed())
+		secret.Data = kubeconfigSecret(t, "prod", "https://prod-2.example.com", nil).Data
+		g.Expect(c.Update(ctx, secret)).To(Succeed())
+
+		updated, err := f.Fetch(ctx)
+		g.Expect(err).NotTo(HaveOccurred())
+		g.Expect(hosts(updated)).To(HaveKeyWithValue("prod", "https://prod-2.example.com"))
+		g.Expect(updated[0]).To(BeIdenticalTo(clusters[0]))
+		g.Expect(updated[1]).NotTo(BeIdenticalTo(clusters[1]))
+	})
+
+	t.Run("deleted secrets remove their cluster", func(t *testing.T) {
+		g := NewGomegaWithT(t)
+
+		g.Expect(c.Delete(ctx, &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "dev", Namespace: clustersNamespace}})).To(Succeed())
+
+		updated, err := f.Fetch(ctx)
+		g.Expect(err).NotTo(HaveOccurred())
+		g.Expect(hosts(updated)).NotTo(HaveKey("dev"))
+	})
+}
+
+func TestSecretsFetcherWithoutClusterAPI(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	scheme := runtime.NewScheme()
+	g.Expect(corev1.AddToScheme(scheme)).To(Succeed())
+
+	// The Cluster API kind is unknown to the RESTMapper
+	c := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithRESTMapper(meta.NewDefaultRESTMapper(nil)).
+		WithObjects(kubeconfigSecret(t, "dev", "https://dev.example.com", map[string]string{fetcher.KubeconfigSecretLabel: "true"})).
+		Build()
+
+	f := fetcher.NewSecretsClusterFetcher(logr.Discard(), c, fetcher.SecretsFetcherConfig{Namespace: clustersNamespace})
+
+	clusters, err := f.Fetch(context.Background())
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(hosts(clusters)).To(Equal(map[string]string{"dev": "https://dev.example.com"}))
+}
+
+func TestSecretsFetcherStart(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	c := newClustersClient(t)
+	clustersManager := &clustersmngrfakes.FakeClustersManager{}
+
+	f := fetcher.NewSecretsClusterFetcher(logr.Discard(), c, fetcher.SecretsFetcherConfig{Namespace: clustersNamespace})
+	f.Start(ctx, clustersManager)
+
+	secret := kubeconfigSecret(t, "dev", "https://dev.example.com", map[string]string{fetcher.KubeconfigSecretLabel: "true"})
+	g.Expect(c.Create(ctx, secret)).To(Succeed())
+
+	// The watches are started in the background, keep changing the secret
+	// until one of them sees it.
+	g.Eventually(func() int {
+		secret.Annotations = map[string]string{"touched": time.Now().String()}
+		g.Expect(c.Update(ctx, secret)).To(Succeed())
+
+		return clustersManager.UpdateClustersCallCount()
+	}, 5*time.Second, 50*time.Millisecond).Should(BeNumerically(">", 0))
+}
+
+func TestSecretsFetcherRejectsUnsafeKubeconfigs(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	label := map[string]string{fetcher.KubeconfigSecretLabel: "true"}
+
+	c := newClustersClient(t,
+		kubeconfigSecret(t, "dev", "https://dev.example.com", label),
+		kubeconfigSecretWithUser(t, "exec", "https://exec.example.com", label, &clientcmdapi.AuthInfo{
+			Exec: &clientcmdapi.ExecConfig{Command: "sh", Args: []string{"-c", "id"}, APIVersion: "client.authentication.k8s.io/v1"},
+		}),
+		kubeconfigSecretWithUser(t, "auth-provider", "https://auth-provider.example.com", label, &clientcmdapi.AuthInfo{
+			AuthProvider: &clientcmdapi.AuthProviderConfig{Name: "oidc"},
+		}),
+		kubeconfigSecretWithUser(t, "token-file", "https://token-file.example.com", label, &clientcmdapi.AuthInfo{
+			TokenFile: "/var/run/secrets/kubernetes.io/serviceaccount/token",
+		}),
+	)
+
+	f := fetcher.NewSecretsClusterFetcher(logr.Discard(), c, fetcher.SecretsFetcherConfig{Namespace: clustersNamespace})
+
+	clusters, err := f.Fetch(context.Background())
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(hosts(clusters)).To(Equal(map[string]string{"dev": "https://dev.example.com"}))
+}
+
+func newClustersClient(t *testing.T, objects ...client.Object) client.WithWatch {
+	t.Helper()
+
+	scheme := runtime.NewScheme()
+	if err := corev1.AddToScheme(scheme); err != nil {
+		t.Fatal(err)
+	}
+
+	gv := fetcher.CAPIClusterGVK.GroupVersion()
+	scheme.AddKnownTypeWithName(fetcher.CAPIClusterGVK, &unstructured.Unstructured{})
+	scheme.AddKnownTypeWithName(gv.WithKind(fetcher.CAPIClusterGVK.Kind+"List"), &unstructured.UnstructuredList{})
+
+	return fake.NewClientBuilder().WithScheme(scheme).WithObjects(objects...).Build()
+}
+
+func kubeconfigSecret(t *testing.T, name, host string, labels map[string]string) *corev1.Secret {
+	t.Helper()
+
+	return kubeconfigSecretWithUser(t, name, host, labels, &clientcmdapi.AuthInfo{Token: "token"})
+}
+
+func kubeconfigSecretWithUser(t *testing.T, name, host string, labels map[string]string, user *clientcmdapi.AuthInfo) *corev1.Secret {
+	t.Helper()
+
+	config := clientcmdapi.NewConfig()
+	config.Clusters["cluster"] = &clientcmdapi.Cluster{Server: host}
+	config.AuthInfos["user"] = user
+	config.Contexts["context"] = &clientcmdapi.Context{Cluster: "cluster", AuthInfo: "user"}
+	config.CurrentContext = "context"
+
+	data, err := clientcmd.Write(*config)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: clustersNamespace,
+			Labels:    labels,
+		},
+		Data: map[string][]byte{"value": data},
+	}
+}
+
+func capiCluster(name string) *unstructured.Unstructured {
+	u := &unstructured.Unstructured{}
+	u.SetGroupVersionKind(fetcher.CAPIClusterGVK)
+	u.SetName(name)
+	u.SetNamespace(clustersNamespace)
+
+	return u
+}
+
+func hosts(clusters []cluster.Cluster) map[string]string {
+	result := map[string]string{}
+	for _, c := range clusters {
+		result[c.GetName()] = c.GetHost()
+	}
+
+	return result
+}
@@ -0,0 +1,339 @@
+package clustersmngr
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/weaveworks/weave-gitops/core/clustersmngr/cluster"
+	coretypes "github.com/weaveworks/weave-gitops/core/server/types"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/apimachinery/pkg/version"
+	"k8s.io/client-go/kubernetes"
+)
+
+// ClusterStatus is the outcome of the last probe of a cluster.
+type ClusterStatus string
+
+const (
+	// ClusterStatusUnknown is the status of the clusters that haven't been
+	// probed yet.
+	ClusterStatusUnknown ClusterStatus = "Unknown"
+	// ClusterStatusHealthy is the status of the clusters whose API server
+	// answered the last probe.
+	ClusterStatusHealthy ClusterStatus = "Healthy"
+	// ClusterStatusUnauthorized is the status of the clusters whose API
+	// server is reachable but rejected the server's credentials.
+	ClusterStatusUnauthorized ClusterStatus = "Unauthorized"
+	// ClusterStatusUnreachable is the status of the clusters whose API
+	// server couldn't be reached, requests to them fail straight away until
+	// a probe reaches them again.
+	ClusterStatusUnreachable ClusterStatus = "Unreachable"
+)
+
+var (
+	clusterProbeFrequency = getEnvDuration("WEAVE_GITOPS_CLUSTER_PROBE_INTERVAL", 30*time.Second)
+	// clusterProbeTimeout is how long each cluster is given to answer a
+	// probe, so that one slow cluster doesn't hold back the others' status.
+	clusterProbeTimeout = getEnvDuration("WEAVE_GITOPS_CLUSTER_PROBE_TIMEOUT", 10*time.Second)
+
+	opsClusterUp = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "gitops",
+			Subsystem: "clustersmngr",
+			Name:      "cluster_up",
+			Help:      "Whether the API server of the cluster answered the last probe",
+		},
+		[]string{"cluster"},
+	)
+	opsClusterProbeLatency = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "gitops",
+			Subsystem: "clustersmngr",
+			Name:      "cluster_probe_latency_seconds",
+			Help:      "How long the last probe of the cluster took",
+		},
+		[]string{"cluster"},
+	)
+	opsClusterLastSeen = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "gitops",
+			Subsystem: "clustersmngr",
+			Name:      "cluster_last_seen_timestamp_seconds",
+			Help:      "When the API server of the cluster last answered a probe",
+		},
+		[]string{"cluster"},
+	)
+)
+
+// ClusterHealth is what the last probe of a cluster found out.
+type ClusterHealth struct {
+	Name              string
+	Host              string
+	Status            ClusterStatus
+	Message           string
+	Latency           time.Duration
+	KubernetesVersion string
+	FluxInstalled     bool
+	FluxNamespace     string
+	FluxVersion       string
+	// LastProbed is when the cluster was last probed, and LastSeen when its
+	// API server last answered.
+	LastProbed time.Time
+	LastSeen   time.Time
+}
+
+// ClusterUnreachableError is returned for the clusters the last probe
+// couldn't reach, rather than waiting for the requests to time out.
+type ClusterUnreachableError struct {
+	Cluster  string
+	LastSeen time.Time
+	Message  string
+}
+
+func (e ClusterUnreachableError) Error() string {
+	if e.LastSeen.IsZero() {
+		return fmt.Sprintf("cluster=%s is unreachable: %s", e.Cluster, e.Message)
+	}
+
+	return fmt.Sprintf("cluster=%s is unreachable, last seen at %s: %s", e.Cluster, e.LastSeen.Format(time.RFC3339), e.Message)
+}
+
+type clustersHealth struct {
+	sync.RWMutex
+	health map[string]ClusterHealth
+}
+
+func (h *clustersHealth) get(name string) (ClusterHealth, bool) {
+	h.RLock()
+	defer h.RUnlock()
+
+	health, found := h.health[name]
+
+	return health, found
+}
+
+func (h *clustersHealth) names() []string {
+	h.RLock()
+	defer h.RUnlock()
+
+	names := []string{}
+	for name := range h.health {
+		names = append(names, name)
+	}
+
+	return names
+}
+
+func (h *clustersHealth) set(health map[string]ClusterHealth) {
+	h.Lock()
+	defer h.Unlock()
+
+	h.health = health
+}
+
+// unreachable returns the errors of the clusters the last probe couldn't
+// reach.
+func (h *clustersHealth) unreachable() map[string]error {
+	h.RLock()
+	defer h.RUnlock()
+
+	errs := map[string]error{}
+
+	for name, health := range h.health {
+		if health.Status == ClusterStatusUnreachable {
+			errs[name] = ClusterUnreachableError{Cluster: name, LastSeen: health.LastSeen, Message: health.Message}
+		}
+	}
+
+	return errs
+}
+
+// GetClustersHealth returns the health of all the clusters, sorted by name.
+func (cf *clustersManager) GetClustersHealth() []ClusterHealth {
+	result := []ClusterHealth{}
+
+	for _, cl := range cf.clusters.Get() {
+		health, found := cf.clustersHealth.get(cl.GetName())
+		if !found {
+			health = ClusterHealth{Name: cl.GetName(), Host: cl.GetHost(), Status: ClusterStatusUnknown}
+		}
+
+		result = append(result, health)
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Name < result[j].Name
+	})
+
+	return result
+}
+
+func (cf *clustersManager) watchClustersHealth(ctx context.Context) {
+	//nolint:staticcheck // deprecated, tracking issue: https://github.com/weaveworks/weave-gitops/issues/3812
+	if err := wait.PollImmediateInfiniteWithContext(ctx, clusterProbeFrequency, func(ctx context.Context) (bool, error) {
+		cf.UpdateClustersHealth(ctx)
+
+		return false, nil
+	}); err != nil {
+		cf.log.Error(err, "failed polling clusters health")
+	}
+}
+
+// UpdateClustersHealth probes all the clusters in parallel.
+func (cf *clustersManager) UpdateClustersHealth(ctx context.Context) {
+	clusters := cf.clusters.Get()
+
+	var (
+		mu     sync.Mutex
+		wg     sync.WaitGroup
+		health = map[string]ClusterHealth{}
+	)
+
+	for _, cl := range clusters {
+		wg.Add(1)
+
+		go func(cl cluster.Cluster) {
+			defer wg.Done()
+
+			ctx, cancel := context.WithTimeout(ctx, probeTimeout(clusterProbeFrequency, clusterProbeTimeout))
+			defer cancel()
+
+			previous, _ := cf.clustersHealth.get(cl.GetName())
+			h := probeCluster(ctx, cl, previous, time.Now())
+
+			mu.Lock()
+			health[cl.GetName()] = h
+			mu.Unlock()
+		}(cl)
+	}
+
+	wg.Wait()
+
+	// forget the metrics of the removed clusters
+	for _, name := range cf.clustersHealth.names() {
+		if _, found := health[name]; !found {
+			opsClusterUp.DeleteLabelValues(name)
+			opsClusterProbeLatency.DeleteLabelValues(name)
+			opsClusterLastSeen.DeleteLabelValues(name)
+			cf.circuitBreakers.forget(name)
+		}
+	}
+
+	for name, h := range health {
+		up := 0.0
+		if h.Status == ClusterStatusHealthy {
+			up = 1
+		}
+
+		opsClusterUp.WithLabelValues(name).Set(up)
+		opsClusterProbeLatency.WithLabelValues(name).Set(h.Latency.Seconds())
+
+		if !h.LastSeen.IsZero() {
+			opsClusterLastSeen.WithLabelValues(name).Set(float64(h.LastSeen.Unix()))
+		}
+	}
+
+	cf.clustersHealth.set(health)
+}
+
+// probeTimeout returns the deadline of each cluster's probe, shorter than the
+// interval between probes so that a probe ends before the next starts.
+func probeTimeout(interval, timeout time.Duration) time.Duration {
+	if timeout <= 0 || timeout >= interval {
+		return interval / 2
+	}
+
+	return timeout
+}
+
+// probeCluster asks the API server of the cluster for its version, and looks
+// for the namespace Flux is installed in.
+func probeCluster(ctx context.Context, cl cluster.Cluster, previous ClusterHealth, now time.Time) ClusterHealth {
+	health := ClusterHealth{
+		Name:       cl.GetName(),
+		Host:       cl.GetHost(),
+		Status:     ClusterStatusUnreachable,
+		LastProbed: now,
+		LastSeen:   previous.LastSeen,
+	}
+
+	clientset, err := cl.GetServerClientset()
+	if err != nil {
+		health.Message = fmt.Sprintf("failed creating clientset: %s", err)
+		return health
+	}
+
+	if clientset == nil {
+		health.Message = "no clientset for the cluster"
+		return health
+	}
+
+	start := time.Now()
+	info, err := serverVersion(ctx, clientset)
+	health.Latency = time.Since(start)
+
+	if err != nil {
+		health.Message = err.Error()
+
+		if k8serrors.IsUnauthorized(err) || k8serrors.IsForbidden(err) {
+			health.Status = ClusterStatusUnauthorized
+			health.LastSeen = now
+		}
+
+		return health
+	}
+
+	health.Status = ClusterStatusHealthy
+	health.LastSeen = now
+	health.KubernetesVersion = info.GitVersion
+
+	namespaces, err := clientset.CoreV1().Namespaces().List(ctx, metav1.ListOptions{
+		LabelSelector: labels.Set{coretypes.PartOfLabel: "flux"}.String(),
+	})
+	if err != nil {
+		health.Message = fmt.Sprintf("couldn't look for Flux: %s", err)
+		return health
+	}
+
+	for _, ns := range namespaces.Items {
+		health.FluxInstalled = true
+		health.FluxNamespace = ns.Name
+		health.FluxVersion = ns.Labels[coretypes.VersionLabel]
+
+		if health.FluxVersion != "" {
+			break
+		}
+	}
+
+	return health
+}
+
+// serverVersion asks the API server for its version until the context is
+// done, the discovery client doesn't take a context.
+func serverVersion(ctx context.Context, clientset kubernetes.Interface) (*version.Info, error) {
+	type result struct {
+		info *version.Info
+		err  error
+	}
+
+	done := make(chan result, 1)
+
+	go func() {
+		info, err := clientset.Discovery().ServerVersion()
+		done <- result{info, err}
+	}()
+
+	select {
+	case r := <-done:
+		return r.info, r.err
+	case <-ctx.Done():
+		return nil, fmt.Errorf("probe timed out: %w", ctx.Err())
+	}
+}
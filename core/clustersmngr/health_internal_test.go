@@ -0,0 +1,48 @@
+package clustersmngr
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	"github.com/weaveworks/weave-gitops/core/clustersmngr/cluster/clusterfakes"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+)
+
+func TestProbeClusterTimesOut(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	hang := make(chan struct{})
+	defer close(hang)
+
+	clientset := fake.NewSimpleClientset()
+	clientset.PrependReactor("get", "version", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		<-hang
+		return true, nil, nil
+	})
+
+	cl := &clusterfakes.FakeCluster{}
+	cl.GetNameReturns("slow")
+	cl.GetServerClientsetReturns(clientset, nil)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	health := probeCluster(ctx, cl, ClusterHealth{}, start)
+
+	g.Expect(time.Since(start)).To(BeNumerically("<", time.Second))
+	g.Expect(health.Status).To(Equal(ClusterStatusUnreachable))
+	g.Expect(health.Message).To(ContainSubstring("probe timed out"))
+}
+
+func TestProbeTimeout(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	g.Expect(probeTimeout(30*time.Second, 10*time.Second)).To(Equal(10 * time.Second))
+	g.Expect(probeTimeout(5*time.Second, 10*time.Second)).To(Equal(2500*time.Millisecond), "shorter than the interval")
+	g.Expect(probeTimeout(30*time.Second, 0)).To(Equal(15 * time.Second))
+}
@@ -0,0 +1,150 @@
+package clustersmngr_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/gomega"
+	"github.com/weaveworks/weave-gitops/core/clustersmngr"
+	"github.com/weaveworks/weave-gitops/core/clustersmngr/cluster"
+	"github.com/weaveworks/weave-gitops/core/clustersmngr/cluster/clusterfakes"
+	"github.com/weaveworks/weave-gitops/core/clustersmngr/clustersmngrfakes"
+	"github.com/weaveworks/weave-gitops/core/nsaccess/nsaccessfakes"
+	"github.com/weaveworks/weave-gitops/pkg/server/auth"
+	v1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/version"
+	fakediscovery "k8s.io/client-go/discovery/fake"
+	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestClustersHealth(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	healthy := makeProbedCluster("healthy", nil,
+		&v1.Namespace{ObjectMeta: metav1.ObjectMeta{
+			Name: "flux-system",
+			Labels: map[string]string{
+				"app.kubernetes.io/part-of": "flux",
+				"app.kubernetes.io/version": "v2.1.2",
+			},
+		}},
+	)
+	noFlux := makeProbedCluster("no-flux", nil)
+	unauthorized := makeProbedCluster("unauthorized", k8serrors.NewUnauthorized("invalid token"))
+	unreachable := makeProbedCluster("unreachable", errors.New("dial tcp 10.0.0.1:443: connect: connection refused"))
+
+	clustersFetcher := &clustersmngrfakes.FakeClusterFetcher{}
+	clustersFetcher.FetchReturns([]cluster.Cluster{healthy, noFlux, unauthorized, unreachable}, nil)
+
+	nsChecker := &nsaccessfakes.FakeChecker{}
+	nsChecker.FilterAccessibleNamespacesReturns([]v1.Namespace{{ObjectMeta: metav1.ObjectMeta{Name: "default"}}}, nil)
+
+	clustersManager := clustersmngr.NewClustersManager([]clustersmngr.ClusterFetcher{clustersFetcher}, nsChecker, logr.Discard())
+	g.Expect(clustersManager.UpdateClusters(ctx)).To(Succeed())
+
+	t.Run("clusters are unknown until probed", func(t *testing.T) {
+		g := NewGomegaWithT(t)
+
+		for _, h := range clustersManager.GetClustersHealth() {
+			g.Expect(h.Status).To(Equal(clustersmngr.ClusterStatusUnknown), h.Name)
+		}
+	})
+
+	clustersManager.UpdateClustersHealth(ctx)
+
+	health := map[string]clustersmngr.ClusterHealth{}
+	for _, h := range clustersManager.GetClustersHealth() {
+		health[h.Name] = h
+	}
+
+	t.Run("probes the clusters", func(t *testing.T) {
+		g := NewGomegaWithT(t)
+
+		g.Expect(health).To(HaveLen(4))
+
+		g.Expect(health["healthy"].Status).To(Equal(clustersmngr.ClusterStatusHealthy))
+		g.Expect(health["healthy"].KubernetesVersion).To(Equal("v1.28.3"))
+		g.Expect(health["healthy"].FluxInstalled).To(BeTrue())
+		g.Expect(health["healthy"].FluxNamespace).To(Equal("flux-system"))
+		g.Expect(health["healthy"].FluxVersion).To(Equal("v2.1.2"))
+		g.Expect(health["healthy"].LastSeen).NotTo(BeZero())
+
+		g.Expect(health["no-flux"].Status).To(Equal(clustersmngr.ClusterStatusHealthy))
+		g.Expect(health["no-flux"].FluxInstalled).To(BeFalse())
+
+		g.Expect(health["unauthorized"].Status).To(Equal(clustersmngr.ClusterStatusUnauthorized))
+		g.Expect(health["unauthorized"].LastSeen).NotTo(BeZero())
+
+		g.Expect(health["unreachable"].Status).To(Equal(clustersmngr.ClusterStatusUnreachable))
+		g.Expect(health["unreachable"].Message).To(ContainSubstring("connection refused"))
+		g.Expect(health["unreachable"].LastSeen).To(BeZero())
+		g.Expect(health["unreachable"].LastProbed).NotTo(BeZero())
+	})
+
+	t.Run("requests to unreachable clusters fail straight away", func(t *testing.T) {
+		g := NewGomegaWithT(t)
+
+		clustersClient, err := clustersManager.GetImpersonatedClient(ctx, &auth.UserPrincipal{ID: "user-id"})
+		g.Expect(err).NotTo(HaveOccurred())
+
+		list := clustersmngr.NewClusteredList(func() client.ObjectList {
+			return &v1.NamespaceList{}
+		})
+
+		err = clustersClient.ClusteredList(ctx, list, false)
+
+		var listErr clustersmngr.ClusteredListError
+		g.Expect(errors.As(err, &listErr)).To(BeTrue())
+		g.Expect(listErr.Errors).To(HaveLen(1))
+		g.Expect(listErr.Errors[0].Cluster).To(Equal("unreachable"))
+		g.Expect(listErr.Errors[0].Err).To(BeAssignableToTypeOf(clustersmngr.ClusterUnreachableError{}))
+
+		g.Expect(list.Lists()).To(HaveKey("healthy"))
+		g.Expect(list.Lists()).NotTo(HaveKey("unreachable"))
+
+		err = clustersClient.Get(ctx, "unreachable", client.ObjectKey{Name: "default"}, &v1.Namespace{})
+		g.Expect(err).To(MatchError(ContainSubstring("cluster=unreachable is unreachable")))
+
+		// the user's namespaces aren't looked up on the unreachable cluster
+		g.Expect(unreachable.GetUserClientsetCallCount()).To(BeZero())
+	})
+}
+
+// makeProbedCluster returns a cluster whose API server answers probes with
+// probeErr, or with its version and the given namespaces.
+func makeProbedCluster(name string, probeErr error, namespaces ...runtime.Object) *clusterfakes.FakeCluster {
+	clientset := fake.NewSimpleClientset(namespaces...)
+	clientset.Discovery().(*fakediscovery.FakeDiscovery).FakedServerVersion = &version.Info{GitVersion: "v1.28.3"}
+
+	if probeErr != nil {
+		clientset.PrependReactor("get", "version", func(action k8stesting.Action) (bool, runtime.Object, error) {
+			return true, nil, probeErr
+		})
+	}
+
+	scheme := runtime.NewScheme()
+	_ = v1.AddToScheme(scheme)
+
+	leafClient := fakeclient.NewClientBuilder().WithScheme(scheme).WithRuntimeObjects(namespaces...).Build()
+
+	c := &clusterfakes.FakeCluster{}
+	c.GetNameReturns(name)
+	c.GetHostReturns("https://" + name + ".example.com")
+	c.GetServerClientsetReturns(clientset, nil)
+	c.GetUserClientsetReturns(clientset, nil)
+	c.GetServerClientReturns(leafClient, nil)
+	c.GetUserClientReturns(leafClient, nil)
+
+	return c
+}
@@ -0,0 +1,98 @@
+package clustersmngr
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/watch"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+var errReadOnly = errors.New("the user is restricted to read-only access")
+
+// readOnlyClient refuses every write, for the users restricted to read-only
+// access such as read-only access tokens. Reads go to the wrapped client.
+type readOnlyClient struct {
+	client.Client
+}
+
+func newReadOnlyClient(c client.Client) client.Client {
+	if wc, ok := c.(client.WithWatch); ok {
+		return &readOnlyWatchClient{readOnlyClient: &readOnlyClient{Client: c}, watcher: wc}
+	}
+
+	return &readOnlyClient{Client: c}
+}
+
+// readOnlyWatchClient is a readOnlyClient for the clients that can also
+// watch, like the users' clients.
+type readOnlyWatchClient struct {
+	*readOnlyClient
+	watcher client.WithWatch
+}
+
+func (c *readOnlyWatchClient) Watch(ctx context.Context, list client.ObjectList, opts ...client.ListOption) (watch.Interface, error) {
+	return c.watcher.Watch(ctx, list, opts...)
+}
+
+func (c *readOnlyClient) Create(ctx context.Context, obj client.Object, opts ...client.CreateOption) error {
+	return c.forbidden(obj)
+}
+
+func (c *readOnlyClient) Update(ctx context.Context, obj client.Object, opts ...client.UpdateOption) error {
+	return c.forbidden(obj)
+}
+
+func (c *readOnlyClient) Patch(ctx context.Context, obj client.Object, patch client.Patch, opts ...client.PatchOption) error {
+	return c.forbidden(obj)
+}
+
+func (c *readOnlyClient) Delete(ctx context.Context, obj client.Object, opts ...client.DeleteOption) error {
+	return c.forbidden(obj)
+}
+
+func (c *readOnlyClient) DeleteAllOf(ctx context.Context, obj client.Object, opts ...client.DeleteAllOfOption) error {
+	return c.forbidden(obj)
+}
+
+func (c *readOnlyClient) Status() client.SubResourceWriter {
+	return c.SubResource("status")
+}
+
+func (c *readOnlyClient) SubResource(subResource string) client.SubResourceClient {
+	return &readOnlySubResourceClient{SubResourceClient: c.Client.SubResource(subResource), parent: c}
+}
+
+func (c *readOnlyClient) forbidden(obj client.Object) error {
+	gr := schema.GroupResource{}
+
+	if gvk, err := c.GroupVersionKindFor(obj); err == nil {
+		gr = schema.GroupResource{Group: gvk.Group, Resource: strings.ToLower(gvk.Kind)}
+
+		if mapping, err := c.RESTMapper().RESTMapping(gvk.GroupKind(), gvk.Version); err == nil {
+			gr = mapping.Resource.GroupResource()
+		}
+	}
+
+	return k8serrors.NewForbidden(gr, obj.GetName(), errReadOnly)
+}
+
+type readOnlySubResourceClient struct {
+	client.SubResourceClient
+	parent *readOnlyClient
+}
+
+func (c *readOnlySubResourceClient) Create(ctx context.Context, obj client.Object, subResource client.Object, opts ...client.SubResourceCreateOption) error {
+	return c.parent.forbidden(obj)
+}
+
+func (c *readOnlySubResourceClient) Update(ctx context.Context, obj client.Object, opts ...client.SubResourceUpdateOption) error {
+	return c.parent.forbidden(obj)
+}
+
+func (c *readOnlySubResourceClient) Patch(ctx context.Context, obj client.Object, patch client.Patch, opts ...client.SubResourcePatchOption) error {
+	return c.parent.forbidden(obj)
+}
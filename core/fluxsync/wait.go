@@ -0,0 +1,173 @@
+package fluxsync
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/fluxcd/pkg/apis/meta"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// The stages an object goes through after a reconciliation request.
+const (
+	StageRequested       = "Requested"
+	StageSourceFetched   = "SourceFetched"
+	StageRevisionApplied = "RevisionApplied"
+	StageProgressing     = "Progressing"
+	StageReady           = "Ready"
+	StageFailed          = "Failed"
+	StageTimedOut        = "TimedOut"
+)
+
+// DefaultWaitTimeout is how long WaitForReady waits if no deadline is given.
+const DefaultWaitTimeout = 5 * time.Minute
+
+// Progress is a stage reached by an object, with the revision it had then.
+type Progress struct {
+	Stage    string
+	Revision string
+	Message  string
+}
+
+// IsFinal returns true if the object won't progress any further on its own.
+func (p Progress) IsFinal() bool {
+	return p.Stage == StageReady || p.Stage == StageFailed || p.Stage == StageTimedOut
+}
+
+// WaitForReady polls the object until the controller has handled the
+// reconciliation requested after lastReconcile, and the Ready condition is
+// either True or False. The stages reached along the way are passed to
+// report, which may be nil. It returns the last stage reached, which is
+// TimedOut if the object isn't ready by the deadline, so that the objects
+// synced together share the time they are given.
+func WaitForReady(ctx context.Context, c client.Client, key client.ObjectKey, obj Reconcilable, lastReconcile string, deadline time.Time, report func(Progress)) (Progress, error) {
+	if deadline.IsZero() {
+		deadline = time.Now().Add(DefaultWaitTimeout)
+	}
+
+	var (
+		last     Progress
+		reported = map[string]bool{}
+	)
+
+	notify := func(p Progress) {
+		last = p
+
+		if reported[p.Stage] {
+			return
+		}
+
+		reported[p.Stage] = true
+
+		if report != nil {
+			report(p)
+		}
+	}
+
+	err := wait.PollUntilContextTimeout(ctx, k8sPollInterval, time.Until(deadline), true, func(ctx context.Context) (bool, error) {
+		if err := c.Get(ctx, key, obj.AsClientObject()); err != nil {
+			return false, err
+		}
+
+		if obj.GetLastHandledReconcileRequest() == lastReconcile {
+			return false, nil
+		}
+
+		for _, p := range SyncProgress(obj) {
+			notify(p)
+		}
+
+		return last.IsFinal(), nil
+	})
+	if err != nil {
+		if wait.Interrupted(err) && ctx.Err() == nil {
+			timedOut := Progress{
+				Stage:    StageTimedOut,
+				Revision: last.Revision,
+				Message:  fmt.Sprintf("not ready by %s, the reconciliation may still be in progress", deadline.Format(time.RFC3339)),
+			}
+			notify(timedOut)
+
+			return timedOut, nil
+		}
+
+		return last, fmt.Errorf("waiting for object: %w", err)
+	}
+
+	return last, nil
+}
+
+// SyncProgress returns the stages an object has reached in its last
+// reconciliation: the artifact fetched by sources, or the revision applied by
+// automations, and the outcome.
+func SyncProgress(obj Reconcilable) []Progress {
+	progress := []Progress{}
+
+	revision, isSource := Revision(obj)
+	if revision != "" {
+		stage := StageRevisionApplied
+		if isSource {
+			stage = StageSourceFetched
+		}
+
+		progress = append(progress, Progress{Stage: stage, Revision: revision})
+	}
+
+	conditions := obj.GetConditions()
+	ready := apimeta.FindStatusCondition(conditions, meta.ReadyCondition)
+	stalled := apimeta.FindStatusCondition(conditions, meta.StalledCondition)
+
+	outcome := Progress{Stage: StageProgressing, Revision: revision}
+
+	switch {
+	case stalled != nil && stalled.Status == metav1.ConditionTrue:
+		outcome.Stage = StageFailed
+		outcome.Message = stalled.Message
+	case ready == nil || ready.ObservedGeneration < obj.GetGeneration():
+	case ready.Status == metav1.ConditionTrue:
+		outcome.Stage = StageReady
+		outcome.Message = ready.Message
+	case ready.Status == metav1.ConditionFalse:
+		outcome.Stage = StageFailed
+		outcome.Message = ready.Message
+	default:
+		outcome.Message = ready.Message
+	}
+
+	return append(progress, outcome)
+}
+
+// Revision returns the revision of the artifact of a source, or the last
+// revision applied by an automation. isSource is true for the former.
+func Revision(obj Reconcilable) (revision string, isSource bool) {
+	content, err := runtime.DefaultUnstructuredConverter.ToUnstructured(obj.AsClientObject())
+	if err != nil {
+		return "", false
+	}
+
+	if revision, _, _ := unstructured.NestedString(content, "status", "artifact", "revision"); revision != "" {
+		return revision, true
+	}
+
+	revision, _, _ = unstructured.NestedString(content, "status", "lastAppliedRevision")
+	if revision != "" {
+		return revision, false
+	}
+
+	// HelmReleases record the chart version of their releases in the history,
+	// the latest first.
+	history, _, _ := unstructured.NestedSlice(content, "status", "history")
+	if len(history) > 0 {
+		if latest, ok := history[0].(map[string]interface{}); ok {
+			revision, _, _ = unstructured.NestedString(latest, "chartVersion")
+		}
+	}
+
+	return revision, false
+}
@@ -0,0 +1,151 @@
+package fluxsync
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	kustomizev1 "github.com/fluxcd/kustomize-controller/api/v1"
+	"github.com/fluxcd/pkg/apis/meta"
+	sourcev1 "github.com/fluxcd/source-controller/api/v1"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestWaitForReady(t *testing.T) {
+	g := NewGomegaWithT(t)
+	ctx := context.Background()
+
+	defer func(interval time.Duration) { k8sPollInterval = interval }(k8sPollInterval)
+	k8sPollInterval = 10 * time.Millisecond
+
+	scheme := runtime.NewScheme()
+	g.Expect(kustomizev1.AddToScheme(scheme)).To(Succeed())
+
+	ks := &kustomizev1.Kustomization{
+		ObjectMeta: metav1.ObjectMeta{Name: "podinfo", Namespace: "flux-system"},
+		Status: kustomizev1.KustomizationStatus{
+			ReconcileRequestStatus: meta.ReconcileRequestStatus{LastHandledReconcileAt: "before"},
+			LastAppliedRevision:    "main@sha1:a",
+			Conditions: []metav1.Condition{
+				{Type: meta.ReadyCondition, Status: metav1.ConditionTrue, Message: "Applied revision: main@sha1:a"},
+			},
+		},
+	}
+
+	c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(ks).WithStatusSubresource(ks).Build()
+	key := client.ObjectKeyFromObject(ks)
+
+	// The controller handles the request and applies a new revision.
+	go func() {
+		time.Sleep(50 * time.Millisecond)
+
+		reconciled := &kustomizev1.Kustomization{}
+		if err := c.Get(ctx, key, reconciled); err != nil {
+			return
+		}
+
+		reconciled.Status.LastHandledReconcileAt = "after"
+		reconciled.Status.LastAppliedRevision = "main@sha1:b"
+		reconciled.Status.Conditions = []metav1.Condition{
+			{Type: meta.ReadyCondition, Status: metav1.ConditionTrue, Message: "Applied revision: main@sha1:b"},
+		}
+		_ = c.Status().Update(ctx, reconciled)
+	}()
+
+	stages := []string{}
+	report := func(p Progress) {
+		stages = append(stages, p.Stage)
+	}
+
+	progress, err := WaitForReady(ctx, c, key, ToReconcileable(kustomizev1.GroupVersion.WithKind(kustomizev1.KustomizationKind)), "before", time.Now().Add(time.Second), report)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(progress).To(Equal(Progress{Stage: StageReady, Revision: "main@sha1:b", Message: "Applied revision: main@sha1:b"}))
+	g.Expect(stages).To(Equal([]string{StageRevisionApplied, StageReady}))
+
+	// Nothing handles the next request.
+	stages = []string{}
+
+	progress, err = WaitForReady(ctx, c, key, ToReconcileable(kustomizev1.GroupVersion.WithKind(kustomizev1.KustomizationKind)), "after", time.Now().Add(50*time.Millisecond), report)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(progress.Stage).To(Equal(StageTimedOut))
+	g.Expect(stages).To(Equal([]string{StageTimedOut}))
+}
+
+func TestSyncProgress(t *testing.T) {
+	tests := []struct {
+		name     string
+		obj      Reconcilable
+		expected []Progress
+	}{
+		{
+			name: "source fetched",
+			obj: GitRepositoryAdapter{GitRepository: &sourcev1.GitRepository{
+				Status: sourcev1.GitRepositoryStatus{
+					Artifact: &sourcev1.Artifact{Revision: "main@sha1:a"},
+					Conditions: []metav1.Condition{
+						{Type: meta.ReadyCondition, Status: metav1.ConditionTrue, Message: "stored artifact"},
+					},
+				},
+			}},
+			expected: []Progress{
+				{Stage: StageSourceFetched, Revision: "main@sha1:a"},
+				{Stage: StageReady, Revision: "main@sha1:a", Message: "stored artifact"},
+			},
+		},
+		{
+			name: "failed",
+			obj: KustomizationAdapter{Kustomization: &kustomizev1.Kustomization{
+				Status: kustomizev1.KustomizationStatus{
+					Conditions: []metav1.Condition{
+						{Type: meta.ReadyCondition, Status: metav1.ConditionFalse, Message: "kustomize build failed"},
+					},
+				},
+			}},
+			expected: []Progress{
+				{Stage: StageFailed, Message: "kustomize build failed"},
+			},
+		},
+		{
+			name: "stalled",
+			obj: KustomizationAdapter{Kustomization: &kustomizev1.Kustomization{
+				Status: kustomizev1.KustomizationStatus{
+					Conditions: []metav1.Condition{
+						{Type: meta.ReadyCondition, Status: metav1.ConditionUnknown},
+						{Type: meta.StalledCondition, Status: metav1.ConditionTrue, Message: "invalid path"},
+					},
+				},
+			}},
+			expected: []Progress{
+				{Stage: StageFailed, Message: "invalid path"},
+			},
+		},
+		{
+			name: "ready for a previous generation",
+			obj: KustomizationAdapter{Kustomization: &kustomizev1.Kustomization{
+				ObjectMeta: metav1.ObjectMeta{Generation: 2},
+				Status: kustomizev1.KustomizationStatus{
+					LastAppliedRevision: "main@sha1:a",
+					Conditions: []metav1.Condition{
+						{Type: meta.ReadyCondition, Status: metav1.ConditionTrue, ObservedGeneration: 1},
+					},
+				},
+			}},
+			expected: []Progress{
+				{Stage: StageRevisionApplied, Revision: "main@sha1:a"},
+				{Stage: StageProgressing, Revision: "main@sha1:a"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewGomegaWithT(t)
+
+			g.Expect(SyncProgress(tt.obj)).To(Equal(tt.expected))
+		})
+	}
+}
@@ -0,0 +1,143 @@
+// Package freeze describes change freezes: windows, one-off or recurring,
+// during which a selection of Flux objects is kept suspended.
+package freeze
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/robfig/cron/v3"
+	"k8s.io/apimachinery/pkg/util/validation"
+)
+
+// ObjectRef identifies a Flux object on a cluster.
+type ObjectRef struct {
+	ClusterName string `json:"clusterName"`
+	Kind        string `json:"kind"`
+	Namespace   string `json:"namespace"`
+	Name        string `json:"name"`
+}
+
+// Selector matches the objects of a kind, optionally limited to a cluster, a
+// namespace and labels.
+type Selector struct {
+	Kind        string            `json:"kind"`
+	ClusterName string            `json:"clusterName,omitempty"`
+	Namespace   string            `json:"namespace,omitempty"`
+	Labels      map[string]string `json:"labels,omitempty"`
+}
+
+// Principal is the user the objects of a freeze are suspended and resumed
+// as.
+type Principal struct {
+	ID     string   `json:"id"`
+	Groups []string `json:"groups,omitempty"`
+}
+
+// Freeze suspends its objects during a window, either the one-off window
+// between Start and End, or the recurring windows of Duration starting on
+// Schedule.
+type Freeze struct {
+	Name      string      `json:"name"`
+	Objects   []ObjectRef `json:"objects,omitempty"`
+	Selectors []Selector  `json:"selectors,omitempty"`
+
+	Start time.Time `json:"start,omitempty"`
+	End   time.Time `json:"end,omitempty"`
+
+	// Schedule is a standard cron expression, with an optional TZ= prefix.
+	Schedule string        `json:"schedule,omitempty"`
+	Duration time.Duration `json:"duration,omitempty"`
+
+	Comment   string    `json:"comment,omitempty"`
+	CreatedBy Principal `json:"createdBy"`
+
+	// ActiveUntil is the end of the window the objects were last suspended
+	// for, zero once they have been resumed.
+	ActiveUntil time.Time `json:"activeUntil,omitempty"`
+}
+
+// Validate checks the freeze has a name, objects and exactly one kind of
+// window.
+func (f Freeze) Validate() error {
+	if errs := validation.IsDNS1123Subdomain(f.Name); len(errs) > 0 {
+		return fmt.Errorf("invalid name %q: %v", f.Name, errs[0])
+	}
+
+	if len(f.Objects) == 0 && len(f.Selectors) == 0 {
+		return errors.New("no objects or selectors")
+	}
+
+	if f.Schedule == "" {
+		if f.End.IsZero() {
+			return errors.New("either an end or a schedule is required")
+		}
+
+		if !f.Start.IsZero() && !f.Start.Before(f.End) {
+			return errors.New("start must be before end")
+		}
+
+		return nil
+	}
+
+	if !f.Start.IsZero() || !f.End.IsZero() {
+		return errors.New("start and end can't be used with a schedule")
+	}
+
+	if _, err := cron.ParseStandard(f.Schedule); err != nil {
+		return fmt.Errorf("invalid schedule: %w", err)
+	}
+
+	if f.Duration <= 0 {
+		return errors.New("a schedule needs a positive duration")
+	}
+
+	return nil
+}
+
+// Window returns the window open at now, if any.
+func (f Freeze) Window(now time.Time) (start, end time.Time, open bool) {
+	if f.Schedule == "" {
+		return f.Start, f.End, !now.Before(f.Start) && now.Before(f.End)
+	}
+
+	sched, err := cron.ParseStandard(f.Schedule)
+	if err != nil {
+		return time.Time{}, time.Time{}, false
+	}
+
+	// The only window that can be open is the first one starting after
+	// now - Duration.
+	start = sched.Next(now.Add(-f.Duration))
+	if start.IsZero() || start.After(now) {
+		return time.Time{}, time.Time{}, false
+	}
+
+	return start, start.Add(f.Duration), true
+}
+
+// NextWindow returns the next window starting after now, if any.
+func (f Freeze) NextWindow(now time.Time) (start, end time.Time, ok bool) {
+	if f.Schedule == "" {
+		return f.Start, f.End, now.Before(f.Start)
+	}
+
+	sched, err := cron.ParseStandard(f.Schedule)
+	if err != nil {
+		return time.Time{}, time.Time{}, false
+	}
+
+	start = sched.Next(now)
+	if start.IsZero() {
+		return time.Time{}, time.Time{}, false
+	}
+
+	return start, start.Add(f.Duration), true
+}
+
+// Expired returns true once a one-off freeze has ended, recurring freezes
+// never expire.
+func (f Freeze) Expired(now time.Time) bool {
+	return f.Schedule == "" && !now.Before(f.End)
+}
@@ -0,0 +1,190 @@
+package freeze_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	"github.com/weaveworks/weave-gitops/core/freeze"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+var podinfo = freeze.ObjectRef{ClusterName: "Default", Kind: "Kustomization", Namespace: "flux-system", Name: "podinfo"}
+
+func TestValidate(t *testing.T) {
+	start := time.Date(2023, 12, 22, 18, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name   string
+		freeze freeze.Freeze
+		err    string
+	}{
+		{
+			name:   "one-off",
+			freeze: freeze.Freeze{Name: "holidays", Objects: []freeze.ObjectRef{podinfo}, Start: start, End: start.Add(24 * time.Hour)},
+		},
+		{
+			name:   "recurring",
+			freeze: freeze.Freeze{Name: "weekends", Selectors: []freeze.Selector{{Kind: "Kustomization"}}, Schedule: "0 18 * * FRI", Duration: 62 * time.Hour},
+		},
+		{
+			name:   "invalid name",
+			freeze: freeze.Freeze{Name: "Week ends", Objects: []freeze.ObjectRef{podinfo}, End: start},
+			err:    "invalid name",
+		},
+		{
+			name:   "no objects",
+			freeze: freeze.Freeze{Name: "holidays", End: start},
+			err:    "no objects or selectors",
+		},
+		{
+			name:   "no window",
+			freeze: freeze.Freeze{Name: "holidays", Objects: []freeze.ObjectRef{podinfo}},
+			err:    "either an end or a schedule is required",
+		},
+		{
+			name:   "ends before it starts",
+			freeze: freeze.Freeze{Name: "holidays", Objects: []freeze.ObjectRef{podinfo}, Start: start, End: start},
+			err:    "start must be before end",
+		},
+		{
+			name:   "invalid schedule",
+			freeze: freeze.Freeze{Name: "weekends", Objects: []freeze.ObjectRef{podinfo}, Schedule: "on fridays", Duration: time.Hour},
+			err:    "invalid schedule",
+		},
+		{
+			name:   "schedule without duration",
+			freeze: freeze.Freeze{Name: "weekends", Objects: []freeze.ObjectRef{podinfo}, Schedule: "0 18 * * FRI"},
+			err:    "a schedule needs a positive duration",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewGomegaWithT(t)
+
+			err := tt.freeze.Validate()
+			if tt.err == "" {
+				g.Expect(err).NotTo(HaveOccurred())
+				return
+			}
+
+			g.Expect(err).To(MatchError(ContainSubstring(tt.err)))
+		})
+	}
+}
+
+func TestWindow(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	// Friday 18:00 until Monday 08:00.
+	weekends := freeze.Freeze{Schedule: "0 18 * * FRI", Duration: 62 * time.Hour}
+
+	friday := time.Date(2023, 12, 22, 18, 0, 0, 0, time.UTC)
+	monday := friday.Add(62 * time.Hour)
+
+	start, end, open := weekends.Window(friday.Add(-time.Minute))
+	g.Expect(open).To(BeFalse())
+	g.Expect(start.IsZero()).To(BeTrue())
+	g.Expect(end.IsZero()).To(BeTrue())
+
+	start, end, open = weekends.Window(friday)
+	g.Expect(open).To(BeTrue())
+	g.Expect(start).To(Equal(friday))
+	g.Expect(end).To(Equal(monday))
+
+	_, end, open = weekends.Window(monday.Add(-time.Minute))
+	g.Expect(open).To(BeTrue())
+	g.Expect(end).To(Equal(monday))
+
+	_, _, open = weekends.Window(monday)
+	g.Expect(open).To(BeFalse())
+
+	start, _, ok := weekends.NextWindow(monday)
+	g.Expect(ok).To(BeTrue())
+	g.Expect(start).To(Equal(friday.AddDate(0, 0, 7)))
+	g.Expect(weekends.Expired(monday)).To(BeFalse())
+
+	holidays := freeze.Freeze{Start: friday, End: monday}
+
+	_, _, open = holidays.Window(friday.Add(-time.Minute))
+	g.Expect(open).To(BeFalse())
+
+	_, _, ok = holidays.NextWindow(friday.Add(-time.Minute))
+	g.Expect(ok).To(BeTrue())
+
+	_, _, open = holidays.Window(friday)
+	g.Expect(open).To(BeTrue())
+	g.Expect(holidays.Expired(friday)).To(BeFalse())
+
+	_, _, open = holidays.Window(monday)
+	g.Expect(open).To(BeFalse())
+	g.Expect(holidays.Expired(monday)).To(BeTrue())
+
+	// Without a start, the window opens straight away.
+	until := freeze.Freeze{End: monday}
+
+	_, _, open = until.Window(friday)
+	g.Expect(open).To(BeTrue())
+}
+
+func TestConfigMapStore(t *testing.T) {
+	g := NewGomegaWithT(t)
+	ctx := context.Background()
+
+	scheme := runtime.NewScheme()
+	g.Expect(clientgoscheme.AddToScheme(scheme)).To(Succeed())
+
+	c := fake.NewClientBuilder().WithScheme(scheme).Build()
+	store := freeze.NewConfigMapStore(c, "flux-system", freeze.DefaultConfigMapName)
+
+	freezes, err := store.List(ctx)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(freezes).To(BeEmpty())
+
+	friday := time.Date(2023, 12, 22, 18, 0, 0, 0, time.UTC)
+
+	weekends := freeze.Freeze{
+		Name:      "weekends",
+		Selectors: []freeze.Selector{{Kind: "Kustomization", Labels: map[string]string{"tier": "prod"}}},
+		Schedule:  "0 18 * * FRI",
+		Duration:  62 * time.Hour,
+		CreatedBy: freeze.Principal{ID: "anne", Groups: []string{"ops"}},
+	}
+
+	holidays := freeze.Freeze{
+		Name:      "holidays",
+		Objects:   []freeze.ObjectRef{podinfo},
+		Start:     friday,
+		End:       friday.AddDate(0, 0, 10),
+		Comment:   "Happy holidays",
+		CreatedBy: freeze.Principal{ID: "anne"},
+	}
+
+	g.Expect(store.Save(ctx, weekends)).To(Succeed())
+	g.Expect(store.Save(ctx, holidays)).To(Succeed())
+
+	freezes, err = store.List(ctx)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(freezes).To(Equal([]freeze.Freeze{holidays, weekends}))
+
+	holidays.ActiveUntil = holidays.End
+	g.Expect(store.Save(ctx, holidays)).To(Succeed())
+
+	f, err := store.Get(ctx, holidays.Name)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(f).To(Equal(holidays))
+
+	g.Expect(store.Delete(ctx, holidays.Name)).To(Succeed())
+	g.Expect(store.Delete(ctx, holidays.Name)).To(MatchError(freeze.ErrNotFound))
+
+	_, err = store.Get(ctx, holidays.Name)
+	g.Expect(err).To(MatchError(freeze.ErrNotFound))
+
+	freezes, err = store.List(ctx)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(freezes).To(Equal([]freeze.Freeze{weekends}))
+}
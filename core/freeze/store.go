@@ -0,0 +1,168 @@
+package freeze
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/util/retry"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// DefaultConfigMapName is the ConfigMap freezes are kept in by default.
+const DefaultConfigMapName = "weave-gitops-freezes"
+
+// ErrNotFound is returned when a freeze doesn't exist.
+var ErrNotFound = errors.New("freeze not found")
+
+// Store keeps the freezes.
+type Store interface {
+	// List returns the freezes, sorted by name.
+	List(ctx context.Context) ([]Freeze, error)
+	Get(ctx context.Context, name string) (Freeze, error)
+	// Save creates or replaces a freeze.
+	Save(ctx context.Context, f Freeze) error
+	Delete(ctx context.Context, name string) error
+}
+
+// ConfigMapStore keeps the freezes in a ConfigMap, each of them as JSON
+// under its name.
+type ConfigMapStore struct {
+	client client.Client
+	key    client.ObjectKey
+}
+
+// NewConfigMapStore returns a store keeping the freezes in the named
+// ConfigMap, which is created on the first save.
+func NewConfigMapStore(c client.Client, namespace, name string) *ConfigMapStore {
+	return &ConfigMapStore{
+		client: c,
+		key:    client.ObjectKey{Namespace: namespace, Name: name},
+	}
+}
+
+func (s *ConfigMapStore) List(ctx context.Context) ([]Freeze, error) {
+	cm, err := s.get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	freezes := []Freeze{}
+
+	for name, data := range cm.Data {
+		f := Freeze{}
+		if err := json.Unmarshal([]byte(data), &f); err != nil {
+			return nil, fmt.Errorf("decoding freeze %q: %w", name, err)
+		}
+
+		freezes = append(freezes, f)
+	}
+
+	sort.Slice(freezes, func(i, j int) bool {
+		return freezes[i].Name < freezes[j].Name
+	})
+
+	return freezes, nil
+}
+
+func (s *ConfigMapStore) Get(ctx context.Context, name string) (Freeze, error) {
+	cm, err := s.get(ctx)
+	if err != nil {
+		return Freeze{}, err
+	}
+
+	data, ok := cm.Data[name]
+	if !ok {
+		return Freeze{}, ErrNotFound
+	}
+
+	f := Freeze{}
+	if err := json.Unmarshal([]byte(data), &f); err != nil {
+		return Freeze{}, fmt.Errorf("decoding freeze %q: %w", name, err)
+	}
+
+	return f, nil
+}
+
+func (s *ConfigMapStore) Save(ctx context.Context, f Freeze) error {
+	data, err := json.Marshal(f)
+	if err != nil {
+		return fmt.Errorf("encoding freeze %q: %w", f.Name, err)
+	}
+
+	return s.update(ctx, func(cm *corev1.ConfigMap) error {
+		if cm.Data == nil {
+			cm.Data = map[string]string{}
+		}
+
+		cm.Data[f.Name] = string(data)
+
+		return nil
+	})
+}
+
+func (s *ConfigMapStore) Delete(ctx context.Context, name string) error {
+	return s.update(ctx, func(cm *corev1.ConfigMap) error {
+		if _, ok := cm.Data[name]; !ok {
+			return ErrNotFound
+		}
+
+		delete(cm.Data, name)
+
+		return nil
+	})
+}
+
+// get returns the ConfigMap, or an empty one if it doesn't exist yet.
+func (s *ConfigMapStore) get(ctx context.Context) (*corev1.ConfigMap, error) {
+	cm := &corev1.ConfigMap{}
+
+	if err := s.client.Get(ctx, s.key, cm); err != nil {
+		if apierrors.IsNotFound(err) {
+			return &corev1.ConfigMap{}, nil
+		}
+
+		return nil, fmt.Errorf("getting freezes: %w", err)
+	}
+
+	return cm, nil
+}
+
+// update applies change to the ConfigMap, creating it if needed, and retries
+// on conflicts with other writers.
+func (s *ConfigMapStore) update(ctx context.Context, change func(*corev1.ConfigMap) error) error {
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		cm := &corev1.ConfigMap{}
+
+		err := s.client.Get(ctx, s.key, cm)
+		if err != nil && !apierrors.IsNotFound(err) {
+			return fmt.Errorf("getting freezes: %w", err)
+		}
+
+		if apierrors.IsNotFound(err) {
+			cm = &corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      s.key.Name,
+					Namespace: s.key.Namespace,
+				},
+			}
+
+			if err := change(cm); err != nil {
+				return err
+			}
+
+			return s.client.Create(ctx, cm)
+		}
+
+		if err := change(cm); err != nil {
+			return err
+		}
+
+		return s.client.Update(ctx, cm)
+	})
+}
@@ -0,0 +1,151 @@
+package history
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	bolt "go.etcd.io/bbolt"
+)
+
+var eventsBucket = []byte("events")
+
+type boltStore struct {
+	db *bolt.DB
+}
+
+// NewBoltStore returns a Store persisting the events in a bbolt database at
+// path, creating it if it doesn't exist.
+func NewBoltStore(path string) (Store, error) {
+	db, err := bolt.Open(path, 0o600, &bolt.Options{Timeout: 5 * time.Second})
+	if err != nil {
+		return nil, fmt.Errorf("opening history database: %w", err)
+	}
+
+	if err := db.Update(func(tx *bolt.Tx) error {
+		_, err := tx.CreateBucketIfNotExists(eventsBucket)
+		return err
+	}); err != nil {
+		db.Close()
+		return nil, fmt.Errorf("initialising history database: %w", err)
+	}
+
+	return &boltStore{db: db}, nil
+}
+
+// Record stores the event in the bucket of its object, keyed by timestamp
+// and a sequence number, so the keys sort chronologically.
+func (s *boltStore) Record(ctx context.Context, event Event) error {
+	data, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+
+	return s.db.Update(func(tx *bolt.Tx) error {
+		objects, err := tx.Bucket(eventsBucket).CreateBucketIfNotExists([]byte(event.Object.String()))
+		if err != nil {
+			return err
+		}
+
+		seq, err := objects.NextSequence()
+		if err != nil {
+			return err
+		}
+
+		key := make([]byte, 16)
+		binary.BigEndian.PutUint64(key[:8], uint64(event.Timestamp.UnixNano()))
+		binary.BigEndian.PutUint64(key[8:], seq)
+
+		return objects.Put(key, data)
+	})
+}
+
+func (s *boltStore) List(ctx context.Context, query Query) ([]Event, error) {
+	events := []Event{}
+
+	err := s.db.View(func(tx *bolt.Tx) error {
+		objects := tx.Bucket(eventsBucket).Bucket([]byte(query.Object.String()))
+		if objects == nil {
+			return nil
+		}
+
+		c := objects.Cursor()
+
+		for k, v := c.Last(); k != nil; k, v = c.Prev() {
+			var event Event
+			if err := json.Unmarshal(v, &event); err != nil {
+				return fmt.Errorf("decoding event: %w", err)
+			}
+
+			if !query.Since.IsZero() && event.Timestamp.Before(query.Since) {
+				break
+			}
+
+			if !query.matches(event) {
+				continue
+			}
+
+			events = append(events, event)
+
+			if query.Limit > 0 && len(events) >= query.Limit {
+				break
+			}
+		}
+
+		return nil
+	})
+
+	return events, err
+}
+
+// DeleteBefore deletes the events older than before, and the timelines left
+// empty.
+func (s *boltStore) DeleteBefore(ctx context.Context, before time.Time) error {
+	end := make([]byte, 8)
+	binary.BigEndian.PutUint64(end, uint64(before.UnixNano()))
+
+	return s.db.Update(func(tx *bolt.Tx) error {
+		events := tx.Bucket(eventsBucket)
+
+		var emptied [][]byte
+
+		if err := events.ForEach(func(name, _ []byte) error {
+			objects := events.Bucket(name)
+			if objects == nil {
+				return nil
+			}
+
+			c := objects.Cursor()
+
+			// The first key is the oldest event left after each deletion.
+			for k, _ := c.First(); k != nil && bytes.Compare(k[:8], end) < 0; k, _ = c.First() {
+				if err := c.Delete(); err != nil {
+					return err
+				}
+			}
+
+			if k, _ := c.First(); k == nil {
+				emptied = append(emptied, append([]byte{}, name...))
+			}
+
+			return nil
+		}); err != nil {
+			return err
+		}
+
+		for _, name := range emptied {
+			if err := events.DeleteBucket(name); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
+func (s *boltStore) Close() error {
+	return s.db.Close()
+}
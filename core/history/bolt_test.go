@@ -0,0 +1,94 @@
+package history_test
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	"github.com/weaveworks/weave-gitops/core/history"
+)
+
+func TestBoltStore(t *testing.T) {
+	g := NewGomegaWithT(t)
+	ctx := context.Background()
+
+	path := filepath.Join(t.TempDir(), "history.db")
+
+	store, err := history.NewBoltStore(path)
+	g.Expect(err).NotTo(HaveOccurred())
+
+	podinfo := history.ObjectRef{ClusterName: "Default", Kind: "Kustomization", Namespace: "flux-system", Name: "podinfo"}
+	other := history.ObjectRef{ClusterName: "Default", Kind: "Kustomization", Namespace: "flux-system", Name: "other"}
+
+	start := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	for i, revision := range []string{"main@sha1:a", "main@sha1:b", "main@sha1:c"} {
+		g.Expect(store.Record(ctx, history.Event{
+			Object:    podinfo,
+			Type:      history.EventRevisionChanged,
+			Timestamp: start.Add(time.Duration(i) * time.Hour),
+			Revision:  revision,
+		})).To(Succeed())
+	}
+
+	g.Expect(store.Record(ctx, history.Event{
+		Object:    other,
+		Type:      history.EventSuspended,
+		Timestamp: start,
+		Principal: "alice",
+	})).To(Succeed())
+
+	events, err := store.List(ctx, history.Query{Object: podinfo})
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(revisions(events)).To(Equal([]string{"main@sha1:c", "main@sha1:b", "main@sha1:a"}))
+
+	events, err = store.List(ctx, history.Query{Object: podinfo, Since: start.Add(time.Hour), Until: start.Add(time.Hour)})
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(revisions(events)).To(Equal([]string{"main@sha1:b"}))
+
+	events, err = store.List(ctx, history.Query{Object: podinfo, Limit: 2})
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(revisions(events)).To(Equal([]string{"main@sha1:c", "main@sha1:b"}))
+
+	events, err = store.List(ctx, history.Query{Object: history.ObjectRef{Name: "missing"}})
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(events).To(BeEmpty())
+
+	// The events survive reopening the database.
+	g.Expect(store.Close()).To(Succeed())
+
+	store, err = history.NewBoltStore(path)
+	g.Expect(err).NotTo(HaveOccurred())
+
+	defer store.Close()
+
+	events, err = store.List(ctx, history.Query{Object: other})
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(events).To(HaveLen(1))
+	g.Expect(events[0].Type).To(Equal(history.EventSuspended))
+	g.Expect(events[0].Principal).To(Equal("alice"))
+	g.Expect(events[0].Timestamp.Equal(start)).To(BeTrue())
+
+	// The events older than the retention are deleted, with the timelines
+	// left empty.
+	g.Expect(store.DeleteBefore(ctx, start.Add(90*time.Minute))).To(Succeed())
+
+	events, err = store.List(ctx, history.Query{Object: podinfo})
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(revisions(events)).To(Equal([]string{"main@sha1:c"}))
+
+	events, err = store.List(ctx, history.Query{Object: other})
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(events).To(BeEmpty())
+}
+
+func revisions(events []history.Event) []string {
+	result := []string{}
+	for _, e := range events {
+		result = append(result, e.Revision)
+	}
+
+	return result
+}
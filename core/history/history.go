@@ -0,0 +1,78 @@
+// Package history records a durable timeline of the changes to Flux
+// objects, like revision transitions, Ready condition changes, suspensions
+// and sync requests.
+package history
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+const (
+	EventRevisionChanged = "RevisionChanged"
+	EventReadyChanged    = "ReadyChanged"
+	EventSuspended       = "Suspended"
+	EventResumed         = "Resumed"
+	EventSyncRequested   = "SyncRequested"
+)
+
+// ObjectRef identifies a Flux object on a cluster.
+type ObjectRef struct {
+	ClusterName string `json:"clusterName"`
+	Kind        string `json:"kind"`
+	Namespace   string `json:"namespace"`
+	Name        string `json:"name"`
+}
+
+func (r ObjectRef) String() string {
+	return fmt.Sprintf("%s/%s/%s/%s", r.ClusterName, r.Kind, r.Namespace, r.Name)
+}
+
+// Event is an entry of the timeline of an object.
+type Event struct {
+	Object    ObjectRef `json:"object"`
+	Type      string    `json:"type"`
+	Timestamp time.Time `json:"timestamp"`
+	// Revision is the revision applied, or fetched for sources, when the
+	// event happened.
+	Revision string `json:"revision,omitempty"`
+	// Status, Reason and Message come from the Ready condition.
+	Status  string `json:"status,omitempty"`
+	Reason  string `json:"reason,omitempty"`
+	Message string `json:"message,omitempty"`
+	// Principal is the user that caused the event, if known.
+	Principal string `json:"principal,omitempty"`
+}
+
+// Query selects the events of an object. Zero values are ignored.
+type Query struct {
+	Object ObjectRef
+	Since  time.Time
+	Until  time.Time
+	Limit  int
+}
+
+func (q Query) matches(e Event) bool {
+	if !q.Since.IsZero() && e.Timestamp.Before(q.Since) {
+		return false
+	}
+
+	if !q.Until.IsZero() && e.Timestamp.After(q.Until) {
+		return false
+	}
+
+	return true
+}
+
+// Store persists the timeline of objects.
+type Store interface {
+	// Record adds an event to the timeline of its object.
+	Record(ctx context.Context, event Event) error
+	// List returns the events matching the query, newest first.
+	List(ctx context.Context, query Query) ([]Event, error)
+	// DeleteBefore deletes the events older than a time.
+	DeleteBefore(ctx context.Context, before time.Time) error
+	// Close releases the resources of the store.
+	Close() error
+}
@@ -0,0 +1,77 @@
+package history
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+type memoryStore struct {
+	mu     sync.RWMutex
+	events map[ObjectRef][]Event
+}
+
+// NewMemoryStore returns a Store keeping the events in memory, they're lost
+// when the server restarts.
+func NewMemoryStore() Store {
+	return &memoryStore{events: map[ObjectRef][]Event{}}
+}
+
+func (s *memoryStore) Record(ctx context.Context, event Event) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.events[event.Object] = append(s.events[event.Object], event)
+
+	return nil
+}
+
+func (s *memoryStore) List(ctx context.Context, query Query) ([]Event, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	events := []Event{}
+
+	recorded := s.events[query.Object]
+	for i := len(recorded) - 1; i >= 0; i-- {
+		if !query.matches(recorded[i]) {
+			continue
+		}
+
+		events = append(events, recorded[i])
+
+		if query.Limit > 0 && len(events) >= query.Limit {
+			break
+		}
+	}
+
+	return events, nil
+}
+
+func (s *memoryStore) DeleteBefore(ctx context.Context, before time.Time) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for ref, recorded := range s.events {
+		kept := []Event{}
+
+		for _, e := range recorded {
+			if !e.Timestamp.Before(before) {
+				kept = append(kept, e)
+			}
+		}
+
+		if len(kept) == 0 {
+			delete(s.events, ref)
+			continue
+		}
+
+		s.events[ref] = kept
+	}
+
+	return nil
+}
+
+func (s *memoryStore) Close() error {
+	return nil
+}
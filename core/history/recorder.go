@@ -0,0 +1,336 @@
+package history
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	helmv2 "github.com/fluxcd/helm-controller/api/v2beta2"
+	kustomizev1 "github.com/fluxcd/kustomize-controller/api/v1"
+	sourcev1 "github.com/fluxcd/source-controller/api/v1"
+	sourcev1b2 "github.com/fluxcd/source-controller/api/v1beta2"
+	"github.com/go-logr/logr"
+	"github.com/weaveworks/weave-gitops/core/clustersmngr"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const pollFrequency = 30 * time.Second
+
+// DefaultKinds are the kinds of objects the recorder follows.
+var DefaultKinds = []schema.GroupVersionKind{
+	kustomizev1.GroupVersion.WithKind(kustomizev1.KustomizationKind),
+	helmv2.GroupVersion.WithKind(helmv2.HelmReleaseKind),
+	sourcev1.GroupVersion.WithKind(sourcev1.GitRepositoryKind),
+	sourcev1b2.GroupVersion.WithKind(sourcev1b2.OCIRepositoryKind),
+	sourcev1b2.GroupVersion.WithKind(sourcev1b2.BucketKind),
+	sourcev1b2.GroupVersion.WithKind(sourcev1b2.HelmRepositoryKind),
+	sourcev1b2.GroupVersion.WithKind(sourcev1b2.HelmChartKind),
+}
+
+// objectState is the last state of an object seen by the recorder.
+type objectState struct {
+	revision  string
+	ready     string
+	suspended bool
+}
+
+// Recorder keeps the timelines of Flux objects up to date. It polls the
+// objects of all the clusters with the server's permissions, and records
+// revision, readiness and suspension changes. Changes made through the API
+// are recorded as they happen, with the user that made them.
+type Recorder struct {
+	store           Store
+	clustersManager clustersmngr.ClustersManager
+	kinds           []schema.GroupVersionKind
+	retention       time.Duration
+	log             logr.Logger
+
+	mu   sync.Mutex
+	last map[ObjectRef]objectState
+	// primed is set once the objects found when starting have been seen.
+	primed bool
+
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+// NewRecorder returns a Recorder keeping the events for the retention,
+// forever if it's zero.
+func NewRecorder(log logr.Logger, store Store, clustersManager clustersmngr.ClustersManager, kinds []schema.GroupVersionKind, retention time.Duration) *Recorder {
+	return &Recorder{
+		store:           store,
+		clustersManager: clustersManager,
+		kinds:           kinds,
+		retention:       retention,
+		log:             log.WithName("history-recorder"),
+		last:            map[ObjectRef]objectState{},
+	}
+}
+
+// Start polls the objects in the background until the context is cancelled
+// or the recorder is stopped.
+func (r *Recorder) Start(ctx context.Context) {
+	ctx, r.cancel = context.WithCancel(ctx)
+	r.done = make(chan struct{})
+
+	go func() {
+		defer close(r.done)
+
+		//nolint:staticcheck // deprecated, tracking issue: https://github.com/weaveworks/weave-gitops/issues/3812
+		if err := wait.PollImmediateInfiniteWithContext(ctx, pollFrequency, func(ctx context.Context) (bool, error) {
+			if err := r.Poll(ctx); err != nil {
+				r.log.Error(err, "failed polling objects")
+			}
+
+			return false, nil
+		}); err != nil && !errors.Is(err, context.Canceled) {
+			r.log.Error(err, "failed polling objects")
+		}
+	}()
+}
+
+// Stop stops polling and waits for the poll in progress, so the store can be
+// closed.
+func (r *Recorder) Stop() {
+	if r.cancel == nil {
+		return
+	}
+
+	r.cancel()
+	<-r.done
+}
+
+// Poll lists the objects of all clusters and records the changes since the
+// last poll, then deletes the events older than the retention.
+func (r *Recorder) Poll(ctx context.Context) error {
+	c, err := r.clustersManager.GetServerClient(ctx)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now().UTC()
+
+	for _, gvk := range r.kinds {
+		clist := clustersmngr.NewClusteredList(func() client.ObjectList {
+			list := &unstructured.UnstructuredList{}
+			list.SetGroupVersionKind(gvk)
+			return list
+		})
+
+		if err := c.ClusteredList(ctx, clist, false); err != nil {
+			// A failing cluster shouldn't stop the others from being
+			// recorded.
+			r.logListError(err, gvk)
+		}
+
+		for clusterName, lists := range clist.Lists() {
+			for _, l := range lists {
+				list, ok := l.(*unstructured.UnstructuredList)
+				if !ok {
+					continue
+				}
+
+				for i := range list.Items {
+					r.observe(ctx, clusterName, &list.Items[i], now)
+				}
+			}
+		}
+	}
+
+	r.mu.Lock()
+	r.primed = true
+	r.mu.Unlock()
+
+	if r.retention > 0 {
+		return r.store.DeleteBefore(ctx, now.Add(-r.retention))
+	}
+
+	return nil
+}
+
+// logListError logs the errors of listing the objects of a kind, but for
+// the clusters without the kind, like those without the helm-controller.
+func (r *Recorder) logListError(err error, gvk schema.GroupVersionKind) {
+	var errs clustersmngr.ClusteredListError
+	if !errors.As(err, &errs) {
+		r.log.Error(err, "failed listing objects", "kind", gvk.Kind)
+		return
+	}
+
+	for _, e := range errs.Errors {
+		if !meta.IsNoMatchError(e.Err) {
+			r.log.Error(e.Err, "failed listing objects", "cluster", e.Cluster, "kind", gvk.Kind)
+		}
+	}
+}
+
+// Record adds an event made through the API, like a suspension, to the
+// timeline of its object.
+func (r *Recorder) Record(ctx context.Context, event Event) error {
+	if event.Timestamp.IsZero() {
+		event.Timestamp = time.Now().UTC()
+	}
+
+	if event.Type == EventSuspended || event.Type == EventResumed {
+		r.mu.Lock()
+		if state, ok := r.last[event.Object]; ok {
+			state.suspended = event.Type == EventSuspended
+			r.last[event.Object] = state
+		}
+		r.mu.Unlock()
+	}
+
+	return r.store.Record(ctx, event)
+}
+
+// List returns the timeline of an object, newest first.
+func (r *Recorder) List(ctx context.Context, query Query) ([]Event, error) {
+	return r.store.List(ctx, query)
+}
+
+func (r *Recorder) observe(ctx context.Context, clusterName string, obj *unstructured.Unstructured, now time.Time) {
+	ref := ObjectRef{
+		ClusterName: clusterName,
+		Kind:        obj.GetKind(),
+		Namespace:   obj.GetNamespace(),
+		Name:        obj.GetName(),
+	}
+
+	current := stateOf(obj)
+	status, reason, message := readyCondition(obj)
+
+	r.mu.Lock()
+	previous, seen := r.last[ref]
+	if !seen {
+		var recorded bool
+
+		previous, recorded = r.lastRecordedState(ctx, ref)
+
+		// The objects found when starting without a timeline haven't
+		// changed, their state is where their timeline starts from.
+		if !recorded && !r.primed {
+			r.last[ref] = current
+			r.mu.Unlock()
+
+			return
+		}
+	}
+
+	// Transient Unknown statuses happen during every reconciliation, only
+	// the outcomes are recorded.
+	if current.ready == "" {
+		current.ready = previous.ready
+	}
+
+	r.last[ref] = current
+	r.mu.Unlock()
+
+	var events []Event
+
+	if current.revision != "" && current.revision != previous.revision {
+		events = append(events, Event{Type: EventRevisionChanged})
+	}
+
+	if current.ready != "" && current.ready != previous.ready {
+		events = append(events, Event{Type: EventReadyChanged})
+	}
+
+	if seen && current.suspended != previous.suspended {
+		if current.suspended {
+			events = append(events, Event{Type: EventSuspended})
+		} else {
+			events = append(events, Event{Type: EventResumed})
+		}
+	}
+
+	for _, e := range events {
+		e.Object = ref
+		e.Timestamp = now
+		e.Revision = current.revision
+		e.Status = status
+		e.Reason = reason
+		e.Message = message
+
+		if err := r.store.Record(ctx, e); err != nil {
+			r.log.Error(err, "failed recording event", "object", ref.String(), "type", e.Type)
+		}
+	}
+}
+
+// lastRecordedState rebuilds the state of an object from its timeline, so
+// restarting the server doesn't record the same changes again. It returns
+// false if nothing was recorded for the object.
+func (r *Recorder) lastRecordedState(ctx context.Context, ref ObjectRef) (objectState, bool) {
+	state := objectState{}
+
+	events, err := r.store.List(ctx, Query{Object: ref})
+	if err != nil {
+		r.log.Error(err, "failed listing events", "object", ref.String())
+		return state, false
+	}
+
+	// Events recorded through the API don't carry a revision.
+	for _, e := range events {
+		if e.Revision != "" {
+			state.revision = e.Revision
+			break
+		}
+	}
+
+	for _, e := range events {
+		if e.Type == EventReadyChanged {
+			state.ready = e.Status
+			break
+		}
+	}
+
+	for _, e := range events {
+		if e.Type == EventSuspended || e.Type == EventResumed {
+			state.suspended = e.Type == EventSuspended
+			break
+		}
+	}
+
+	return state, len(events) > 0
+}
+
+func stateOf(obj *unstructured.Unstructured) objectState {
+	state := objectState{}
+
+	state.revision, _, _ = unstructured.NestedString(obj.Object, "status", "lastAppliedRevision")
+	if state.revision == "" {
+		state.revision, _, _ = unstructured.NestedString(obj.Object, "status", "artifact", "revision")
+	}
+
+	state.suspended, _, _ = unstructured.NestedBool(obj.Object, "spec", "suspend")
+
+	if status, _, _ := readyCondition(obj); status == "True" || status == "False" {
+		state.ready = status
+	}
+
+	return state
+}
+
+func readyCondition(obj *unstructured.Unstructured) (status, reason, message string) {
+	conditions, _, _ := unstructured.NestedSlice(obj.Object, "status", "conditions")
+
+	for _, c := range conditions {
+		cond, ok := c.(map[string]interface{})
+		if !ok || cond["type"] != "Ready" {
+			continue
+		}
+
+		status, _ = cond["status"].(string)
+		reason, _ = cond["reason"].(string)
+		message, _ = cond["message"].(string)
+
+		return status, reason, message
+	}
+
+	return "", "", ""
+}
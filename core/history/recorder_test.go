@@ -0,0 +1,204 @@
+package history_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	kustomizev1 "github.com/fluxcd/kustomize-controller/api/v1"
+	"github.com/go-logr/logr"
+	. "github.com/onsi/gomega"
+	"github.com/weaveworks/weave-gitops/core/clustersmngr"
+	"github.com/weaveworks/weave-gitops/core/clustersmngr/cluster/clusterfakes"
+	"github.com/weaveworks/weave-gitops/core/clustersmngr/clustersmngrfakes"
+	"github.com/weaveworks/weave-gitops/core/history"
+	"github.com/weaveworks/weave-gitops/pkg/kube"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestRecorderPoll(t *testing.T) {
+	g := NewGomegaWithT(t)
+	ctx := context.Background()
+
+	scheme, err := kube.CreateScheme()
+	g.Expect(err).NotTo(HaveOccurred())
+
+	ks := &kustomizev1.Kustomization{
+		ObjectMeta: metav1.ObjectMeta{Name: "podinfo", Namespace: "flux-system"},
+		Status: kustomizev1.KustomizationStatus{
+			LastAppliedRevision: "main@sha1:a",
+			Conditions: []metav1.Condition{
+				{Type: "Ready", Status: metav1.ConditionTrue, Reason: "ReconciliationSucceeded", Message: "Applied revision: main@sha1:a"},
+			},
+		},
+	}
+
+	k8s := fake.NewClientBuilder().WithScheme(scheme).WithObjects(ks).WithStatusSubresource(ks).Build()
+
+	c := &clusterfakes.FakeCluster{}
+	c.GetNameReturns("Default")
+
+	pool := clustersmngr.NewClustersClientsPool()
+	g.Expect(pool.Add(k8s, c)).To(Succeed())
+
+	clustersManager := &clustersmngrfakes.FakeClustersManager{}
+	clustersManager.GetServerClientReturns(clustersmngr.NewClient(pool, nil, logr.Discard()), nil)
+
+	store := history.NewMemoryStore()
+	recorder := history.NewRecorder(logr.Discard(), store, clustersManager, []schema.GroupVersionKind{
+		kustomizev1.GroupVersion.WithKind(kustomizev1.KustomizationKind),
+	}, 0)
+
+	ref := history.ObjectRef{ClusterName: "Default", Kind: "Kustomization", Namespace: "flux-system", Name: "podinfo"}
+
+	// The state of the objects found when starting is where their timeline
+	// starts from.
+	g.Expect(recorder.Poll(ctx)).To(Succeed())
+
+	events, err := recorder.List(ctx, history.Query{Object: ref})
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(events).To(BeEmpty())
+
+	// The objects created since are recorded as they're first seen.
+	created := ks.DeepCopy()
+	created.ObjectMeta = metav1.ObjectMeta{Name: "podinfo-2", Namespace: "flux-system"}
+	g.Expect(k8s.Create(ctx, created)).To(Succeed())
+	created.Status = ks.Status
+	g.Expect(k8s.Status().Update(ctx, created)).To(Succeed())
+
+	// Nothing changed, nothing is recorded.
+	g.Expect(recorder.Poll(ctx)).To(Succeed())
+
+	events, err = recorder.List(ctx, history.Query{Object: ref})
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(events).To(BeEmpty())
+
+	createdRef := ref
+	createdRef.Name = created.Name
+
+	events, err = recorder.List(ctx, history.Query{Object: createdRef})
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(eventTypes(events)).To(Equal([]string{history.EventReadyChanged, history.EventRevisionChanged}))
+
+	// A suspension made through the API isn't recorded again by the poller.
+	g.Expect(recorder.Record(ctx, history.Event{Object: ref, Type: history.EventSuspended, Principal: "alice"})).To(Succeed())
+
+	g.Expect(k8s.Get(ctx, client.ObjectKeyFromObject(ks), ks)).To(Succeed())
+	ks.Spec.Suspend = true
+	g.Expect(k8s.Update(ctx, ks)).To(Succeed())
+
+	// An in-progress reconciliation isn't recorded, only its outcome.
+	ks.Status.LastAppliedRevision = "main@sha1:b"
+	ks.Status.Conditions = []metav1.Condition{
+		{Type: "Ready", Status: metav1.ConditionUnknown, Reason: "Progressing"},
+	}
+	g.Expect(k8s.Status().Update(ctx, ks)).To(Succeed())
+
+	g.Expect(recorder.Poll(ctx)).To(Succeed())
+
+	events, err = recorder.List(ctx, history.Query{Object: ref})
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(eventTypes(events)).To(Equal([]string{
+		history.EventRevisionChanged,
+		history.EventSuspended,
+	}))
+	g.Expect(events[0].Revision).To(Equal("main@sha1:b"))
+	g.Expect(events[1].Principal).To(Equal("alice"))
+
+	// Resuming outside of the API is picked up by the poller.
+	ks.Spec.Suspend = false
+	g.Expect(k8s.Update(ctx, ks)).To(Succeed())
+
+	ks.Status.Conditions = []metav1.Condition{
+		{Type: "Ready", Status: metav1.ConditionFalse, Reason: "BuildFailed", Message: "kustomize build failed"},
+	}
+	g.Expect(k8s.Status().Update(ctx, ks)).To(Succeed())
+
+	g.Expect(recorder.Poll(ctx)).To(Succeed())
+
+	events, err = recorder.List(ctx, history.Query{Object: ref, Limit: 2})
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(eventTypes(events)).To(ConsistOf(history.EventReadyChanged, history.EventResumed))
+
+	for _, e := range events {
+		if e.Type == history.EventReadyChanged {
+			g.Expect(e.Status).To(Equal("False"))
+			g.Expect(e.Reason).To(Equal("BuildFailed"))
+		}
+	}
+
+	// A new recorder, like after a restart, carries on from the stored
+	// timeline instead of recording the same changes again.
+	recorder = history.NewRecorder(logr.Discard(), store, clustersManager, []schema.GroupVersionKind{
+		kustomizev1.GroupVersion.WithKind(kustomizev1.KustomizationKind),
+	}, 0)
+
+	g.Expect(recorder.Poll(ctx)).To(Succeed())
+
+	all, err := recorder.List(ctx, history.Query{Object: ref})
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(all).To(HaveLen(4))
+
+	// The events older than the retention are deleted when polling.
+	g.Expect(recorder.Record(ctx, history.Event{
+		Object:    ref,
+		Type:      history.EventSyncRequested,
+		Timestamp: time.Now().UTC().Add(-48 * time.Hour),
+	})).To(Succeed())
+
+	recorder = history.NewRecorder(logr.Discard(), store, clustersManager, []schema.GroupVersionKind{
+		kustomizev1.GroupVersion.WithKind(kustomizev1.KustomizationKind),
+	}, 24*time.Hour)
+
+	g.Expect(recorder.Poll(ctx)).To(Succeed())
+
+	all, err = recorder.List(ctx, history.Query{Object: ref})
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(all).To(HaveLen(4))
+}
+
+func TestRecorderStop(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	polling := make(chan struct{})
+	release := make(chan struct{})
+
+	clustersManager := &clustersmngrfakes.FakeClustersManager{}
+	clustersManager.GetServerClientStub = func(ctx context.Context) (clustersmngr.Client, error) {
+		close(polling)
+		<-release
+
+		return clustersmngr.NewClient(clustersmngr.NewClustersClientsPool(), nil, logr.Discard()), nil
+	}
+
+	recorder := history.NewRecorder(logr.Discard(), history.NewMemoryStore(), clustersManager, history.DefaultKinds, 0)
+	recorder.Start(context.Background())
+
+	<-polling
+
+	stopped := make(chan struct{})
+
+	go func() {
+		recorder.Stop()
+		close(stopped)
+	}()
+
+	g.Consistently(stopped, 100*time.Millisecond).ShouldNot(BeClosed(), "the poll in progress is waited for")
+
+	close(release)
+
+	g.Eventually(stopped, time.Second).Should(BeClosed())
+	g.Expect(clustersManager.GetServerClientCallCount()).To(Equal(1))
+}
+
+func eventTypes(events []history.Event) []string {
+	result := []string{}
+	for _, e := range events {
+		result = append(result, e.Type)
+	}
+
+	return result
+}
@@ -158,6 +158,9 @@ func allAPIGroups(rules []rbacv1.PolicyRule) []string {
 //
 // Secrets don't exist in "apps" according to k8s,
 // but the "index checker" that checks this struct does not mind.
+//
+// Rules limited to some resourceNames don't give access to the whole
+// namespace, so they're left out.
 func hasAllRules(status authorizationv1.SubjectRulesReviewStatus, rules []rbacv1.PolicyRule) bool {
 	derivedAccess := map[string]map[string]map[string]bool{}
 
@@ -165,6 +168,10 @@ func hasAllRules(status authorizationv1.SubjectRulesReviewStatus, rules []rbacv1
 	allAPIGroupsInRules := allAPIGroups(rules)
 
 	for _, statusRule := range status.ResourceRules {
+		if len(statusRule.ResourceNames) > 0 {
+			continue
+		}
+
 		apiGroups := statusRule.APIGroups
 		if containsWildcard(apiGroups) {
 			apiGroups = allAPIGroupsInRules
@@ -0,0 +1,56 @@
+package nsaccess
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/cheshir/ttlcache"
+	authorizationv1 "k8s.io/api/authorization/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	typedauth "k8s.io/client-go/kubernetes/typed/authorization/v1"
+)
+
+// RulesReviewer checks what users can do in namespaces with
+// SelfSubjectRulesReviews, caching the reviews so that checking the access to
+// the objects of every request doesn't hit the API server as hard as reading
+// the objects would.
+type RulesReviewer struct {
+	cache *ttlcache.Cache
+	ttl   time.Duration
+}
+
+// NewRulesReviewer returns a RulesReviewer caching the reviews for ttl.
+func NewRulesReviewer(ttl time.Duration) *RulesReviewer {
+	return &RulesReviewer{
+		cache: ttlcache.New(ttl),
+		ttl:   ttl,
+	}
+}
+
+// Can returns whether the user the auth client impersonates can do everything
+// the rule allows in the namespace. The reviews are cached under cacheKey,
+// which needs to identify both the user and the cluster.
+func (r *RulesReviewer) Can(ctx context.Context, auth typedauth.AuthorizationV1Interface, cacheKey, namespace string, rule rbacv1.PolicyRule) (bool, error) {
+	key := ttlcache.StringKey(fmt.Sprintf("%s:%s", cacheKey, namespace))
+
+	if val, found := r.cache.Get(key); found {
+		return hasAllRules(val.(authorizationv1.SubjectRulesReviewStatus), []rbacv1.PolicyRule{rule}), nil
+	}
+
+	sar := &authorizationv1.SelfSubjectRulesReview{
+		Spec: authorizationv1.SelfSubjectRulesReviewSpec{
+			Namespace: namespace,
+		},
+	}
+
+	authRes, err := auth.SelfSubjectRulesReviews().Create(ctx, sar, metav1.CreateOptions{})
+	if err != nil {
+		return false, fmt.Errorf("reviewing the rules of namespace %s: %w", namespace, err)
+	}
+
+	r.cache.Set(key, authRes.Status, r.ttl)
+
+	return hasAllRules(authRes.Status, []rbacv1.PolicyRule{rule}), nil
+}
@@ -0,0 +1,83 @@
+package nsaccess
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	authorizationv1 "k8s.io/api/authorization/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+)
+
+func TestRulesReviewer(t *testing.T) {
+	g := NewGomegaWithT(t)
+	ctx := context.Background()
+
+	reviews := 0
+
+	clientset := fake.NewSimpleClientset()
+	clientset.PrependReactor("create", "selfsubjectrulesreviews", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		reviews++
+
+		review := action.(k8stesting.CreateAction).GetObject().(*authorizationv1.SelfSubjectRulesReview)
+
+		switch review.Spec.Namespace {
+		case "team-a":
+			review.Status.ResourceRules = []authorizationv1.ResourceRule{{
+				APIGroups: []string{"kustomize.toolkit.fluxcd.io"},
+				Resources: []string{"kustomizations"},
+				Verbs:     []string{"get", "list", "watch"},
+			}}
+		case "team-c":
+			// only allowed to read one of the kustomizations
+			review.Status.ResourceRules = []authorizationv1.ResourceRule{{
+				APIGroups:     []string{"kustomize.toolkit.fluxcd.io"},
+				Resources:     []string{"kustomizations"},
+				Verbs:         []string{"get", "list", "watch"},
+				ResourceNames: []string{"apps"},
+			}}
+		}
+
+		return true, review, nil
+	})
+
+	listKustomizations := rbacv1.PolicyRule{
+		APIGroups: []string{"kustomize.toolkit.fluxcd.io"},
+		Resources: []string{"kustomizations"},
+		Verbs:     []string{"list"},
+	}
+	listHelmReleases := rbacv1.PolicyRule{
+		APIGroups: []string{"helm.toolkit.fluxcd.io"},
+		Resources: []string{"helmreleases"},
+		Verbs:     []string{"list"},
+	}
+
+	reviewer := NewRulesReviewer(time.Minute)
+
+	ok, err := reviewer.Can(ctx, clientset.AuthorizationV1(), "cluster/user", "team-a", listKustomizations)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(ok).To(BeTrue())
+
+	ok, err = reviewer.Can(ctx, clientset.AuthorizationV1(), "cluster/user", "team-a", listHelmReleases)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(ok).To(BeFalse())
+
+	g.Expect(reviews).To(Equal(1), "the review of the namespace is cached")
+
+	ok, err = reviewer.Can(ctx, clientset.AuthorizationV1(), "cluster/user", "team-b", listKustomizations)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(ok).To(BeFalse())
+
+	_, err = reviewer.Can(ctx, clientset.AuthorizationV1(), "cluster/other-user", "team-a", listKustomizations)
+	g.Expect(err).NotTo(HaveOccurred())
+
+	g.Expect(reviews).To(Equal(3), "the reviews are cached per namespace and user")
+
+	ok, err = reviewer.Can(ctx, clientset.AuthorizationV1(), "cluster/user", "team-c", listKustomizations)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(ok).To(BeFalse(), "rules limited to resourceNames don't give access to the namespace")
+}
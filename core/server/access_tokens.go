@@ -0,0 +1,162 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/weaveworks/weave-gitops/core/audit"
+	pb "github.com/weaveworks/weave-gitops/pkg/api/core"
+	"github.com/weaveworks/weave-gitops/pkg/server/auth"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	defaultAccessTokenLifetime = 30 * 24 * time.Hour
+	maxAccessTokenLifetime     = 365 * 24 * time.Hour
+)
+
+func (cs *coreServer) CreateAccessToken(ctx context.Context, msg *pb.CreateAccessTokenRequest) (*pb.CreateAccessTokenResponse, error) {
+	res, err := cs.createAccessToken(ctx, msg)
+
+	ref := accessTokenRef(msg.Name)
+	if res != nil {
+		ref = accessTokenRef(res.AccessToken.Id)
+	}
+
+	cs.recordAudit(ctx, audit.ActionCreateAccessToken, []*pb.ObjectRef{ref}, err)
+
+	return res, err
+}
+
+func (cs *coreServer) createAccessToken(ctx context.Context, msg *pb.CreateAccessTokenRequest) (*pb.CreateAccessTokenResponse, error) {
+	if cs.accessTokens == nil {
+		return nil, status.Error(codes.Unavailable, "access tokens are not enabled")
+	}
+
+	principal := auth.Principal(ctx)
+
+	// a token could otherwise outlive the one it was created with, or lift
+	// its restrictions
+	if principal.AccessTokenID != "" {
+		return nil, status.Error(codes.PermissionDenied, "access tokens can't be created with an access token")
+	}
+
+	if msg.Name == "" {
+		return nil, status.Error(codes.InvalidArgument, "name is required")
+	}
+
+	lifetime := defaultAccessTokenLifetime
+
+	if msg.ExpiresIn != "" {
+		var err error
+
+		lifetime, err = time.ParseDuration(msg.ExpiresIn)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid expiresIn: %v", err)
+		}
+
+		if lifetime <= 0 || lifetime > maxAccessTokenLifetime {
+			return nil, status.Errorf(codes.InvalidArgument, "expiresIn must be positive and at most %s", maxAccessTokenLifetime)
+		}
+	}
+
+	for _, name := range msg.Clusters {
+		if !cs.clusterExists(name) {
+			return nil, status.Errorf(codes.InvalidArgument, "cluster %q not found", name)
+		}
+	}
+
+	t, token, err := auth.NewAccessToken(principal, msg.Name, time.Now().Add(lifetime), msg.ReadOnly, msg.Clusters)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "%v", err)
+	}
+
+	if err := cs.accessTokens.Save(ctx, t); err != nil {
+		return nil, status.Errorf(codes.Internal, "saving access token: %v", err)
+	}
+
+	return &pb.CreateAccessTokenResponse{
+		AccessToken: accessTokenToProto(t),
+		Token:       token,
+	}, nil
+}
+
+func (cs *coreServer) ListAccessTokens(ctx context.Context, msg *pb.ListAccessTokensRequest) (*pb.ListAccessTokensResponse, error) {
+	if cs.accessTokens == nil {
+		return nil, status.Error(codes.Unavailable, "access tokens are not enabled")
+	}
+
+	tokens, err := cs.accessTokens.List(ctx, auth.Principal(ctx).Identity())
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "listing access tokens: %v", err)
+	}
+
+	result := []*pb.AccessToken{}
+	for _, t := range tokens {
+		result = append(result, accessTokenToProto(t))
+	}
+
+	return &pb.ListAccessTokensResponse{AccessTokens: result}, nil
+}
+
+func (cs *coreServer) DeleteAccessToken(ctx context.Context, msg *pb.DeleteAccessTokenRequest) (*pb.DeleteAccessTokenResponse, error) {
+	res, err := cs.deleteAccessToken(ctx, msg)
+
+	cs.recordAudit(ctx, audit.ActionDeleteAccessToken, []*pb.ObjectRef{accessTokenRef(msg.Id)}, err)
+
+	return res, err
+}
+
+func (cs *coreServer) deleteAccessToken(ctx context.Context, msg *pb.DeleteAccessTokenRequest) (*pb.DeleteAccessTokenResponse, error) {
+	if cs.accessTokens == nil {
+		return nil, status.Error(codes.Unavailable, "access tokens are not enabled")
+	}
+
+	t, err := cs.accessTokens.Get(ctx, msg.Id)
+	if err != nil {
+		if errors.Is(err, auth.ErrAccessTokenNotFound) {
+			return nil, status.Errorf(codes.NotFound, "access token %q not found", msg.Id)
+		}
+
+		return nil, status.Errorf(codes.Internal, "getting access token: %v", err)
+	}
+
+	// the tokens of other users are reported missing, so their IDs can't be
+	// probed
+	if t.Principal().Identity() != auth.Principal(ctx).Identity() {
+		return nil, status.Errorf(codes.NotFound, "access token %q not found", msg.Id)
+	}
+
+	if err := cs.accessTokens.Delete(ctx, msg.Id); err != nil && !errors.Is(err, auth.ErrAccessTokenNotFound) {
+		return nil, status.Errorf(codes.Internal, "deleting access token: %v", err)
+	}
+
+	return &pb.DeleteAccessTokenResponse{}, nil
+}
+
+func (cs *coreServer) clusterExists(name string) bool {
+	for _, cl := range cs.clustersManager.GetClusters() {
+		if cl.GetName() == name {
+			return true
+		}
+	}
+
+	return false
+}
+
+func accessTokenToProto(t auth.AccessToken) *pb.AccessToken {
+	return &pb.AccessToken{
+		Id:        t.ID,
+		Name:      t.Name,
+		CreatedAt: t.CreatedAt.UTC().Format(time.RFC3339),
+		ExpiresAt: t.ExpiresAt.UTC().Format(time.RFC3339),
+		ReadOnly:  t.ReadOnly,
+		Clusters:  t.Clusters,
+	}
+}
+
+func accessTokenRef(name string) *pb.ObjectRef {
+	return &pb.ObjectRef{Kind: "AccessToken", Name: name}
+}
@@ -0,0 +1,261 @@
+package server_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	kustomizev1 "github.com/fluxcd/kustomize-controller/api/v1"
+	"github.com/go-logr/logr"
+	. "github.com/onsi/gomega"
+	"github.com/weaveworks/weave-gitops/core/audit"
+	pb "github.com/weaveworks/weave-gitops/pkg/api/core"
+	"github.com/weaveworks/weave-gitops/pkg/kube"
+	"github.com/weaveworks/weave-gitops/pkg/server/auth"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestAccessTokens(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	ctx := context.Background()
+
+	scheme, err := kube.CreateScheme()
+	g.Expect(err).To(BeNil())
+
+	k := fake.NewClientBuilder().WithScheme(scheme).Build()
+
+	recorder := audit.NewRecorder(logr.Discard(), audit.DefaultRetained)
+
+	cfg := makeServerConfig(k, t, "")
+	cfg.AccessTokens = auth.NewSecretAccessTokenStore(k, "flux-system", auth.DefaultAccessTokensSecretName)
+	cfg.AuditRecorder = recorder
+
+	c := makeServer(cfg, t)
+
+	anneCtx := metadata.NewOutgoingContext(ctx, metadata.Pairs(MetadataUserKey, "anne", MetadataGroupsKey, "developers"))
+	bobCtx := metadata.NewOutgoingContext(ctx, metadata.Pairs(MetadataUserKey, "bob"))
+
+	created, err := c.CreateAccessToken(anneCtx, &pb.CreateAccessTokenRequest{
+		Name:      "ci",
+		ExpiresIn: "24h",
+		ReadOnly:  true,
+		Clusters:  []string{"Default"},
+	})
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(created.Token).To(HavePrefix(auth.AccessTokenPrefix + created.AccessToken.Id + "_"))
+	g.Expect(created.AccessToken.Name).To(Equal("ci"))
+	g.Expect(created.AccessToken.ReadOnly).To(BeTrue())
+	g.Expect(created.AccessToken.Clusters).To(Equal([]string{"Default"}))
+
+	expiresAt, err := time.Parse(time.RFC3339, created.AccessToken.ExpiresAt)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(expiresAt).To(BeTemporally("~", time.Now().Add(24*time.Hour), time.Minute))
+
+	t.Run("stores the token for the user and their groups", func(t *testing.T) {
+		g := NewGomegaWithT(t)
+
+		stored, err := cfg.AccessTokens.Get(ctx, created.AccessToken.Id)
+		g.Expect(err).NotTo(HaveOccurred())
+		g.Expect(stored.UserID).To(Equal("anne"))
+		g.Expect(stored.Groups).To(Equal([]string{"developers"}))
+		g.Expect(stored.Hash).NotTo(BeEmpty())
+		g.Expect(created.Token).NotTo(ContainSubstring(stored.Hash))
+	})
+
+	t.Run("validates the request", func(t *testing.T) {
+		g := NewGomegaWithT(t)
+
+		for _, req := range []*pb.CreateAccessTokenRequest{
+			{},
+			{Name: "forever", ExpiresIn: "10000h"},
+			{Name: "negative", ExpiresIn: "-1h"},
+			{Name: "elsewhere", Clusters: []string{"prod"}},
+		} {
+			_, err := c.CreateAccessToken(anneCtx, req)
+			g.Expect(status.Code(err)).To(Equal(codes.InvalidArgument), req.Name)
+		}
+	})
+
+	t.Run("lists the tokens of the user", func(t *testing.T) {
+		g := NewGomegaWithT(t)
+
+		res, err := c.ListAccessTokens(anneCtx, &pb.ListAccessTokensRequest{})
+		g.Expect(err).NotTo(HaveOccurred())
+		g.Expect(res.AccessTokens).To(HaveLen(1))
+		g.Expect(res.AccessTokens[0].Id).To(Equal(created.AccessToken.Id))
+
+		res, err = c.ListAccessTokens(bobCtx, &pb.ListAccessTokensRequest{})
+		g.Expect(err).NotTo(HaveOccurred())
+		g.Expect(res.AccessTokens).To(BeEmpty())
+	})
+
+	t.Run("tells apart the users of different providers", func(t *testing.T) {
+		g := NewGomegaWithT(t)
+
+		gitlabAnneCtx := metadata.NewOutgoingContext(ctx, metadata.Pairs(MetadataUserKey, "anne", MetadataPrefixKey, "gitlab:"))
+
+		res, err := c.ListAccessTokens(gitlabAnneCtx, &pb.ListAccessTokensRequest{})
+		g.Expect(err).NotTo(HaveOccurred())
+		g.Expect(res.AccessTokens).To(BeEmpty())
+
+		_, err = c.DeleteAccessToken(gitlabAnneCtx, &pb.DeleteAccessTokenRequest{Id: created.AccessToken.Id})
+		g.Expect(status.Code(err)).To(Equal(codes.NotFound))
+
+		gitlabToken, err := c.CreateAccessToken(gitlabAnneCtx, &pb.CreateAccessTokenRequest{Name: "gitlab"})
+		g.Expect(err).NotTo(HaveOccurred())
+
+		// The token is impersonated with the prefixes of its provider.
+		stored, err := cfg.AccessTokens.Get(ctx, gitlabToken.AccessToken.Id)
+		g.Expect(err).NotTo(HaveOccurred())
+		g.Expect(stored.Principal().Prefixes).To(Equal(&auth.ImpersonationPrefixes{Username: "gitlab:", Groups: "gitlab:"}))
+
+		res, err = c.ListAccessTokens(anneCtx, &pb.ListAccessTokensRequest{})
+		g.Expect(err).NotTo(HaveOccurred())
+		g.Expect(res.AccessTokens).To(HaveLen(1))
+		g.Expect(res.AccessTokens[0].Id).To(Equal(created.AccessToken.Id))
+
+		_, err = c.DeleteAccessToken(gitlabAnneCtx, &pb.DeleteAccessTokenRequest{Id: gitlabToken.AccessToken.Id})
+		g.Expect(err).NotTo(HaveOccurred())
+	})
+
+	t.Run("tokens can't create tokens", func(t *testing.T) {
+		g := NewGomegaWithT(t)
+
+		tokenCtx := metadata.NewOutgoingContext(ctx, metadata.Pairs(MetadataUserKey, "anne", MetadataAccessTokenKey, created.AccessToken.Id))
+
+		_, err := c.CreateAccessToken(tokenCtx, &pb.CreateAccessTokenRequest{Name: "more"})
+		g.Expect(status.Code(err)).To(Equal(codes.PermissionDenied))
+	})
+
+	t.Run("users can't delete the tokens of others", func(t *testing.T) {
+		g := NewGomegaWithT(t)
+
+		_, err := c.DeleteAccessToken(bobCtx, &pb.DeleteAccessTokenRequest{Id: created.AccessToken.Id})
+		g.Expect(status.Code(err)).To(Equal(codes.NotFound))
+	})
+
+	t.Run("deletes the token", func(t *testing.T) {
+		g := NewGomegaWithT(t)
+
+		_, err := c.DeleteAccessToken(anneCtx, &pb.DeleteAccessTokenRequest{Id: created.AccessToken.Id})
+		g.Expect(err).NotTo(HaveOccurred())
+
+		_, err = c.DeleteAccessToken(anneCtx, &pb.DeleteAccessTokenRequest{Id: created.AccessToken.Id})
+		g.Expect(status.Code(err)).To(Equal(codes.NotFound))
+
+		res, err := c.ListAccessTokens(anneCtx, &pb.ListAccessTokensRequest{})
+		g.Expect(err).NotTo(HaveOccurred())
+		g.Expect(res.AccessTokens).To(BeEmpty())
+	})
+
+	t.Run("audits creating and deleting the tokens", func(t *testing.T) {
+		g := NewGomegaWithT(t)
+
+		created := recorder.List(audit.Query{Action: audit.ActionCreateAccessToken, Principal: "anne"})
+		g.Expect(created).NotTo(BeEmpty())
+		g.Expect(created[len(created)-1].Outcome).To(Equal(audit.OutcomeSuccess))
+
+		deleted := recorder.List(audit.Query{Action: audit.ActionDeleteAccessToken, Principal: "anne"})
+		g.Expect(deleted).To(HaveLen(2))
+		g.Expect(deleted[0].Outcome).To(Equal(audit.OutcomeFailure))
+		g.Expect(deleted[1].Outcome).To(Equal(audit.OutcomeSuccess))
+	})
+}
+
+func TestAccessTokensDisabled(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	k := fake.NewClientBuilder().Build()
+	c := makeServer(makeServerConfig(k, t, ""), t)
+
+	ctx := metadata.NewOutgoingContext(context.Background(), metadata.Pairs(MetadataUserKey, "anne"))
+
+	_, err := c.ListAccessTokens(ctx, &pb.ListAccessTokensRequest{})
+	g.Expect(status.Code(err)).To(Equal(codes.Unavailable))
+}
+
+func TestReadOnlyAccessTokens(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	ctx := context.Background()
+
+	scheme, err := kube.CreateScheme()
+	g.Expect(err).To(BeNil())
+
+	ns := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "flux-system"}}
+	apps := newKustomization("apps", ns.Name, false, metav1.ConditionTrue)
+
+	k := fake.NewClientBuilder().WithScheme(scheme).WithObjects(ns, apps).Build()
+
+	c := makeServer(makeServerConfig(k, t, ""), t)
+
+	tokenCtx := metadata.NewOutgoingContext(ctx, metadata.Pairs(
+		MetadataUserKey, "anne",
+		MetadataGroupsKey, "system:masters",
+		MetadataAccessTokenKey, "abc",
+	))
+
+	ref := &pb.ObjectRef{Kind: kustomizev1.KustomizationKind, Name: apps.Name, Namespace: ns.Name, ClusterName: "Default"}
+
+	res, err := c.ToggleSuspendResource(tokenCtx, &pb.ToggleSuspendResourceRequest{Objects: []*pb.ObjectRef{ref}, Suspend: true})
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(res.Results).To(HaveLen(1))
+	g.Expect(res.Results[0].Status).To(Equal("Forbidden"))
+
+	got := &kustomizev1.Kustomization{}
+	g.Expect(k.Get(ctx, client.ObjectKeyFromObject(apps), got)).To(Succeed())
+	g.Expect(got.Spec.Suspend).To(BeFalse())
+
+	obj, err := c.GetObject(tokenCtx, &pb.GetObjectRequest{Name: apps.Name, Namespace: ns.Name, Kind: kustomizev1.KustomizationKind, ClusterName: "Default"})
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(obj.Object.Payload).NotTo(BeEmpty())
+
+	// Watches are reads too.
+	watchCtx, cancel := context.WithTimeout(tokenCtx, 10*time.Second)
+	defer cancel()
+
+	stream, err := c.WatchObjects(watchCtx, &pb.WatchObjectsRequest{Kind: kustomizev1.KustomizationKind})
+	g.Expect(err).NotTo(HaveOccurred())
+
+	events := make(chan *pb.WatchObjectsResponse)
+
+	go func() {
+		defer close(events)
+
+		for {
+			res, err := stream.Recv()
+			if err != nil {
+				return
+			}
+
+			events <- res
+		}
+	}()
+
+	// The watch is opened asynchronously, keep poking the object until the
+	// first event comes through.
+	var event *pb.WatchObjectsResponse
+
+	g.Eventually(func() *pb.WatchObjectsResponse {
+		g.Expect(k.Get(ctx, client.ObjectKeyFromObject(apps), got)).To(Succeed())
+		got.Spec.Path = time.Now().String()
+		g.Expect(k.Update(ctx, got)).To(Succeed())
+
+		select {
+		case event = <-events:
+		case <-time.After(100 * time.Millisecond):
+		}
+
+		return event
+	}, 5*time.Second).ShouldNot(BeNil())
+
+	g.Expect(event.Error).To(BeNil())
+	g.Expect(event.Object.Payload).To(ContainSubstring(apps.Name))
+}
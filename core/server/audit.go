@@ -0,0 +1,161 @@
+package server
+
+import (
+	"context"
+	"time"
+
+	"github.com/weaveworks/weave-gitops/core/audit"
+	pb "github.com/weaveworks/weave-gitops/pkg/api/core"
+	"github.com/weaveworks/weave-gitops/pkg/server/auth"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (cs *coreServer) ListAuditEvents(ctx context.Context, msg *pb.ListAuditEventsRequest) (*pb.ListAuditEventsResponse, error) {
+	if cs.audit == nil {
+		return nil, status.Error(codes.Unavailable, "audit log is not enabled")
+	}
+
+	principal := auth.Principal(ctx)
+	if principal == nil || !cs.auditAdmins.Allows(principal.Identity(), principal.IdentityGroups()) {
+		return nil, status.Error(codes.PermissionDenied, "only admins can list audit events")
+	}
+
+	query := audit.Query{
+		Principal:   msg.Principal,
+		Action:      msg.Action,
+		ClusterName: msg.ClusterName,
+		Limit:       int(msg.Limit),
+	}
+
+	var err error
+
+	if query.Since, err = parseHistoryTime(msg.Since); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid since: %v", err)
+	}
+
+	if query.Until, err = parseHistoryTime(msg.Until); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid until: %v", err)
+	}
+
+	var events []audit.Event
+
+	if cs.auditEvents != nil {
+		if events, err = cs.auditEvents.List(ctx, query); err != nil {
+			return nil, status.Errorf(codes.Internal, "listing audit events: %v", err)
+		}
+	} else {
+		events = cs.audit.List(query)
+	}
+
+	result := []*pb.AuditEvent{}
+	for _, e := range events {
+		result = append(result, &pb.AuditEvent{
+			Id:          e.ID,
+			Timestamp:   e.Timestamp.Format(time.RFC3339),
+			Principal:   e.Principal,
+			Groups:      e.Groups,
+			Action:      e.Action,
+			ClusterName: e.ClusterName,
+			Objects:     auditProtoObjectRefs(e.Objects),
+			Outcome:     e.Outcome,
+			Message:     e.Message,
+			SourceIP:    e.SourceIP,
+		})
+	}
+
+	return &pb.ListAuditEventsResponse{Events: result}, nil
+}
+
+// recordAudit records an action of the user on objects. The outcome is a
+// failure if err isn't nil.
+func (cs *coreServer) recordAudit(ctx context.Context, action string, objects []*pb.ObjectRef, err error) {
+	outcome, message := audit.OutcomeSuccess, ""
+	if err != nil {
+		outcome, message = audit.OutcomeFailure, err.Error()
+	}
+
+	cs.recordAuditOutcome(ctx, action, objects, outcome, message)
+}
+
+// recordAuditResults records an action of the user on each object, with the
+// results of the action.
+func (cs *coreServer) recordAuditResults(ctx context.Context, action string, results []*pb.ObjectResult) {
+	for _, r := range results {
+		outcome := audit.OutcomeSuccess
+		if r.Status != resultSuccess {
+			outcome = audit.OutcomeFailure
+		}
+
+		cs.recordAuditOutcome(ctx, action, []*pb.ObjectRef{r.Object}, outcome, r.Message)
+	}
+}
+
+func (cs *coreServer) recordAuditOutcome(ctx context.Context, action string, objects []*pb.ObjectRef, outcome, message string) {
+	if cs.audit == nil {
+		return
+	}
+
+	event := audit.Event{
+		Action:   action,
+		Objects:  auditObjectRefs(objects),
+		Outcome:  outcome,
+		Message:  message,
+		SourceIP: audit.SourceIP(ctx),
+	}
+
+	if principal := auth.Principal(ctx); principal != nil {
+		event.Principal = principal.Identity()
+		event.Groups = principal.IdentityGroups()
+	}
+
+	for i, ref := range event.Objects {
+		if i == 0 {
+			event.ClusterName = ref.ClusterName
+		} else if ref.ClusterName != event.ClusterName {
+			event.ClusterName = ""
+			break
+		}
+	}
+
+	cs.audit.Record(ctx, event)
+}
+
+// syncAuditOutcome tells whether a sync was requested. Objects failing to
+// become ready were still synced.
+func syncAuditOutcome(result *pb.SyncResult) string {
+	switch result.Status {
+	case resultForbidden, resultNotFound, resultConflict, resultError:
+		return audit.OutcomeFailure
+	default:
+		return audit.OutcomeSuccess
+	}
+}
+
+func auditObjectRefs(refs []*pb.ObjectRef) []audit.ObjectRef {
+	result := []audit.ObjectRef{}
+	for _, ref := range refs {
+		result = append(result, audit.ObjectRef{
+			ClusterName: ref.ClusterName,
+			Kind:        ref.Kind,
+			Namespace:   ref.Namespace,
+			Name:        ref.Name,
+		})
+	}
+
+	return result
+}
+
+func auditProtoObjectRefs(refs []audit.ObjectRef) []*pb.ObjectRef {
+	result := []*pb.ObjectRef{}
+	for _, ref := range refs {
+		result = append(result, &pb.ObjectRef{
+			ClusterName: ref.ClusterName,
+			Kind:        ref.Kind,
+			Namespace:   ref.Namespace,
+			Name:        ref.Name,
+		})
+	}
+
+	return result
+}
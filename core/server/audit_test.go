@@ -0,0 +1,174 @@
+package server_test
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+
+	kustomizev1 "github.com/fluxcd/kustomize-controller/api/v1"
+	"github.com/go-logr/logr"
+	. "github.com/onsi/gomega"
+	"github.com/weaveworks/weave-gitops/core/audit"
+	pb "github.com/weaveworks/weave-gitops/pkg/api/core"
+	"github.com/weaveworks/weave-gitops/pkg/kube"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestAuditEvents(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	ctx := context.Background()
+
+	scheme, err := kube.CreateScheme()
+	g.Expect(err).To(BeNil())
+
+	ns := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "flux-system"}}
+	apps := newKustomization("apps", ns.Name, false, metav1.ConditionTrue)
+
+	k := fake.NewClientBuilder().WithScheme(scheme).WithObjects(ns, apps).Build()
+
+	recorder := audit.NewRecorder(logr.Discard(), audit.DefaultRetained)
+
+	cfg := makeServerConfig(k, t, "")
+	cfg.AuditRecorder = recorder
+	cfg.AuditAdmins = audit.Admins{Groups: []string{"auditors"}}
+
+	c := makeServer(cfg, t)
+
+	anneCtx := metadata.NewOutgoingContext(ctx, metadata.Pairs(
+		MetadataUserKey, "anne",
+		MetadataGroupsKey, "developers",
+		"x-forwarded-for", "10.0.0.1",
+	))
+	adminCtx := metadata.NewOutgoingContext(ctx, metadata.Pairs(MetadataUserKey, "bob", MetadataGroupsKey, "auditors"))
+
+	ref := func(name string) *pb.ObjectRef {
+		return &pb.ObjectRef{Kind: kustomizev1.KustomizationKind, Name: name, Namespace: ns.Name, ClusterName: "Default"}
+	}
+
+	_, err = c.ToggleSuspendResource(anneCtx, &pb.ToggleSuspendResourceRequest{
+		Objects: []*pb.ObjectRef{ref(apps.Name), ref("missing")},
+		Suspend: true,
+	})
+	g.Expect(err).NotTo(HaveOccurred())
+
+	_, err = c.ToggleSuspendResource(anneCtx, &pb.ToggleSuspendResourceRequest{
+		Objects: []*pb.ObjectRef{ref(apps.Name)},
+		Until:   "not a time",
+	})
+	g.Expect(err).To(HaveOccurred())
+
+	t.Run("records each object suspended, and the calls failing", func(t *testing.T) {
+		g := NewGomegaWithT(t)
+
+		res, err := c.ListAuditEvents(adminCtx, &pb.ListAuditEventsRequest{})
+		g.Expect(err).NotTo(HaveOccurred())
+		g.Expect(res.Events).To(HaveLen(3))
+
+		invalid := res.Events[0]
+		g.Expect(invalid.Action).To(Equal(audit.ActionResume))
+		g.Expect(invalid.Outcome).To(Equal(audit.OutcomeFailure))
+		g.Expect(invalid.Message).To(ContainSubstring("invalid until"))
+
+		missing := res.Events[1]
+		g.Expect(missing.Action).To(Equal(audit.ActionSuspend))
+		g.Expect(missing.Outcome).To(Equal(audit.OutcomeFailure))
+		g.Expect(missing.Objects[0].Name).To(Equal("missing"))
+
+		suspended := res.Events[2]
+		g.Expect(suspended.Action).To(Equal(audit.ActionSuspend))
+		g.Expect(suspended.Outcome).To(Equal(audit.OutcomeSuccess))
+		g.Expect(suspended.Principal).To(Equal("anne"))
+		g.Expect(suspended.Groups).To(Equal([]string{"developers"}))
+		g.Expect(suspended.ClusterName).To(Equal("Default"))
+		g.Expect(suspended.Objects).To(HaveLen(1))
+		g.Expect(suspended.Objects[0].Name).To(Equal(apps.Name))
+		g.Expect(suspended.SourceIP).To(Equal("10.0.0.1"))
+	})
+
+	t.Run("filters the events", func(t *testing.T) {
+		g := NewGomegaWithT(t)
+
+		res, err := c.ListAuditEvents(adminCtx, &pb.ListAuditEventsRequest{Action: audit.ActionSuspend, Limit: 1})
+		g.Expect(err).NotTo(HaveOccurred())
+		g.Expect(res.Events).To(HaveLen(1))
+		g.Expect(res.Events[0].Objects[0].Name).To(Equal("missing"))
+
+		res, err = c.ListAuditEvents(adminCtx, &pb.ListAuditEventsRequest{Principal: "bob"})
+		g.Expect(err).NotTo(HaveOccurred())
+		g.Expect(res.Events).To(BeEmpty())
+	})
+
+	t.Run("only admins can list the events", func(t *testing.T) {
+		g := NewGomegaWithT(t)
+
+		_, err := c.ListAuditEvents(anneCtx, &pb.ListAuditEventsRequest{})
+		g.Expect(status.Code(err)).To(Equal(codes.PermissionDenied))
+
+		gitlabAuditorCtx := metadata.NewOutgoingContext(ctx, metadata.Pairs(MetadataUserKey, "bob", MetadataGroupsKey, "auditors", MetadataPrefixKey, "gitlab:"))
+
+		_, err = c.ListAuditEvents(gitlabAuditorCtx, &pb.ListAuditEventsRequest{})
+		g.Expect(status.Code(err)).To(Equal(codes.PermissionDenied), "the auditors group is the one of the default provider")
+	})
+}
+
+func TestAuditEventsFromStore(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	ctx := context.Background()
+
+	scheme, err := kube.CreateScheme()
+	g.Expect(err).To(BeNil())
+
+	ns := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "flux-system"}}
+	apps := newKustomization("apps", ns.Name, false, metav1.ConditionTrue)
+
+	k := fake.NewClientBuilder().WithScheme(scheme).WithObjects(ns, apps).Build()
+
+	store, err := audit.NewBoltSink(filepath.Join(t.TempDir(), "audit.db"), 0)
+	g.Expect(err).NotTo(HaveOccurred())
+
+	defer store.Close()
+
+	// The events of a previous run of the server.
+	g.Expect(store.Write(ctx, audit.Event{ID: "before", Timestamp: time.Now().Add(-time.Hour), Principal: "anne", Action: audit.ActionLogin})).To(Succeed())
+
+	// Nothing is kept in memory.
+	recorder := audit.NewRecorder(logr.Discard(), 0, store)
+	defer recorder.Close()
+
+	cfg := makeServerConfig(k, t, "")
+	cfg.AuditRecorder = recorder
+	cfg.AuditEvents = store
+	cfg.AuditAdmins = audit.Admins{Groups: []string{"auditors"}}
+
+	c := makeServer(cfg, t)
+
+	anneCtx := metadata.NewOutgoingContext(ctx, metadata.Pairs(MetadataUserKey, "anne"))
+	adminCtx := metadata.NewOutgoingContext(ctx, metadata.Pairs(MetadataUserKey, "bob", MetadataGroupsKey, "auditors"))
+
+	_, err = c.ToggleSuspendResource(anneCtx, &pb.ToggleSuspendResourceRequest{
+		Objects: []*pb.ObjectRef{{Kind: kustomizev1.KustomizationKind, Name: apps.Name, Namespace: ns.Name, ClusterName: "Default"}},
+		Suspend: true,
+	})
+	g.Expect(err).NotTo(HaveOccurred())
+
+	// The events are written to the store in the background.
+	g.Eventually(func() []string {
+		res, err := c.ListAuditEvents(adminCtx, &pb.ListAuditEventsRequest{Principal: "anne"})
+		g.Expect(err).NotTo(HaveOccurred())
+
+		actions := []string{}
+		for _, e := range res.Events {
+			actions = append(actions, e.Action)
+		}
+
+		return actions
+	}, 5*time.Second).Should(Equal([]string{audit.ActionSuspend, audit.ActionLogin}))
+}
@@ -0,0 +1,195 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/weaveworks/weave-gitops/core/clustersmngr"
+	pb "github.com/weaveworks/weave-gitops/pkg/api/core"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	v1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// The results of an operation on one of many objects.
+const (
+	resultSuccess   = "Success"
+	resultForbidden = "Forbidden"
+	resultNotFound  = "NotFound"
+	resultConflict  = "Conflict"
+	resultError     = "Error"
+)
+
+// resultStatus classifies the error of an operation on an object.
+func resultStatus(err error) string {
+	var clusterNotFound clustersmngr.ClusterNotFoundError
+
+	switch {
+	case err == nil:
+		return resultSuccess
+	case apierrors.IsForbidden(err):
+		return resultForbidden
+	case apierrors.IsNotFound(err), errors.As(err, &clusterNotFound):
+		return resultNotFound
+	case apierrors.IsConflict(err):
+		return resultConflict
+	default:
+		return resultError
+	}
+}
+
+func objectResult(ref *pb.ObjectRef, err error) *pb.ObjectResult {
+	result := &pb.ObjectResult{
+		Object: ref,
+		Status: resultStatus(err),
+	}
+
+	if err != nil {
+		result.Message = err.Error()
+	}
+
+	return result
+}
+
+// selectObjects returns the objects referenced, followed by the objects
+// matched by the selectors, without duplicates. The clusters the selectors
+// couldn't be listed from are returned as failed results.
+func (cs *coreServer) selectObjects(ctx context.Context, c clustersmngr.Client, refs []*pb.ObjectRef, selectors []*pb.ObjectSelector) ([]*pb.ObjectRef, []*pb.ObjectResult, error) {
+	var (
+		objects = []*pb.ObjectRef{}
+		failed  = []*pb.ObjectResult{}
+		seen    = map[string]bool{}
+	)
+
+	add := func(ref *pb.ObjectRef) {
+		key := fmt.Sprintf("%s/%s/%s/%s", ref.ClusterName, ref.Kind, ref.Namespace, ref.Name)
+		if seen[key] {
+			return
+		}
+
+		seen[key] = true
+		objects = append(objects, ref)
+	}
+
+	for _, ref := range refs {
+		add(ref)
+	}
+
+	for _, selector := range selectors {
+		gvk, err := cs.primaryKinds.Lookup(selector.Kind)
+		if err != nil {
+			return nil, nil, status.Errorf(codes.InvalidArgument, "selector: %v", err)
+		}
+
+		clist := clustersmngr.NewClusteredList(func() client.ObjectList {
+			list := &unstructured.UnstructuredList{}
+			list.SetGroupVersionKind(*gvk)
+			return list
+		})
+
+		listErrs, err := listSelected(ctx, c, clist, selector)
+		if err != nil {
+			return nil, nil, fmt.Errorf("listing %s: %w", selector.Kind, err)
+		}
+
+		for _, e := range listErrs {
+			if !selects(selector, e.Cluster, e.Namespace) {
+				continue
+			}
+
+			failed = append(failed, objectResult(&pb.ObjectRef{Kind: selector.Kind, Namespace: e.Namespace, ClusterName: e.Cluster}, e.Err))
+		}
+
+		for clusterName, lists := range clist.Lists() {
+			for _, l := range lists {
+				list, ok := l.(*unstructured.UnstructuredList)
+				if !ok {
+					continue
+				}
+
+				for _, obj := range list.Items {
+					if !selects(selector, clusterName, obj.GetNamespace()) {
+						continue
+					}
+
+					add(&pb.ObjectRef{
+						Kind:        selector.Kind,
+						Name:        obj.GetName(),
+						Namespace:   obj.GetNamespace(),
+						ClusterName: clusterName,
+					})
+				}
+			}
+		}
+	}
+
+	return objects, failed, nil
+}
+
+// listSelected lists the objects matched by the selector into clist. The
+// selectors limited to a cluster or a namespace only list from those, the
+// others list from all the namespaces of all the clusters.
+func listSelected(ctx context.Context, c clustersmngr.Client, clist clustersmngr.ClusteredObjectList, selector *pb.ObjectSelector) ([]clustersmngr.ListError, error) {
+	opts := []client.ListOption{}
+	if len(selector.Labels) > 0 {
+		opts = append(opts, client.MatchingLabels(selector.Labels))
+	}
+
+	if selector.ClusterName == "" && selector.Namespace == "" {
+		if err := c.ClusteredList(ctx, clist, true, opts...); err != nil {
+			var errs clustersmngr.ClusteredListError
+			if !errors.As(err, &errs) {
+				return nil, err
+			}
+
+			return errs.Errors, nil
+		}
+
+		return nil, nil
+	}
+
+	clusterNames := []string{selector.ClusterName}
+	if selector.ClusterName == "" {
+		clusterNames = []string{}
+		for clusterName := range c.ClientsPool().Clients() {
+			clusterNames = append(clusterNames, clusterName)
+		}
+	}
+
+	listErrs := []clustersmngr.ListError{}
+
+	for _, clusterName := range clusterNames {
+		namespaces := []v1.Namespace{{ObjectMeta: metav1.ObjectMeta{Name: selector.Namespace}}}
+		if selector.Namespace == "" {
+			namespaces = c.Namespaces()[clusterName]
+		}
+
+		for _, ns := range namespaces {
+			list := clist.NewList()
+
+			if err := c.List(ctx, clusterName, list, append(opts, client.InNamespace(ns.Name))...); err != nil {
+				listErrs = append(listErrs, clustersmngr.ListError{Cluster: clusterName, Namespace: ns.Name, Err: err})
+				continue
+			}
+
+			clist.AddObjectList(clusterName, namespaces, list)
+		}
+	}
+
+	return listErrs, nil
+}
+
+// selects returns true if the selector matches the cluster and namespace, an
+// empty namespace matches any selector namespace.
+func selects(selector *pb.ObjectSelector, clusterName, namespace string) bool {
+	if selector.ClusterName != "" && selector.ClusterName != clusterName {
+		return false
+	}
+
+	return selector.Namespace == "" || namespace == "" || selector.Namespace == namespace
+}
@@ -0,0 +1,207 @@
+package server_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	kustomizev1 "github.com/fluxcd/kustomize-controller/api/v1"
+	. "github.com/onsi/gomega"
+	pb "github.com/weaveworks/weave-gitops/pkg/api/core"
+	"github.com/weaveworks/weave-gitops/pkg/kube"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+)
+
+func TestToggleSuspendResource_Bulk(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	ctx := context.Background()
+
+	scheme, err := kube.CreateScheme()
+	g.Expect(err).To(BeNil())
+
+	ns := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "flux-system"}}
+	otherNS := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "other"}}
+
+	tenant := map[string]string{"tenant": "a"}
+
+	apps := newKustomization("apps", ns.Name, false, metav1.ConditionTrue)
+	apps.Labels = tenant
+
+	infra := newKustomization("infra", otherNS.Name, false, metav1.ConditionTrue)
+	infra.Labels = tenant
+
+	locked := newKustomization("locked", otherNS.Name, false, metav1.ConditionTrue)
+	locked.Labels = tenant
+
+	busy := newKustomization("busy", ns.Name, false, metav1.ConditionTrue)
+
+	unlabelled := newKustomization("unlabelled", ns.Name, false, metav1.ConditionTrue)
+
+	gr := schema.GroupResource{Group: kustomizev1.GroupVersion.Group, Resource: "kustomizations"}
+
+	var (
+		listedMu sync.Mutex
+		listed   []string
+	)
+
+	k := fake.NewClientBuilder().WithScheme(scheme).
+		WithObjects(ns, otherNS, apps, infra, locked, busy, unlabelled).
+		WithInterceptorFuncs(interceptor.Funcs{
+			List: func(ctx context.Context, c client.WithWatch, list client.ObjectList, opts ...client.ListOption) error {
+				if _, ok := list.(*unstructured.UnstructuredList); ok {
+					listOpts := &client.ListOptions{}
+					listOpts.ApplyOptions(opts)
+
+					listedMu.Lock()
+					listed = append(listed, listOpts.Namespace)
+					listedMu.Unlock()
+				}
+
+				return c.List(ctx, list, opts...)
+			},
+			Patch: func(ctx context.Context, c client.WithWatch, obj client.Object, patch client.Patch, opts ...client.PatchOption) error {
+				switch obj.GetName() {
+				case locked.Name:
+					return apierrors.NewForbidden(gr, obj.GetName(), nil)
+				case busy.Name:
+					return apierrors.NewConflict(gr, obj.GetName(), nil)
+				}
+
+				return c.Patch(ctx, obj, patch, opts...)
+			},
+		}).
+		Build()
+
+	c := makeServer(makeServerConfig(k, t, ""), t)
+
+	md := metadata.Pairs(MetadataUserKey, "anne", MetadataGroupsKey, "system:masters")
+	outgoingCtx := metadata.NewOutgoingContext(ctx, md)
+
+	ref := func(name, namespace string) *pb.ObjectRef {
+		return &pb.ObjectRef{Kind: kustomizev1.KustomizationKind, Name: name, Namespace: namespace, ClusterName: "Default"}
+	}
+
+	res, err := c.ToggleSuspendResource(outgoingCtx, &pb.ToggleSuspendResourceRequest{
+		Objects: []*pb.ObjectRef{
+			ref(apps.Name, apps.Namespace),
+			ref(busy.Name, busy.Namespace),
+			ref("missing", ns.Name),
+		},
+		Selectors: []*pb.ObjectSelector{{
+			Kind:        kustomizev1.KustomizationKind,
+			ClusterName: "Default",
+			Labels:      tenant,
+		}},
+		Suspend: true,
+	})
+	g.Expect(err).NotTo(HaveOccurred())
+
+	results := map[string]string{}
+	for _, r := range res.Results {
+		results[r.Object.Namespace+"/"+r.Object.Name] = r.Status
+	}
+
+	// apps is both referenced and selected, it's only suspended once.
+	g.Expect(res.Results).To(HaveLen(5))
+	g.Expect(results).To(Equal(map[string]string{
+		"flux-system/apps":    "Success",
+		"flux-system/busy":    "Conflict",
+		"flux-system/missing": "NotFound",
+		"other/infra":         "Success",
+		"other/locked":        "Forbidden",
+	}))
+
+	for _, obj := range []*kustomizev1.Kustomization{apps, infra, unlabelled} {
+		g.Expect(k.Get(ctx, client.ObjectKeyFromObject(obj), obj)).To(Succeed())
+	}
+
+	g.Expect(apps.Spec.Suspend).To(BeTrue())
+	g.Expect(infra.Spec.Suspend).To(BeTrue())
+	g.Expect(unlabelled.Spec.Suspend).To(BeFalse())
+
+	// Selecting a namespace leaves the others alone, they aren't even listed.
+	listedMu.Lock()
+	listed = nil
+	listedMu.Unlock()
+
+	res, err = c.ToggleSuspendResource(outgoingCtx, &pb.ToggleSuspendResourceRequest{
+		Selectors: []*pb.ObjectSelector{{
+			Kind:      kustomizev1.KustomizationKind,
+			Namespace: otherNS.Name,
+			Labels:    tenant,
+		}},
+		Suspend: false,
+	})
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(res.Results).To(HaveLen(2))
+	g.Expect(listed).To(Equal([]string{otherNS.Name}))
+
+	g.Expect(k.Get(ctx, client.ObjectKeyFromObject(infra), infra)).To(Succeed())
+	g.Expect(infra.Spec.Suspend).To(BeFalse())
+	g.Expect(k.Get(ctx, client.ObjectKeyFromObject(apps), apps)).To(Succeed())
+	g.Expect(apps.Spec.Suspend).To(BeTrue())
+
+	_, err = c.ToggleSuspendResource(outgoingCtx, &pb.ToggleSuspendResourceRequest{
+		Selectors: []*pb.ObjectSelector{{Kind: "NotAKind"}},
+	})
+	g.Expect(status.Code(err)).To(Equal(codes.InvalidArgument))
+}
+
+func TestSyncFluxObject_Bulk(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	ctx := context.Background()
+
+	scheme, err := kube.CreateScheme()
+	g.Expect(err).To(BeNil())
+
+	ns := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "flux-system"}}
+
+	apps := newKustomization("apps", ns.Name, false, metav1.ConditionTrue)
+	apps.Labels = map[string]string{"tenant": "a"}
+
+	k := fake.NewClientBuilder().WithScheme(scheme).WithObjects(ns, apps).Build()
+
+	c := makeServer(makeServerConfig(k, t, ""), t)
+
+	md := metadata.Pairs(MetadataUserKey, "anne", MetadataGroupsKey, "system:masters")
+	outgoingCtx := metadata.NewOutgoingContext(ctx, md)
+
+	res, err := c.SyncFluxObject(outgoingCtx, &pb.SyncFluxObjectRequest{
+		Objects: []*pb.ObjectRef{{
+			Kind:        kustomizev1.KustomizationKind,
+			Name:        "missing",
+			Namespace:   ns.Name,
+			ClusterName: "Default",
+		}},
+		Selectors: []*pb.ObjectSelector{{
+			Kind:   kustomizev1.KustomizationKind,
+			Labels: apps.Labels,
+		}},
+		Wait:    true,
+		Timeout: "10ms",
+	})
+	g.Expect(err).NotTo(HaveOccurred())
+
+	results := map[string]string{}
+	for _, r := range res.Results {
+		results[r.Object.Name] = r.Status
+	}
+
+	// Nothing handles the request for apps, so it times out.
+	g.Expect(results).To(Equal(map[string]string{
+		"missing": "NotFound",
+		"apps":    "TimedOut",
+	}))
+}
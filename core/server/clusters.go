@@ -0,0 +1,52 @@
+package server
+
+import (
+	"context"
+	"time"
+
+	"github.com/weaveworks/weave-gitops/core/clustersmngr"
+	pb "github.com/weaveworks/weave-gitops/pkg/api/core"
+	"github.com/weaveworks/weave-gitops/pkg/server/auth"
+)
+
+func (cs *coreServer) ListClusters(ctx context.Context, msg *pb.ListClustersRequest) (*pb.ListClustersResponse, error) {
+	clusters := []*pb.ClusterStatus{}
+	principal := auth.Principal(ctx)
+
+	for _, h := range cs.clustersManager.GetClustersHealth() {
+		if principal != nil && !principal.AllowsCluster(h.Name) {
+			continue
+		}
+
+		clusters = append(clusters, clusterHealthToProto(h))
+	}
+
+	return &pb.ListClustersResponse{Clusters: clusters}, nil
+}
+
+func clusterHealthToProto(h clustersmngr.ClusterHealth) *pb.ClusterStatus {
+	status := &pb.ClusterStatus{
+		Name:              h.Name,
+		Host:              h.Host,
+		Status:            string(h.Status),
+		Message:           h.Message,
+		KubernetesVersion: h.KubernetesVersion,
+		FluxInstalled:     h.FluxInstalled,
+		FluxNamespace:     h.FluxNamespace,
+		FluxVersion:       h.FluxVersion,
+	}
+
+	if h.Latency != 0 {
+		status.Latency = h.Latency.Round(time.Microsecond).String()
+	}
+
+	if !h.LastProbed.IsZero() {
+		status.LastProbed = h.LastProbed.UTC().Format(time.RFC3339)
+	}
+
+	if !h.LastSeen.IsZero() {
+		status.LastSeen = h.LastSeen.UTC().Format(time.RFC3339)
+	}
+
+	return status
+}
@@ -0,0 +1,75 @@
+package server_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/gomega"
+	"github.com/weaveworks/weave-gitops/core/clustersmngr"
+	"github.com/weaveworks/weave-gitops/core/clustersmngr/cluster/clusterfakes"
+	"github.com/weaveworks/weave-gitops/core/clustersmngr/fetcher"
+	pb "github.com/weaveworks/weave-gitops/pkg/api/core"
+	"github.com/weaveworks/weave-gitops/pkg/kube"
+	"google.golang.org/grpc/metadata"
+	"k8s.io/apimachinery/pkg/version"
+	fakediscovery "k8s.io/client-go/discovery/fake"
+	"k8s.io/client-go/kubernetes/fake"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestListClusters(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	ctx := context.Background()
+
+	scheme, err := kube.CreateScheme()
+	g.Expect(err).To(BeNil())
+
+	k := fakeclient.NewClientBuilder().WithScheme(scheme).Build()
+
+	clientset := fake.NewSimpleClientset()
+	clientset.Discovery().(*fakediscovery.FakeDiscovery).FakedServerVersion = &version.Info{GitVersion: "v1.28.3"}
+
+	cl := &clusterfakes.FakeCluster{}
+	cl.GetNameReturns("Default")
+	cl.GetHostReturns("https://kubernetes.default.svc")
+	cl.GetServerClientsetReturns(clientset, nil)
+	cl.GetUserClientsetReturns(clientset, nil)
+	cl.GetServerClientReturns(k, nil)
+	cl.GetUserClientReturns(k, nil)
+
+	cfg := makeServerConfig(k, t, "")
+	cfg.ClustersManager = clustersmngr.NewClustersManager([]clustersmngr.ClusterFetcher{fetcher.NewSingleClusterFetcher(cl)}, &nsChecker, logr.Discard())
+	g.Expect(cfg.ClustersManager.UpdateClusters(ctx)).To(Succeed())
+
+	c := makeServer(cfg, t)
+
+	md := metadata.Pairs(MetadataUserKey, "anne", MetadataGroupsKey, "system:masters")
+	outgoingCtx := metadata.NewOutgoingContext(ctx, md)
+
+	res, err := c.ListClusters(outgoingCtx, &pb.ListClustersRequest{})
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(res.Clusters).To(HaveLen(1))
+	g.Expect(res.Clusters[0].Status).To(Equal(string(clustersmngr.ClusterStatusUnknown)))
+	g.Expect(res.Clusters[0].LastSeen).To(BeEmpty())
+
+	cfg.ClustersManager.UpdateClustersHealth(ctx)
+
+	res, err = c.ListClusters(outgoingCtx, &pb.ListClustersRequest{})
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(res.Clusters).To(HaveLen(1))
+
+	status := res.Clusters[0]
+	g.Expect(status.Name).To(Equal("Default"))
+	g.Expect(status.Host).To(Equal("https://kubernetes.default.svc"))
+	g.Expect(status.Status).To(Equal(string(clustersmngr.ClusterStatusHealthy)))
+	g.Expect(status.KubernetesVersion).To(Equal("v1.28.3"))
+	g.Expect(status.FluxInstalled).To(BeFalse())
+	g.Expect(status.Latency).NotTo(BeEmpty())
+
+	lastSeen, err := time.Parse(time.RFC3339, status.LastSeen)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(lastSeen).To(BeTemporally("~", time.Now(), time.Minute))
+}
@@ -0,0 +1,505 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+
+	helmv2 "github.com/fluxcd/helm-controller/api/v2beta2"
+	kustomizev1 "github.com/fluxcd/kustomize-controller/api/v1"
+	"github.com/fluxcd/pkg/apis/meta"
+	sourcev1 "github.com/fluxcd/source-controller/api/v1"
+	sourcev1b2 "github.com/fluxcd/source-controller/api/v1beta2"
+	"github.com/hashicorp/go-multierror"
+	"github.com/weaveworks/weave-gitops/core/clustersmngr"
+	"github.com/weaveworks/weave-gitops/core/fluxsync"
+	pb "github.com/weaveworks/weave-gitops/pkg/api/core"
+	"github.com/weaveworks/weave-gitops/pkg/server/auth"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/cli-utils/pkg/object"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	edgeDependsOn   = "DependsOn"
+	edgeSourcesFrom = "SourcesFrom"
+	edgeProducedBy  = "ProducedBy"
+)
+
+// dependencyGraphKinds are the kinds of objects that make up the dependency
+// graph.
+var dependencyGraphKinds = []string{
+	kustomizev1.KustomizationKind,
+	helmv2.HelmReleaseKind,
+	sourcev1.GitRepositoryKind,
+	sourcev1b2.OCIRepositoryKind,
+	sourcev1b2.BucketKind,
+	sourcev1b2.HelmRepositoryKind,
+	sourcev1b2.HelmChartKind,
+}
+
+func (cs *coreServer) GetDependencyGraph(ctx context.Context, msg *pb.GetDependencyGraphRequest) (*pb.GetDependencyGraphResponse, error) {
+	respErrors := []*pb.ListError{}
+
+	var (
+		clustersClient clustersmngr.Client
+		err            error
+	)
+
+	if msg.ClusterName != "" {
+		clustersClient, err = cs.clustersManager.GetImpersonatedClientForCluster(ctx, auth.Principal(ctx), msg.ClusterName)
+	} else {
+		clustersClient, err = cs.clustersManager.GetImpersonatedClient(ctx, auth.Principal(ctx))
+	}
+
+	if clustersClient == nil {
+		return nil, doClientError(err)
+	}
+
+	if err != nil {
+		if merr, ok := err.(*multierror.Error); ok {
+			for _, err := range merr.Errors {
+				if cerr, ok := err.(*clustersmngr.ClientError); ok {
+					respErrors = append(respErrors, &pb.ListError{ClusterName: cerr.ClusterName, Message: cerr.Error()})
+				}
+			}
+		}
+	}
+
+	graph := newDependencyGraph()
+
+	for _, kind := range dependencyGraphKinds {
+		gvk, err := cs.primaryKinds.Lookup(kind)
+		if err != nil {
+			return nil, err
+		}
+
+		clist := clustersmngr.NewClusteredList(func() client.ObjectList {
+			list := unstructured.UnstructuredList{}
+			list.SetGroupVersionKind(*gvk)
+			return &list
+		})
+
+		if err := clustersClient.ClusteredList(ctx, clist, true); err != nil {
+			var errs clustersmngr.ClusteredListError
+			if !errors.As(err, &errs) {
+				return nil, err
+			}
+
+			for _, e := range errs.Errors {
+				respErrors = append(respErrors, &pb.ListError{ClusterName: e.Cluster, Namespace: e.Namespace, Message: e.Err.Error()})
+			}
+		}
+
+		for clusterName, lists := range clist.Lists() {
+			for _, l := range lists {
+				list, ok := l.(*unstructured.UnstructuredList)
+				if !ok {
+					continue
+				}
+
+				for i := range list.Items {
+					graph.addObject(clusterName, &list.Items[i])
+				}
+			}
+		}
+	}
+
+	respErrors = append(respErrors, graph.link()...)
+
+	if msg.Namespace != "" {
+		graph = graph.scoped(msg.Namespace)
+	}
+
+	res := graph.toProto()
+	res.Errors = respErrors
+
+	return res, nil
+}
+
+type dependencyGraphNode struct {
+	id          string
+	clusterName string
+	kind        string
+	name        string
+	namespace   string
+	status      string
+	message     string
+	ready       bool
+	suspended   bool
+	missing     bool
+	obj         *unstructured.Unstructured
+}
+
+type dependencyGraphEdge struct {
+	from     string
+	to       string
+	edgeType string
+}
+
+// dependencyGraph holds the Flux objects of one or more clusters and the
+// relationships between them. Edges point from the object that has the
+// relationship to the object it's related to, e.g. from a Kustomization to
+// the source it sources from.
+type dependencyGraph struct {
+	nodes map[string]*dependencyGraphNode
+	edges []dependencyGraphEdge
+}
+
+func newDependencyGraph() *dependencyGraph {
+	return &dependencyGraph{nodes: map[string]*dependencyGraphNode{}}
+}
+
+func dependencyGraphNodeID(clusterName, kind, namespace, name string) string {
+	return fmt.Sprintf("%s/%s/%s/%s", clusterName, kind, namespace, name)
+}
+
+func (g *dependencyGraph) addObject(clusterName string, obj *unstructured.Unstructured) {
+	id := dependencyGraphNodeID(clusterName, obj.GetKind(), obj.GetNamespace(), obj.GetName())
+
+	var message string
+	if cond, found := readyCondition(*obj); found {
+		message, _, _ = unstructured.NestedString(cond, "message")
+	}
+
+	suspended, _, _ := unstructured.NestedBool(obj.Object, "spec", "suspend")
+
+	g.nodes[id] = &dependencyGraphNode{
+		id:          id,
+		clusterName: clusterName,
+		kind:        obj.GetKind(),
+		name:        obj.GetName(),
+		namespace:   obj.GetNamespace(),
+		status:      objectStatus(*obj),
+		message:     message,
+		ready:       readyConditionStatus(*obj) == "True",
+		suspended:   suspended,
+		obj:         obj,
+	}
+}
+
+// addEdge adds an edge to the referenced object, adding a missing node for
+// it if it wasn't listed.
+func (g *dependencyGraph) addEdge(from *dependencyGraphNode, kind, namespace, name, edgeType string) {
+	to := dependencyGraphNodeID(from.clusterName, kind, namespace, name)
+
+	if _, ok := g.nodes[to]; !ok {
+		g.nodes[to] = &dependencyGraphNode{
+			id:          to,
+			clusterName: from.clusterName,
+			kind:        kind,
+			name:        name,
+			namespace:   namespace,
+			status:      "Unknown",
+			message:     fmt.Sprintf("%s %s/%s not found", kind, namespace, name),
+			missing:     true,
+		}
+	}
+
+	g.edges = append(g.edges, dependencyGraphEdge{from: from.id, to: to, edgeType: edgeType})
+}
+
+// link adds the edges between the listed objects. Objects that can't be
+// decoded are reported as errors and left without edges.
+func (g *dependencyGraph) link() []*pb.ListError {
+	var errs []*pb.ListError
+
+	listed := []*dependencyGraphNode{}
+	for _, n := range g.nodes {
+		listed = append(listed, n)
+	}
+
+	sort.Slice(listed, func(i, j int) bool { return listed[i].id < listed[j].id })
+
+	for _, n := range listed {
+		if err := g.linkNode(n); err != nil {
+			errs = append(errs, &pb.ListError{ClusterName: n.clusterName, Namespace: n.namespace, Message: err.Error()})
+		}
+	}
+
+	return errs
+}
+
+func (g *dependencyGraph) linkNode(n *dependencyGraphNode) error {
+	var (
+		automation fluxsync.Automation
+		dependsOn  []meta.NamespacedObjectReference
+		inventory  []string
+	)
+
+	switch n.kind {
+	case kustomizev1.KustomizationKind:
+		var ks kustomizev1.Kustomization
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(n.obj.UnstructuredContent(), &ks); err != nil {
+			return fmt.Errorf("converting unstructured to kustomization: %w", err)
+		}
+
+		automation = fluxsync.KustomizationAdapter{Kustomization: &ks}
+		dependsOn = ks.Spec.DependsOn
+
+		if ks.Status.Inventory != nil {
+			for _, entry := range ks.Status.Inventory.Entries {
+				inventory = append(inventory, entry.ID)
+			}
+		}
+	case helmv2.HelmReleaseKind:
+		var hr helmv2.HelmRelease
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(n.obj.UnstructuredContent(), &hr); err != nil {
+			return fmt.Errorf("converting unstructured to helmrelease: %w", err)
+		}
+
+		automation = fluxsync.HelmReleaseAdapter{HelmRelease: &hr}
+		dependsOn = hr.Spec.DependsOn
+	default:
+		return nil
+	}
+
+	for _, dep := range dependsOn {
+		namespace := dep.Namespace
+		if namespace == "" {
+			namespace = n.namespace
+		}
+
+		g.addEdge(n, n.kind, namespace, dep.Name, edgeDependsOn)
+	}
+
+	if sourceRef := automation.SourceRef(); sourceRef.Name() != "" {
+		// The namespace of the source defaults to the namespace of the
+		// automation, like in Flux.
+		namespace := sourceRef.Namespace()
+		if namespace == "" {
+			namespace = n.namespace
+		}
+
+		g.addEdge(n, sourceRef.Kind(), namespace, sourceRef.Name(), edgeSourcesFrom)
+	}
+
+	// Only the Flux objects of the inventory are part of the graph, so
+	// entries that weren't listed are skipped rather than reported missing.
+	for _, id := range inventory {
+		objMetadata, err := object.ParseObjMetadata(id)
+		if err != nil {
+			continue
+		}
+
+		produced := dependencyGraphNodeID(n.clusterName, objMetadata.GroupKind.Kind, objMetadata.Namespace, objMetadata.Name)
+		if p, ok := g.nodes[produced]; ok && !p.missing && produced != n.id {
+			g.edges = append(g.edges, dependencyGraphEdge{from: produced, to: n.id, edgeType: edgeProducedBy})
+		}
+	}
+
+	return nil
+}
+
+// scoped returns the part of the graph made of the objects in the namespace
+// and everything they depend on or source from, directly or not.
+func (g *dependencyGraph) scoped(namespace string) *dependencyGraph {
+	dependencies := map[string][]string{}
+
+	for _, e := range g.edges {
+		if e.edgeType != edgeProducedBy {
+			dependencies[e.from] = append(dependencies[e.from], e.to)
+		}
+	}
+
+	keep := map[string]bool{}
+	queue := []string{}
+
+	for id, n := range g.nodes {
+		if n.namespace == namespace {
+			keep[id] = true
+			queue = append(queue, id)
+		}
+	}
+
+	for len(queue) > 0 {
+		id := queue[0]
+		queue = queue[1:]
+
+		for _, dep := range dependencies[id] {
+			if !keep[dep] {
+				keep[dep] = true
+				queue = append(queue, dep)
+			}
+		}
+	}
+
+	scoped := newDependencyGraph()
+
+	for id := range keep {
+		scoped.nodes[id] = g.nodes[id]
+	}
+
+	for _, e := range g.edges {
+		if keep[e.from] && keep[e.to] {
+			scoped.edges = append(scoped.edges, e)
+		}
+	}
+
+	return scoped
+}
+
+// blockedBy returns the dependencies and sources of the node that aren't
+// ready.
+func (g *dependencyGraph) blockedBy(id string) []string {
+	blocked := []string{}
+
+	for _, e := range g.edges {
+		if e.from != id || e.edgeType == edgeProducedBy {
+			continue
+		}
+
+		if to := g.nodes[e.to]; to.missing || !to.ready {
+			blocked = append(blocked, e.to)
+		}
+	}
+
+	sort.Strings(blocked)
+
+	return blocked
+}
+
+// cycles returns the dependsOn cycles of the graph, found as the strongly
+// connected components with more than one node, or a node depending on
+// itself, using Tarjan's algorithm.
+func (g *dependencyGraph) cycles() [][]string {
+	dependencies := map[string][]string{}
+	selfLoops := map[string]bool{}
+
+	for _, e := range g.edges {
+		if e.edgeType != edgeDependsOn {
+			continue
+		}
+
+		dependencies[e.from] = append(dependencies[e.from], e.to)
+
+		if e.from == e.to {
+			selfLoops[e.from] = true
+		}
+	}
+
+	var (
+		index   = 0
+		indices = map[string]int{}
+		lowLink = map[string]int{}
+		onStack = map[string]bool{}
+		stack   []string
+		cycles  [][]string
+		visit   func(id string)
+	)
+
+	visit = func(id string) {
+		indices[id] = index
+		lowLink[id] = index
+		index++
+
+		stack = append(stack, id)
+		onStack[id] = true
+
+		for _, dep := range dependencies[id] {
+			if _, visited := indices[dep]; !visited {
+				visit(dep)
+
+				if lowLink[dep] < lowLink[id] {
+					lowLink[id] = lowLink[dep]
+				}
+			} else if onStack[dep] && indices[dep] < lowLink[id] {
+				lowLink[id] = indices[dep]
+			}
+		}
+
+		if lowLink[id] != indices[id] {
+			return
+		}
+
+		var component []string
+
+		for {
+			top := stack[len(stack)-1]
+			stack = stack[:len(stack)-1]
+			onStack[top] = false
+
+			component = append(component, top)
+
+			if top == id {
+				break
+			}
+		}
+
+		if len(component) > 1 || selfLoops[id] {
+			sort.Strings(component)
+			cycles = append(cycles, component)
+		}
+	}
+
+	for _, id := range g.sortedIDs() {
+		if _, visited := indices[id]; !visited {
+			visit(id)
+		}
+	}
+
+	sort.Slice(cycles, func(i, j int) bool { return cycles[i][0] < cycles[j][0] })
+
+	return cycles
+}
+
+func (g *dependencyGraph) sortedIDs() []string {
+	ids := make([]string, 0, len(g.nodes))
+	for id := range g.nodes {
+		ids = append(ids, id)
+	}
+
+	sort.Strings(ids)
+
+	return ids
+}
+
+func (g *dependencyGraph) toProto() *pb.GetDependencyGraphResponse {
+	res := &pb.GetDependencyGraphResponse{
+		Nodes:  []*pb.DependencyGraphNode{},
+		Edges:  []*pb.DependencyGraphEdge{},
+		Cycles: []*pb.DependencyCycle{},
+	}
+
+	for _, id := range g.sortedIDs() {
+		n := g.nodes[id]
+
+		res.Nodes = append(res.Nodes, &pb.DependencyGraphNode{
+			Id:          n.id,
+			Kind:        n.kind,
+			Name:        n.name,
+			Namespace:   n.namespace,
+			ClusterName: n.clusterName,
+			Status:      n.status,
+			Message:     n.message,
+			Suspended:   n.suspended,
+			Missing:     n.missing,
+			BlockedBy:   g.blockedBy(id),
+		})
+	}
+
+	edges := append([]dependencyGraphEdge{}, g.edges...)
+	sort.Slice(edges, func(i, j int) bool {
+		if edges[i].from != edges[j].from {
+			return edges[i].from < edges[j].from
+		}
+
+		if edges[i].to != edges[j].to {
+			return edges[i].to < edges[j].to
+		}
+
+		return edges[i].edgeType < edges[j].edgeType
+	})
+
+	for _, e := range edges {
+		res.Edges = append(res.Edges, &pb.DependencyGraphEdge{From: e.from, To: e.to, Type: e.edgeType})
+	}
+
+	for _, c := range g.cycles() {
+		res.Cycles = append(res.Cycles, &pb.DependencyCycle{NodeIds: c})
+	}
+
+	return res
+}
@@ -0,0 +1,141 @@
+package server_test
+
+import (
+	"context"
+	"testing"
+
+	helmv2 "github.com/fluxcd/helm-controller/api/v2beta2"
+	kustomizev1 "github.com/fluxcd/kustomize-controller/api/v1"
+	"github.com/fluxcd/pkg/apis/meta"
+	sourcev1 "github.com/fluxcd/source-controller/api/v1"
+	sourcev1b2 "github.com/fluxcd/source-controller/api/v1beta2"
+	. "github.com/onsi/gomega"
+	pb "github.com/weaveworks/weave-gitops/pkg/api/core"
+	"github.com/weaveworks/weave-gitops/pkg/kube"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestGetDependencyGraph(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	ctx := context.Background()
+
+	scheme, err := kube.CreateScheme()
+	g.Expect(err).To(BeNil())
+
+	ns := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "flux-system"}}
+	otherNS := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "other"}}
+
+	repo := &sourcev1.GitRepository{
+		ObjectMeta: metav1.ObjectMeta{Name: "my-repo", Namespace: ns.Name},
+		Status: sourcev1.GitRepositoryStatus{
+			Conditions: []metav1.Condition{{Type: "Ready", Status: metav1.ConditionTrue}},
+		},
+	}
+
+	infra := newKustomization("infra", ns.Name, false, metav1.ConditionTrue)
+	infra.Status.Inventory = &kustomizev1.ResourceInventory{
+		Entries: []kustomizev1.ResourceRef{
+			{ID: "flux-system_apps_kustomize.toolkit.fluxcd.io_Kustomization", Version: "v1"},
+			{ID: "flux-system_podinfo_apps_Deployment", Version: "v1"},
+		},
+	}
+
+	apps := newKustomization("apps", ns.Name, false, metav1.ConditionFalse)
+	apps.Spec.DependsOn = []meta.NamespacedObjectReference{{Name: "infra"}}
+
+	cycleA := newKustomization("cycle-a", ns.Name, false, metav1.ConditionUnknown)
+	cycleA.Spec.DependsOn = []meta.NamespacedObjectReference{{Name: "cycle-b"}}
+
+	cycleB := newKustomization("cycle-b", ns.Name, false, metav1.ConditionUnknown)
+	cycleB.Spec.DependsOn = []meta.NamespacedObjectReference{{Name: "cycle-a"}}
+
+	orphan := newKustomization("orphan", otherNS.Name, false, metav1.ConditionUnknown)
+	orphan.Spec.SourceRef.Namespace = ns.Name
+	orphan.Spec.DependsOn = []meta.NamespacedObjectReference{{Name: "does-not-exist"}}
+
+	release := &helmv2.HelmRelease{
+		ObjectMeta: metav1.ObjectMeta{Name: "podinfo", Namespace: ns.Name},
+		Spec: helmv2.HelmReleaseSpec{
+			Chart: helmv2.HelmChartTemplate{
+				Spec: helmv2.HelmChartTemplateSpec{
+					Chart: "podinfo",
+					SourceRef: helmv2.CrossNamespaceObjectReference{
+						Kind: sourcev1b2.HelmRepositoryKind,
+						Name: "podinfo",
+					},
+				},
+			},
+		},
+	}
+
+	fakeClient := fake.NewClientBuilder().WithScheme(scheme).WithRuntimeObjects(
+		ns, otherNS, repo, infra, apps, cycleA, cycleB, orphan, release,
+	).Build()
+
+	cfg := makeServerConfig(fakeClient, t, "")
+	c := makeServer(cfg, t)
+
+	id := func(kind, namespace, name string) string {
+		return "Default/" + kind + "/" + namespace + "/" + name
+	}
+
+	res, err := c.GetDependencyGraph(ctx, &pb.GetDependencyGraphRequest{})
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(res.Errors).To(BeEmpty())
+
+	nodes := map[string]*pb.DependencyGraphNode{}
+	for _, n := range res.Nodes {
+		nodes[n.Id] = n
+	}
+
+	g.Expect(nodes).To(HaveLen(9))
+	g.Expect(nodes[id(sourcev1.GitRepositoryKind, ns.Name, "my-repo")].Status).To(Equal("Ready"))
+	g.Expect(nodes[id(kustomizev1.KustomizationKind, ns.Name, "apps")].Status).To(Equal("Failed"))
+	g.Expect(nodes[id(kustomizev1.KustomizationKind, ns.Name, "apps")].BlockedBy).To(BeEmpty())
+	g.Expect(nodes[id(kustomizev1.KustomizationKind, otherNS.Name, "does-not-exist")].Missing).To(BeTrue())
+	g.Expect(nodes[id(kustomizev1.KustomizationKind, otherNS.Name, "orphan")].BlockedBy).To(ConsistOf(
+		id(kustomizev1.KustomizationKind, otherNS.Name, "does-not-exist"),
+	))
+	g.Expect(nodes[id(sourcev1b2.HelmRepositoryKind, ns.Name, "podinfo")].Missing).To(BeTrue())
+
+	type edge struct{ from, to, edgeType string }
+
+	edges := []edge{}
+	for _, e := range res.Edges {
+		edges = append(edges, edge{e.From, e.To, e.Type})
+	}
+
+	g.Expect(edges).To(ContainElements(
+		edge{id(kustomizev1.KustomizationKind, ns.Name, "apps"), id(kustomizev1.KustomizationKind, ns.Name, "infra"), "DependsOn"},
+		edge{id(kustomizev1.KustomizationKind, ns.Name, "apps"), id(sourcev1.GitRepositoryKind, ns.Name, "my-repo"), "SourcesFrom"},
+		edge{id(kustomizev1.KustomizationKind, ns.Name, "apps"), id(kustomizev1.KustomizationKind, ns.Name, "infra"), "ProducedBy"},
+		edge{id(kustomizev1.KustomizationKind, otherNS.Name, "orphan"), id(sourcev1.GitRepositoryKind, ns.Name, "my-repo"), "SourcesFrom"},
+		edge{id(helmv2.HelmReleaseKind, ns.Name, "podinfo"), id(sourcev1b2.HelmRepositoryKind, ns.Name, "podinfo"), "SourcesFrom"},
+	))
+
+	g.Expect(res.Cycles).To(HaveLen(1))
+	g.Expect(res.Cycles[0].NodeIds).To(Equal([]string{
+		id(kustomizev1.KustomizationKind, ns.Name, "cycle-a"),
+		id(kustomizev1.KustomizationKind, ns.Name, "cycle-b"),
+	}))
+
+	// Scoping to a namespace keeps the dependencies living in other
+	// namespaces.
+	res, err = c.GetDependencyGraph(ctx, &pb.GetDependencyGraphRequest{Namespace: otherNS.Name})
+	g.Expect(err).NotTo(HaveOccurred())
+
+	ids := []string{}
+	for _, n := range res.Nodes {
+		ids = append(ids, n.Id)
+	}
+
+	g.Expect(ids).To(ConsistOf(
+		id(kustomizev1.KustomizationKind, otherNS.Name, "orphan"),
+		id(kustomizev1.KustomizationKind, otherNS.Name, "does-not-exist"),
+		id(sourcev1.GitRepositoryKind, ns.Name, "my-repo"),
+	))
+	g.Expect(res.Cycles).To(BeEmpty())
+}
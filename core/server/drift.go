@@ -0,0 +1,345 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+
+	helmv2 "github.com/fluxcd/helm-controller/api/v2beta2"
+	kustomizev1 "github.com/fluxcd/kustomize-controller/api/v1"
+	"github.com/fluxcd/pkg/ssa"
+	"github.com/weaveworks/weave-gitops/core/fluxsync"
+	pb "github.com/weaveworks/weave-gitops/pkg/api/core"
+	"github.com/weaveworks/weave-gitops/pkg/fluxbuild"
+	"github.com/weaveworks/weave-gitops/pkg/server/auth"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/client-go/kubernetes"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+)
+
+const (
+	driftInSync  = "InSync"
+	driftDrifted = "Drifted"
+	driftMissing = "Missing"
+	driftError   = "Error"
+
+	fieldAdded   = "Added"
+	fieldRemoved = "Removed"
+	fieldChanged = "Changed"
+)
+
+// The field managers used by the Flux controllers, so the dry-run applies
+// take over the fields the controllers would.
+var (
+	kustomizeControllerOwner = ssa.Owner{Field: "kustomize-controller", Group: kustomizev1.GroupVersion.Group}
+	helmControllerOwner      = ssa.Owner{Field: "helm-controller", Group: helmv2.GroupVersion.Group}
+)
+
+func (cs *coreServer) GetDrift(ctx context.Context, msg *pb.GetDriftRequest) (*pb.GetDriftResponse, error) {
+	clustersClient, err := cs.clustersManager.GetImpersonatedClient(ctx, auth.Principal(ctx))
+	if err != nil {
+		return nil, fmt.Errorf("error getting impersonating client: %w", err)
+	}
+
+	c, err := clustersClient.Scoped(msg.ClusterName)
+	if err != nil {
+		return nil, fmt.Errorf("error getting scoped client: %w", err)
+	}
+
+	var (
+		desired   []*unstructured.Unstructured
+		revision  string
+		defaultNS string
+		owner     ssa.Owner
+	)
+
+	switch msg.Kind {
+	case kustomizev1.KustomizationKind:
+		ks := &kustomizev1.Kustomization{}
+		if err := c.Get(ctx, client.ObjectKey{Name: msg.Name, Namespace: msg.Namespace}, ks); err != nil {
+			return nil, fmt.Errorf("failed to get kustomization: %w", err)
+		}
+
+		if ks.Spec.KubeConfig != nil {
+			return nil, status.Error(codes.FailedPrecondition, "drift detection is not supported for kustomizations applied to remote clusters")
+		}
+
+		// The user can read the source, its artifact is fetched with the
+		// server's permissions.
+		clientset, err := cs.serverClientset(msg.ClusterName)
+		if err != nil {
+			return nil, err
+		}
+
+		desired, revision, err = cs.kustomizationDesiredObjects(ctx, c, clientset, ks)
+		if err != nil {
+			return nil, err
+		}
+
+		defaultNS = ks.Namespace
+		owner = kustomizeControllerOwner
+	case helmv2.HelmReleaseKind:
+		hr, err := cs.getHelmRelease(ctx, c, msg.Name, msg.Namespace)
+		if err != nil {
+			return nil, err
+		}
+
+		if hr.Spec.KubeConfig != nil {
+			return nil, status.Error(codes.FailedPrecondition, "drift detection is not supported for helm releases installed on remote clusters")
+		}
+
+		// The desired state is the manifest of the latest release, what
+		// helm-controller last rendered: changes to the chart or values that
+		// haven't been released yet aren't taken into account.
+		desired, err = getHelmReleaseObjects(ctx, c, hr)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get helm release objects: %w", err)
+		}
+
+		if latest := hr.Status.History.Latest(); latest != nil {
+			revision = latest.ChartVersion
+		}
+
+		defaultNS = defaultNSFromHelmRelease(hr)
+		owner = helmControllerOwner
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "drift detection is only supported for %s and %s", kustomizev1.KustomizationKind, helmv2.HelmReleaseKind)
+	}
+
+	manager := ssa.NewResourceManager(c, nil, owner)
+
+	if msg.Kind == kustomizev1.KustomizationKind {
+		manager.SetOwnerLabels(desired, msg.Name, msg.Namespace)
+	}
+
+	objects := []*pb.ObjectDrift{}
+
+	for _, obj := range desired {
+		if obj.GetNamespace() == "" {
+			namespaced, err := apiutil.IsObjectNamespaced(obj, c.Scheme(), c.RESTMapper())
+			if err == nil && namespaced {
+				obj.SetNamespace(defaultNS)
+			}
+		}
+
+		objects = append(objects, objectDrift(ctx, manager, obj))
+	}
+
+	return &pb.GetDriftResponse{
+		Objects:  objects,
+		Revision: revision,
+	}, nil
+}
+
+// kustomizationDesiredObjects renders the manifests of a Kustomization from
+// the artifact of its source, the same way kustomize-controller would.
+func (cs *coreServer) kustomizationDesiredObjects(ctx context.Context, c client.Client, clientset kubernetes.Interface, ks *kustomizev1.Kustomization) ([]*unstructured.Unstructured, string, error) {
+	sourceRef := fluxsync.KustomizationAdapter{Kustomization: ks}.SourceRef()
+
+	sourceGVK, err := cs.primaryKinds.Lookup(sourceRef.Kind())
+	if err != nil {
+		return nil, "", err
+	}
+
+	sourceNS := sourceRef.Namespace()
+	if sourceNS == "" {
+		sourceNS = ks.Namespace
+	}
+
+	source := &unstructured.Unstructured{}
+	source.SetGroupVersionKind(*sourceGVK)
+
+	if err := c.Get(ctx, client.ObjectKey{Name: sourceRef.Name(), Namespace: sourceNS}, source); err != nil {
+		return nil, "", fmt.Errorf("failed to get source: %w", err)
+	}
+
+	artifactURL, _, _ := unstructured.NestedString(source.Object, "status", "artifact", "url")
+	revision, _, _ := unstructured.NestedString(source.Object, "status", "artifact", "revision")
+
+	if artifactURL == "" {
+		return nil, "", status.Errorf(codes.FailedPrecondition, "%s %s/%s has no artifact", sourceRef.Kind(), sourceNS, sourceRef.Name())
+	}
+
+	tmpDir, err := os.MkdirTemp("", "drift-")
+	if err != nil {
+		return nil, "", err
+	}
+	defer os.RemoveAll(tmpDir)
+
+	objects, err := buildKustomization(ctx, clientset, artifactURL, tmpDir, ks)
+	if err != nil {
+		return nil, "", err
+	}
+
+	vars, err := substituteVariables(ctx, c, ks)
+	if err != nil {
+		return nil, "", err
+	}
+
+	for i, obj := range objects {
+		if objects[i], err = fluxbuild.Substitute(obj, vars); err != nil {
+			return nil, "", err
+		}
+	}
+
+	if meta := ks.Spec.CommonMetadata; meta != nil {
+		ssa.SetCommonMetadata(objects, meta.Labels, meta.Annotations)
+	}
+
+	return objects, revision, nil
+}
+
+// serverClientset returns the clientset of the cluster with the server's
+// permissions.
+func (cs *coreServer) serverClientset(clusterName string) (kubernetes.Interface, error) {
+	for _, cl := range cs.clustersManager.GetClusters() {
+		if cl.GetName() == clusterName {
+			return cl.GetServerClientset()
+		}
+	}
+
+	return nil, status.Errorf(codes.NotFound, "cluster %q not found", clusterName)
+}
+
+// objectDrift runs a server-side apply dry-run of the desired object, and
+// compares the result with the live object.
+func objectDrift(ctx context.Context, manager *ssa.ResourceManager, obj *unstructured.Unstructured) *pb.ObjectDrift {
+	gvk := obj.GroupVersionKind()
+
+	drift := &pb.ObjectDrift{
+		GroupVersionKind: &pb.GroupVersionKind{
+			Group:   gvk.Group,
+			Version: gvk.Version,
+			Kind:    gvk.Kind,
+		},
+		Name:      obj.GetName(),
+		Namespace: obj.GetNamespace(),
+		Fields:    []*pb.FieldDrift{},
+	}
+
+	entry, live, dryRun, err := manager.Diff(ctx, obj, ssa.DefaultDiffOptions())
+	if err != nil {
+		drift.Status = driftError
+		drift.Message = err.Error()
+
+		return drift
+	}
+
+	switch entry.Action {
+	case ssa.CreatedAction:
+		drift.Status = driftMissing
+	case ssa.ConfiguredAction:
+		drift.Status = driftDrifted
+		drift.Fields = driftedFields(live, dryRun)
+	default:
+		drift.Status = driftInSync
+	}
+
+	return drift
+}
+
+// driftedFields lists the fields a reconciliation would change on the live
+// object. Only the labels and annotations of the metadata are compared, and
+// the status is ignored.
+func driftedFields(live, dryRun *unstructured.Unstructured) []*pb.FieldDrift {
+	fields := []*pb.FieldDrift{}
+
+	compareFields("", prepareForDrift(dryRun), prepareForDrift(live), &fields)
+
+	return fields
+}
+
+func prepareForDrift(obj *unstructured.Unstructured) map[string]interface{} {
+	prepared := obj.DeepCopy().Object
+
+	delete(prepared, "status")
+
+	metadata := map[string]interface{}{}
+
+	for _, field := range []string{"labels", "annotations"} {
+		if value, found, _ := unstructured.NestedFieldNoCopy(prepared, "metadata", field); found {
+			metadata[field] = value
+		}
+	}
+
+	prepared["metadata"] = metadata
+
+	return prepared
+}
+
+func compareFields(path string, desired, live interface{}, fields *[]*pb.FieldDrift) {
+	desiredMap, desiredIsMap := desired.(map[string]interface{})
+	liveMap, liveIsMap := live.(map[string]interface{})
+
+	if desiredIsMap && liveIsMap {
+		keys := map[string]bool{}
+		for k := range desiredMap {
+			keys[k] = true
+		}
+
+		for k := range liveMap {
+			keys[k] = true
+		}
+
+		sorted := make([]string, 0, len(keys))
+		for k := range keys {
+			sorted = append(sorted, k)
+		}
+
+		sort.Strings(sorted)
+
+		for _, k := range sorted {
+			fieldPath := path + "/" + escapeJSONPointer(k)
+
+			desiredValue, inDesired := desiredMap[k]
+			liveValue, inLive := liveMap[k]
+
+			switch {
+			case !inLive:
+				*fields = append(*fields, &pb.FieldDrift{Path: fieldPath, Type: fieldAdded, Desired: toJSON(desiredValue)})
+			case !inDesired:
+				*fields = append(*fields, &pb.FieldDrift{Path: fieldPath, Type: fieldRemoved, Live: toJSON(liveValue)})
+			default:
+				compareFields(fieldPath, desiredValue, liveValue, fields)
+			}
+		}
+
+		return
+	}
+
+	desiredSlice, desiredIsSlice := desired.([]interface{})
+	liveSlice, liveIsSlice := live.([]interface{})
+
+	if desiredIsSlice && liveIsSlice && len(desiredSlice) == len(liveSlice) {
+		for i := range desiredSlice {
+			compareFields(path+"/"+strconv.Itoa(i), desiredSlice[i], liveSlice[i], fields)
+		}
+
+		return
+	}
+
+	if !reflect.DeepEqual(desired, live) {
+		*fields = append(*fields, &pb.FieldDrift{Path: path, Type: fieldChanged, Desired: toJSON(desired), Live: toJSON(live)})
+	}
+}
+
+func escapeJSONPointer(s string) string {
+	return strings.ReplaceAll(strings.ReplaceAll(s, "~", "~0"), "/", "~1")
+}
+
+func toJSON(value interface{}) string {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Sprintf("%v", value)
+	}
+
+	return string(data)
+}
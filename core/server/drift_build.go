@@ -0,0 +1,220 @@
+package server
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+
+	kustomizev1 "github.com/fluxcd/kustomize-controller/api/v1"
+	"github.com/weaveworks/weave-gitops/pkg/fluxbuild"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/client-go/kubernetes"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// maxArtifactSize is the maximum size of an extracted source artifact.
+const maxArtifactSize = 100 << 20
+
+// buildKustomization fetches the source artifact into dir and builds the
+// path of the Kustomization with kustomize, applying the overrides of the
+// Kustomization spec.
+func buildKustomization(ctx context.Context, cs kubernetes.Interface, artifactURL, dir string, ks *kustomizev1.Kustomization) ([]*unstructured.Unstructured, error) {
+	artifactDir := filepath.Join(dir, "artifact")
+
+	if err := fetchArtifact(ctx, cs, artifactURL, artifactDir); err != nil {
+		return nil, fmt.Errorf("fetching artifact: %w", err)
+	}
+
+	return fluxbuild.Build(artifactDir, &ks.Spec)
+}
+
+// fetchArtifact downloads a tarball artifact from source-controller and
+// extracts it into dir.
+func fetchArtifact(ctx context.Context, cs kubernetes.Interface, artifactURL, dir string) error {
+	body, err := openArtifact(ctx, cs, artifactURL)
+	if err != nil {
+		return err
+	}
+	defer body.Close()
+
+	return extractArtifact(body, dir)
+}
+
+// openArtifact returns the content of the artifact. The artifacts are served
+// by source-controller at the in-cluster address of its service, which is
+// only reachable from the cluster, so those are fetched through the service
+// proxy of the API server.
+func openArtifact(ctx context.Context, cs kubernetes.Interface, artifactURL string) (io.ReadCloser, error) {
+	u, err := url.Parse(artifactURL)
+	if err != nil {
+		return nil, err
+	}
+
+	if service, namespace, ok := clusterService(u.Hostname()); ok {
+		port := u.Port()
+		if port == "" {
+			port = "80"
+			if u.Scheme == "https" {
+				port = "443"
+			}
+		}
+
+		params := map[string]string{}
+		for k, v := range u.Query() {
+			params[k] = v[0]
+		}
+
+		return cs.CoreV1().Services(namespace).ProxyGet(u.Scheme, service, port, u.Path, params).Stream(ctx)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, artifactURL, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if res.StatusCode != http.StatusOK {
+		res.Body.Close()
+		return nil, fmt.Errorf("unexpected status %s", res.Status)
+	}
+
+	return res.Body, nil
+}
+
+// clusterService returns the service and namespace of the in-cluster
+// hostname of a service, <service>.<namespace>.svc[.cluster.local].
+func clusterService(hostname string) (string, string, bool) {
+	parts := strings.Split(strings.TrimSuffix(hostname, "."), ".")
+	if len(parts) < 3 || parts[2] != "svc" {
+		return "", "", false
+	}
+
+	return parts[0], parts[1], true
+}
+
+func extractArtifact(r io.Reader, dir string) error {
+	gzr, err := gzip.NewReader(r)
+	if err != nil {
+		return err
+	}
+	defer gzr.Close()
+
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return err
+	}
+
+	var size int64
+
+	tr := tar.NewReader(gzr)
+
+	for {
+		header, err := tr.Next()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+
+		if err != nil {
+			return err
+		}
+
+		target := filepath.Join(dir, filepath.Clean("/"+header.Name))
+		if !strings.HasPrefix(target, filepath.Clean(dir)+string(os.PathSeparator)) {
+			return fmt.Errorf("invalid path %q in artifact", header.Name)
+		}
+
+		switch header.Typeflag {
+		case tar.TypeDir:
+			if err := os.MkdirAll(target, 0o700); err != nil {
+				return err
+			}
+		case tar.TypeReg:
+			size += header.Size
+			if size > maxArtifactSize {
+				return fmt.Errorf("artifact is larger than %d bytes", maxArtifactSize)
+			}
+
+			if err := os.MkdirAll(filepath.Dir(target), 0o700); err != nil {
+				return err
+			}
+
+			if err := writeArtifactFile(target, tr, header.Size); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func writeArtifactFile(path string, r io.Reader, size int64) error {
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0o600)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	_, err = io.CopyN(f, r, size)
+
+	return err
+}
+
+// substituteVariables returns the variables of the post build substitutions
+// of a Kustomization, nil if it has none. The inline variables take
+// precedence over the ones from ConfigMaps and Secrets.
+func substituteVariables(ctx context.Context, c client.Client, ks *kustomizev1.Kustomization) (map[string]string, error) {
+	if ks.Spec.PostBuild == nil {
+		return nil, nil
+	}
+
+	vars := map[string]string{}
+
+	for _, ref := range ks.Spec.PostBuild.SubstituteFrom {
+		key := client.ObjectKey{Name: ref.Name, Namespace: ks.Namespace}
+
+		switch ref.Kind {
+		case "ConfigMap":
+			cm := &v1.ConfigMap{}
+			if err := c.Get(ctx, key, cm); err != nil {
+				if ref.Optional && client.IgnoreNotFound(err) == nil {
+					continue
+				}
+
+				return nil, fmt.Errorf("getting substitutions from ConfigMap %s: %w", key, err)
+			}
+
+			for k, v := range cm.Data {
+				vars[k] = v
+			}
+		case "Secret":
+			secret := &v1.Secret{}
+			if err := c.Get(ctx, key, secret); err != nil {
+				if ref.Optional && client.IgnoreNotFound(err) == nil {
+					continue
+				}
+
+				return nil, fmt.Errorf("getting substitutions from Secret %s: %w", key, err)
+			}
+
+			for k, v := range secret.Data {
+				vars[k] = string(v)
+			}
+		}
+	}
+
+	for k, v := range ks.Spec.PostBuild.Substitute {
+		vars[k] = v
+	}
+
+	return vars, nil
+}
@@ -0,0 +1,241 @@
+package server
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	kustomizev1 "github.com/fluxcd/kustomize-controller/api/v1"
+	"github.com/fluxcd/pkg/apis/kustomize"
+	. "github.com/onsi/gomega"
+	pb "github.com/weaveworks/weave-gitops/pkg/api/core"
+	"github.com/weaveworks/weave-gitops/pkg/kube"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	clientsetfake "k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/rest"
+	k8stesting "k8s.io/client-go/testing"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+const (
+	testDeployment = `apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: podinfo
+spec:
+  replicas: ${replicas:=1}
+  template:
+    spec:
+      containers:
+      - name: podinfo
+        image: ghcr.io/stefanprodan/podinfo:6.0.0
+`
+	testConfigMap = `apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: podinfo-config
+  annotations:
+    kustomize.toolkit.fluxcd.io/substitute: disabled
+data:
+  greeting: ${greeting}
+`
+)
+
+func makeArtifact(g *WithT, files map[string]string) []byte {
+	var buf bytes.Buffer
+
+	gzw := gzip.NewWriter(&buf)
+	tw := tar.NewWriter(gzw)
+
+	for name, content := range files {
+		g.Expect(tw.WriteHeader(&tar.Header{
+			Name:     name,
+			Mode:     0o600,
+			Size:     int64(len(content)),
+			Typeflag: tar.TypeReg,
+		})).To(Succeed())
+
+		_, err := tw.Write([]byte(content))
+		g.Expect(err).NotTo(HaveOccurred())
+	}
+
+	g.Expect(tw.Close()).To(Succeed())
+	g.Expect(gzw.Close()).To(Succeed())
+
+	return buf.Bytes()
+}
+
+func TestBuildKustomization(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	artifact := makeArtifact(g, map[string]string{
+		"apps/podinfo/deployment.yaml": testDeployment,
+		"apps/podinfo/configmap.yaml":  testConfigMap,
+		"apps/podinfo/values.txt":      "not a manifest",
+	})
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write(artifact)
+	}))
+	defer srv.Close()
+
+	ks := &kustomizev1.Kustomization{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "podinfo",
+			Namespace: "flux-system",
+		},
+		Spec: kustomizev1.KustomizationSpec{
+			Path:            "./apps/podinfo",
+			TargetNamespace: "podinfo",
+			Images: []kustomize.Image{
+				{Name: "ghcr.io/stefanprodan/podinfo", NewTag: "6.1.0"},
+			},
+		},
+	}
+
+	objects, err := buildKustomization(context.Background(), nil, srv.URL+"/artifact.tar.gz", t.TempDir(), ks)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(objects).To(HaveLen(2))
+
+	for _, obj := range objects {
+		g.Expect(obj.GetNamespace()).To(Equal("podinfo"))
+
+		if obj.GetKind() == "Deployment" {
+			containers, _, _ := unstructured.NestedSlice(obj.Object, "spec", "template", "spec", "containers")
+			g.Expect(containers).To(HaveLen(1))
+			g.Expect(containers[0]).To(HaveKeyWithValue("image", "ghcr.io/stefanprodan/podinfo:6.1.0"))
+		}
+	}
+}
+
+func TestFetchArtifactThroughServiceProxy(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	artifact := makeArtifact(g, map[string]string{
+		"apps/podinfo/configmap.yaml": testConfigMap,
+	})
+
+	clientset := clientsetfake.NewSimpleClientset()
+	clientset.AddProxyReactor("services", func(action k8stesting.Action) (bool, rest.ResponseWrapper, error) {
+		proxy := action.(k8stesting.ProxyGetAction)
+
+		if proxy.GetNamespace() != "flux-system" || proxy.GetName() != "source-controller" || proxy.GetPort() != "80" {
+			return true, nil, fmt.Errorf("unexpected service %s/%s:%s", proxy.GetNamespace(), proxy.GetName(), proxy.GetPort())
+		}
+
+		return true, &fakeResponseWrapper{body: artifact}, nil
+	})
+
+	dir := t.TempDir()
+
+	g.Expect(fetchArtifact(context.Background(), clientset, "http://source-controller.flux-system.svc.cluster.local./gitrepository/flux-system/podinfo/sha.tar.gz", dir)).To(Succeed())
+	g.Expect(dir + "/apps/podinfo/configmap.yaml").To(BeAnExistingFile())
+
+	actions := clientset.Actions()
+	g.Expect(actions).To(HaveLen(1))
+	g.Expect(actions[0].(k8stesting.ProxyGetAction).GetPath()).To(Equal("/gitrepository/flux-system/podinfo/sha.tar.gz"))
+}
+
+type fakeResponseWrapper struct {
+	body []byte
+}
+
+func (w *fakeResponseWrapper) DoRaw(context.Context) ([]byte, error) {
+	return w.body, nil
+}
+
+func (w *fakeResponseWrapper) Stream(context.Context) (io.ReadCloser, error) {
+	return io.NopCloser(bytes.NewReader(w.body)), nil
+}
+
+func TestExtractArtifactStaysInDirectory(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	artifact := makeArtifact(g, map[string]string{
+		"../../etc/passwd": "root",
+	})
+
+	dir := t.TempDir()
+
+	// The path is rooted in the directory rather than escaping it.
+	g.Expect(extractArtifact(bytes.NewReader(artifact), dir)).To(Succeed())
+	g.Expect(dir + "/etc/passwd").To(BeAnExistingFile())
+}
+
+func TestSubstituteVariables(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	scheme, err := kube.CreateScheme()
+	g.Expect(err).NotTo(HaveOccurred())
+
+	cm := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: "vars", Namespace: "flux-system"},
+		Data:       map[string]string{"replicas": "2", "greeting": "hello"},
+	}
+
+	c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(cm).Build()
+
+	ks := &kustomizev1.Kustomization{
+		ObjectMeta: metav1.ObjectMeta{Name: "podinfo", Namespace: "flux-system"},
+		Spec: kustomizev1.KustomizationSpec{
+			PostBuild: &kustomizev1.PostBuild{
+				Substitute: map[string]string{"replicas": "3"},
+				SubstituteFrom: []kustomizev1.SubstituteReference{
+					{Kind: "ConfigMap", Name: "vars"},
+					{Kind: "Secret", Name: "optional-vars", Optional: true},
+				},
+			},
+		},
+	}
+
+	vars, err := substituteVariables(context.Background(), c, ks)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(vars).To(Equal(map[string]string{"replicas": "3", "greeting": "hello"}))
+
+}
+
+func TestDriftedFields(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	live := &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "apps/v1",
+		"kind":       "Deployment",
+		"metadata": map[string]interface{}{
+			"name":            "podinfo",
+			"resourceVersion": "2",
+			"labels":          map[string]interface{}{"app": "podinfo", "edited/by": "kubectl"},
+		},
+		"spec": map[string]interface{}{
+			"replicas": int64(5),
+			"template": map[string]interface{}{
+				"spec": map[string]interface{}{
+					"containers": []interface{}{
+						map[string]interface{}{"name": "podinfo", "image": "podinfo:6.0.0"},
+					},
+				},
+			},
+		},
+		"status": map[string]interface{}{"replicas": int64(5)},
+	}}
+
+	dryRun := live.DeepCopy()
+	dryRun.SetResourceVersion("3")
+	dryRun.SetLabels(map[string]string{"app": "podinfo"})
+	g.Expect(unstructured.SetNestedField(dryRun.Object, int64(2), "spec", "replicas")).To(Succeed())
+	g.Expect(unstructured.SetNestedField(dryRun.Object, "Recreate", "spec", "strategy", "type")).To(Succeed())
+	g.Expect(unstructured.SetNestedField(dryRun.Object, int64(2), "status", "replicas")).To(Succeed())
+
+	g.Expect(driftedFields(live, dryRun)).To(Equal([]*pb.FieldDrift{
+		{Path: "/metadata/labels/edited~1by", Type: "Removed", Live: `"kubectl"`},
+		{Path: "/spec/replicas", Type: "Changed", Desired: "2", Live: "5"},
+		{Path: "/spec/strategy", Type: "Added", Desired: `{"type":"Recreate"}`},
+	}))
+}
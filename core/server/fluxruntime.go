@@ -369,6 +369,16 @@ func (cs *coreServer) GetReconciledObjects(ctx context.Context, msg *pb.GetRecon
 			continue
 		}
 
+		health, err := cs.healthChecker.Check(unstructuredObj)
+		if err != nil {
+			cs.logger.V(logger.LogLevelDebug).Info("Couldn't check the health of a reconciled object", "error", err)
+		}
+
+		o.Health = &pb.HealthStatus{
+			Status:  string(health.Status),
+			Message: health.Message,
+		}
+
 		objects = append(objects, o)
 	}
 
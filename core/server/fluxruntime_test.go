@@ -182,6 +182,7 @@ func TestGetReconciledObjects(t *testing.T) {
 				g.Expect(json.Unmarshal([]byte(actualObj.Payload), &object)).To(Succeed(), "failed unmarshalling result object")
 				metadata, ok := object["metadata"].(map[string]interface{})
 				g.Expect(ok).To(BeTrue(), "object has unexpected metadata type")
+				g.Expect(actualObj.Health).NotTo(BeNil(), "object has no health status")
 				actualObjs[idx] = objectAssertion{
 					kind: object["kind"].(string),
 					name: metadata["name"].(string),
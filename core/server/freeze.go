@@ -0,0 +1,452 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/weaveworks/weave-gitops/core/audit"
+	"github.com/weaveworks/weave-gitops/core/freeze"
+	pb "github.com/weaveworks/weave-gitops/pkg/api/core"
+	"github.com/weaveworks/weave-gitops/pkg/server/auth"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"k8s.io/apimachinery/pkg/util/wait"
+)
+
+// freezeReconcileInterval is how often the freeze windows are checked, and
+// so how late objects can be suspended and resumed.
+const freezeReconcileInterval = 30 * time.Second
+
+func (cs *coreServer) CreateFreeze(ctx context.Context, msg *pb.CreateFreezeRequest) (*pb.CreateFreezeResponse, error) {
+	res, err := cs.createFreeze(ctx, msg)
+
+	objects := []*pb.ObjectRef{}
+	if msg.Freeze != nil {
+		objects = append([]*pb.ObjectRef{freezeRef(msg.Freeze.Name)}, msg.Freeze.Objects...)
+	}
+
+	cs.recordAudit(ctx, audit.ActionCreateFreeze, objects, err)
+
+	if err != nil {
+		return nil, err
+	}
+
+	cs.recordAuditResults(ctx, audit.ActionSuspend, res.Results)
+
+	return res, nil
+}
+
+func (cs *coreServer) createFreeze(ctx context.Context, msg *pb.CreateFreezeRequest) (*pb.CreateFreezeResponse, error) {
+	if cs.freezes == nil {
+		return nil, status.Error(codes.Unavailable, "change freezes are not enabled")
+	}
+
+	if msg.Freeze == nil {
+		return nil, status.Error(codes.InvalidArgument, "freeze is required")
+	}
+
+	f, err := freezeFromProto(msg.Freeze)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+
+	principal := auth.Principal(ctx)
+
+	if err := checkCanCreateFreeze(principal); err != nil {
+		return nil, err
+	}
+
+	f.CreatedBy = freeze.Principal{ID: principal.ID, Groups: principal.Groups}
+
+	if err := f.Validate(); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+
+	now := time.Now()
+
+	if f.Expired(now) {
+		return nil, status.Error(codes.InvalidArgument, "end is in the past")
+	}
+
+	for _, selector := range f.Selectors {
+		if _, err := cs.primaryKinds.Lookup(selector.Kind); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "selector: %v", err)
+		}
+	}
+
+	if _, err := cs.freezes.Get(ctx, f.Name); err == nil {
+		return nil, status.Errorf(codes.AlreadyExists, "freeze %q already exists", f.Name)
+	} else if !errors.Is(err, freeze.ErrNotFound) {
+		return nil, err
+	}
+
+	if err := cs.freezes.Save(ctx, f); err != nil {
+		return nil, fmt.Errorf("saving freeze: %w", err)
+	}
+
+	// Don't wait for the next reconciliation when the window is open.
+	f, results, err := cs.reconcileFreeze(ctx, f, now)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.CreateFreezeResponse{Freeze: freezeToProto(f, now), Results: results}, nil
+}
+
+// checkCanCreateFreeze denies restricted access tokens the creation of
+// freezes: the freeze acts as the user later on, without the restrictions of
+// their access token.
+func checkCanCreateFreeze(principal *auth.UserPrincipal) error {
+	if principal.ReadOnly || len(principal.Clusters) > 0 {
+		return status.Error(codes.PermissionDenied, "freezes can't be created with a restricted access token")
+	}
+
+	return nil
+}
+
+func (cs *coreServer) ListFreezes(ctx context.Context, msg *pb.ListFreezesRequest) (*pb.ListFreezesResponse, error) {
+	if cs.freezes == nil {
+		return nil, status.Error(codes.Unavailable, "change freezes are not enabled")
+	}
+
+	freezes, err := cs.freezes.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+
+	result := []*pb.Freeze{}
+	for _, f := range freezes {
+		result = append(result, freezeToProto(f, now))
+	}
+
+	return &pb.ListFreezesResponse{Freezes: result}, nil
+}
+
+func (cs *coreServer) DeleteFreeze(ctx context.Context, msg *pb.DeleteFreezeRequest) (*pb.DeleteFreezeResponse, error) {
+	res, err := cs.deleteFreeze(ctx, msg)
+
+	cs.recordAudit(ctx, audit.ActionDeleteFreeze, []*pb.ObjectRef{freezeRef(msg.Name)}, err)
+
+	if err != nil {
+		return nil, err
+	}
+
+	cs.recordAuditResults(ctx, audit.ActionResume, res.Results)
+
+	return res, nil
+}
+
+func (cs *coreServer) deleteFreeze(ctx context.Context, msg *pb.DeleteFreezeRequest) (*pb.DeleteFreezeResponse, error) {
+	if cs.freezes == nil {
+		return nil, status.Error(codes.Unavailable, "change freezes are not enabled")
+	}
+
+	f, err := cs.freezes.Get(ctx, msg.Name)
+	if err != nil {
+		if errors.Is(err, freeze.ErrNotFound) {
+			return nil, status.Errorf(codes.NotFound, "freeze %q not found", msg.Name)
+		}
+
+		return nil, err
+	}
+
+	// The objects are suspended and resumed as the creator, who others may
+	// not be able to act as.
+	principal := auth.Principal(ctx)
+	if f.CreatedBy.ID != principal.ID {
+		return nil, status.Errorf(codes.PermissionDenied, "freeze %q was created by %s", f.Name, f.CreatedBy.ID)
+	}
+
+	if principal.ReadOnly {
+		return nil, status.Error(codes.PermissionDenied, "freezes can't be deleted with a read-only access token")
+	}
+
+	results := []*pb.ObjectResult{}
+
+	if !f.ActiveUntil.IsZero() {
+		results, err = cs.applyFreeze(ctx, f, false, f.ActiveUntil)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if err := cs.freezes.Delete(ctx, f.Name); err != nil && !errors.Is(err, freeze.ErrNotFound) {
+		return nil, fmt.Errorf("deleting freeze: %w", err)
+	}
+
+	return &pb.DeleteFreezeResponse{Results: results}, nil
+}
+
+// reconcileFreeze suspends the objects of a freeze when a window opens,
+// resumes them when it closes, and deletes one-off freezes once over. It
+// returns the updated freeze and the results of suspending or resuming the
+// objects, if any.
+func (cs *coreServer) reconcileFreeze(ctx context.Context, f freeze.Freeze, now time.Time) (freeze.Freeze, []*pb.ObjectResult, error) {
+	var (
+		results []*pb.ObjectResult
+		changed bool
+		err     error
+	)
+
+	_, end, open := f.Window(now)
+
+	switch {
+	case open && !f.ActiveUntil.Equal(end):
+		if results, err = cs.applyFreeze(ctx, f, true, end); err != nil {
+			return f, nil, err
+		}
+
+		f.ActiveUntil = end
+		changed = true
+	case !open && !f.ActiveUntil.IsZero():
+		if results, err = cs.applyFreeze(ctx, f, false, f.ActiveUntil); err != nil {
+			return f, nil, err
+		}
+
+		f.ActiveUntil = time.Time{}
+		changed = true
+	}
+
+	if f.Expired(now) && f.ActiveUntil.IsZero() {
+		if err := cs.freezes.Delete(ctx, f.Name); err != nil && !errors.Is(err, freeze.ErrNotFound) {
+			return f, nil, fmt.Errorf("deleting freeze %q: %w", f.Name, err)
+		}
+
+		return f, results, nil
+	}
+
+	if changed {
+		if err := cs.freezes.Save(ctx, f); err != nil {
+			return f, nil, fmt.Errorf("saving freeze %q: %w", f.Name, err)
+		}
+	}
+
+	return f, results, nil
+}
+
+// applyFreeze suspends the objects of a freeze until the end of a window, or
+// resumes them, as its creator. Objects suspended by someone else are left
+// alone.
+func (cs *coreServer) applyFreeze(ctx context.Context, f freeze.Freeze, suspend bool, until time.Time) ([]*pb.ObjectResult, error) {
+	principal := &auth.UserPrincipal{ID: f.CreatedBy.ID, Groups: f.CreatedBy.Groups}
+
+	clustersClient, err := cs.clustersManager.GetImpersonatedClient(ctx, principal)
+	if err != nil {
+		return nil, fmt.Errorf("error getting impersonating client: %w", err)
+	}
+
+	objects, results, err := cs.selectObjects(ctx, clustersClient, protoObjectRefs(f.Objects), protoSelectors(f.Selectors))
+	if err != nil {
+		return nil, err
+	}
+
+	window := &suspendWindow{freeze: f.Name, until: until, skipSuspended: true}
+
+	for _, ref := range objects {
+		err := cs.toggleSuspend(ctx, clustersClient, ref, suspend, f.Comment, principal, window)
+		if errors.Is(err, errNotFrozen) {
+			continue
+		}
+
+		results = append(results, objectResult(ref, err))
+	}
+
+	return results, nil
+}
+
+// FreezeController suspends and resumes the objects of the change freezes as
+// their windows open and close.
+type FreezeController struct {
+	log logr.Logger
+	cs  *coreServer
+}
+
+func NewFreezeController(cfg CoreServerConfig) (*FreezeController, error) {
+	if cfg.FreezeStore == nil {
+		return nil, errors.New("no freeze store configured")
+	}
+
+	s, err := NewCoreServer(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	return &FreezeController{
+		log: cfg.log.WithName("freeze-controller"),
+		cs:  s.(*coreServer),
+	}, nil
+}
+
+// Start reconciles the freezes in the background until the context is
+// cancelled.
+func (c *FreezeController) Start(ctx context.Context) {
+	go func() {
+		//nolint:staticcheck // deprecated, tracking issue: https://github.com/weaveworks/weave-gitops/issues/3812
+		_ = wait.PollImmediateInfiniteWithContext(ctx, freezeReconcileInterval, func(ctx context.Context) (bool, error) {
+			if err := c.Reconcile(ctx, time.Now()); err != nil {
+				c.log.Error(err, "reconciling freezes")
+			}
+
+			return false, nil
+		})
+	}()
+}
+
+// Reconcile suspends and resumes the objects of every freeze whose window
+// opened or closed by now.
+func (c *FreezeController) Reconcile(ctx context.Context, now time.Time) error {
+	freezes, err := c.cs.freezes.List(ctx)
+	if err != nil {
+		return err
+	}
+
+	var errs []error
+
+	for _, f := range freezes {
+		_, results, err := c.cs.reconcileFreeze(ctx, f, now)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+
+		for _, r := range results {
+			if r.Status != resultSuccess {
+				c.log.Info("couldn't apply freeze", "freeze", f.Name, "cluster", r.Object.ClusterName, "kind", r.Object.Kind,
+					"namespace", r.Object.Namespace, "name", r.Object.Name, "status", r.Status, "message", r.Message)
+			}
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+func freezeFromProto(msg *pb.Freeze) (freeze.Freeze, error) {
+	f := freeze.Freeze{
+		Name:      msg.Name,
+		Objects:   freezeObjectRefs(msg.Objects),
+		Selectors: freezeSelectors(msg.Selectors),
+		Schedule:  msg.Schedule,
+		Comment:   msg.Comment,
+	}
+
+	var err error
+
+	if msg.Start != "" {
+		if f.Start, err = time.Parse(time.RFC3339, msg.Start); err != nil {
+			return f, fmt.Errorf("invalid start: %w", err)
+		}
+	}
+
+	if msg.End != "" {
+		if f.End, err = time.Parse(time.RFC3339, msg.End); err != nil {
+			return f, fmt.Errorf("invalid end: %w", err)
+		}
+	}
+
+	if msg.Duration != "" {
+		if f.Duration, err = time.ParseDuration(msg.Duration); err != nil {
+			return f, fmt.Errorf("invalid duration: %w", err)
+		}
+	}
+
+	return f, nil
+}
+
+func freezeToProto(f freeze.Freeze, now time.Time) *pb.Freeze {
+	msg := &pb.Freeze{
+		Name:      f.Name,
+		Objects:   protoObjectRefs(f.Objects),
+		Selectors: protoSelectors(f.Selectors),
+		Start:     formatFreezeTime(f.Start),
+		End:       formatFreezeTime(f.End),
+		Schedule:  f.Schedule,
+		Comment:   f.Comment,
+		CreatedBy: f.CreatedBy.ID,
+	}
+
+	if f.Duration != 0 {
+		msg.Duration = f.Duration.String()
+	}
+
+	msg.ActiveUntil = formatFreezeTime(f.ActiveUntil)
+
+	if start, _, ok := f.NextWindow(now); ok {
+		msg.NextStart = formatFreezeTime(start)
+	}
+
+	return msg
+}
+
+// freezeRef refers to a freeze in the audit log.
+func freezeRef(name string) *pb.ObjectRef {
+	return &pb.ObjectRef{Kind: "Freeze", Name: name}
+}
+
+func formatFreezeTime(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+
+	return t.UTC().Format(time.RFC3339)
+}
+
+func freezeObjectRefs(refs []*pb.ObjectRef) []freeze.ObjectRef {
+	result := []freeze.ObjectRef{}
+	for _, ref := range refs {
+		result = append(result, freeze.ObjectRef{
+			ClusterName: ref.ClusterName,
+			Kind:        ref.Kind,
+			Namespace:   ref.Namespace,
+			Name:        ref.Name,
+		})
+	}
+
+	return result
+}
+
+func freezeSelectors(selectors []*pb.ObjectSelector) []freeze.Selector {
+	result := []freeze.Selector{}
+	for _, s := range selectors {
+		result = append(result, freeze.Selector{
+			Kind:        s.Kind,
+			ClusterName: s.ClusterName,
+			Namespace:   s.Namespace,
+			Labels:      s.Labels,
+		})
+	}
+
+	return result
+}
+
+func protoObjectRefs(refs []freeze.ObjectRef) []*pb.ObjectRef {
+	result := []*pb.ObjectRef{}
+	for _, ref := range refs {
+		result = append(result, &pb.ObjectRef{
+			ClusterName: ref.ClusterName,
+			Kind:        ref.Kind,
+			Namespace:   ref.Namespace,
+			Name:        ref.Name,
+		})
+	}
+
+	return result
+}
+
+func protoSelectors(selectors []freeze.Selector) []*pb.ObjectSelector {
+	result := []*pb.ObjectSelector{}
+	for _, s := range selectors {
+		result = append(result, &pb.ObjectSelector{
+			Kind:        s.Kind,
+			ClusterName: s.ClusterName,
+			Namespace:   s.Namespace,
+			Labels:      s.Labels,
+		})
+	}
+
+	return result
+}
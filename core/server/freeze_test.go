@@ -0,0 +1,266 @@
+package server_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	kustomizev1 "github.com/fluxcd/kustomize-controller/api/v1"
+	. "github.com/onsi/gomega"
+	"github.com/weaveworks/weave-gitops/core/freeze"
+	"github.com/weaveworks/weave-gitops/core/server"
+	pb "github.com/weaveworks/weave-gitops/pkg/api/core"
+	"github.com/weaveworks/weave-gitops/pkg/kube"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestFreezes(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	ctx := context.Background()
+
+	scheme, err := kube.CreateScheme()
+	g.Expect(err).To(BeNil())
+
+	ns := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "flux-system"}}
+
+	prod := map[string]string{"tier": "prod"}
+
+	apps := newKustomization("apps", ns.Name, false, metav1.ConditionTrue)
+	apps.Labels = prod
+
+	// Suspended by someone else, the freeze leaves it alone.
+	manual := newKustomization("manual", ns.Name, true, metav1.ConditionTrue)
+	manual.Labels = prod
+	manual.Annotations = map[string]string{server.SuspendedByAnnotation: "bob"}
+
+	infra := newKustomization("infra", ns.Name, false, metav1.ConditionTrue)
+
+	k := fake.NewClientBuilder().WithScheme(scheme).WithObjects(ns, apps, manual, infra).Build()
+
+	cfg := makeServerConfig(k, t, "")
+	cfg.FreezeStore = freeze.NewConfigMapStore(k, ns.Name, freeze.DefaultConfigMapName)
+
+	c := makeServer(cfg, t)
+
+	controller, err := server.NewFreezeController(cfg)
+	g.Expect(err).NotTo(HaveOccurred())
+
+	md := metadata.Pairs(MetadataUserKey, "anne", MetadataGroupsKey, "system:masters")
+	outgoingCtx := metadata.NewOutgoingContext(ctx, md)
+
+	end := time.Now().Add(time.Hour).UTC().Truncate(time.Second)
+
+	res, err := c.CreateFreeze(outgoingCtx, &pb.CreateFreezeRequest{
+		Freeze: &pb.Freeze{
+			Name: "release",
+			Selectors: []*pb.ObjectSelector{{
+				Kind:   kustomizev1.KustomizationKind,
+				Labels: prod,
+			}},
+			End:     end.Format(time.RFC3339),
+			Comment: "Release day",
+		},
+	})
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(res.Freeze.CreatedBy).To(Equal("anne"))
+	g.Expect(res.Freeze.ActiveUntil).To(Equal(end.Format(time.RFC3339)))
+
+	// The window is already open.
+	g.Expect(res.Results).To(HaveLen(1))
+	g.Expect(res.Results[0].Object.Name).To(Equal(apps.Name))
+	g.Expect(res.Results[0].Status).To(Equal("Success"))
+
+	g.Expect(k.Get(ctx, client.ObjectKeyFromObject(apps), apps)).To(Succeed())
+	g.Expect(apps.Spec.Suspend).To(BeTrue())
+	g.Expect(apps.Annotations).To(HaveKeyWithValue(server.SuspendedByAnnotation, "anne"))
+	g.Expect(apps.Annotations).To(HaveKeyWithValue(server.SuspendedCommentAnnotation, "Release day"))
+	g.Expect(apps.Annotations).To(HaveKeyWithValue(server.SuspendedByFreezeAnnotation, "release"))
+	g.Expect(apps.Annotations).To(HaveKeyWithValue(server.SuspendedUntilAnnotation, end.Format(time.RFC3339)))
+
+	g.Expect(k.Get(ctx, client.ObjectKeyFromObject(manual), manual)).To(Succeed())
+	g.Expect(manual.Annotations).To(HaveKeyWithValue(server.SuspendedByAnnotation, "bob"))
+
+	_, err = c.CreateFreeze(outgoingCtx, &pb.CreateFreezeRequest{
+		Freeze: &pb.Freeze{Name: "release", Objects: []*pb.ObjectRef{{Kind: kustomizev1.KustomizationKind}}, End: end.Format(time.RFC3339)},
+	})
+	g.Expect(status.Code(err)).To(Equal(codes.AlreadyExists))
+
+	_, err = c.CreateFreeze(outgoingCtx, &pb.CreateFreezeRequest{
+		Freeze: &pb.Freeze{Name: "weekends", Selectors: []*pb.ObjectSelector{{Kind: kustomizev1.KustomizationKind}}, Schedule: "0 18 * * FRI"},
+	})
+	g.Expect(status.Code(err)).To(Equal(codes.InvalidArgument))
+
+	// Nothing changes until the window closes.
+	g.Expect(controller.Reconcile(ctx, end.Add(-time.Minute))).To(Succeed())
+	g.Expect(k.Get(ctx, client.ObjectKeyFromObject(apps), apps)).To(Succeed())
+	g.Expect(apps.Spec.Suspend).To(BeTrue())
+
+	g.Expect(controller.Reconcile(ctx, end)).To(Succeed())
+
+	g.Expect(k.Get(ctx, client.ObjectKeyFromObject(apps), apps)).To(Succeed())
+	g.Expect(apps.Spec.Suspend).To(BeFalse())
+	g.Expect(apps.Annotations).NotTo(HaveKey(server.SuspendedByAnnotation))
+	g.Expect(apps.Annotations).NotTo(HaveKey(server.SuspendedByFreezeAnnotation))
+	g.Expect(apps.Annotations).NotTo(HaveKey(server.SuspendedUntilAnnotation))
+
+	g.Expect(k.Get(ctx, client.ObjectKeyFromObject(manual), manual)).To(Succeed())
+	g.Expect(manual.Spec.Suspend).To(BeTrue())
+
+	// The one-off freeze is over.
+	list, err := c.ListFreezes(outgoingCtx, &pb.ListFreezesRequest{})
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(list.Freezes).To(BeEmpty())
+}
+
+func TestToggleSuspendResourceUntil(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	ctx := context.Background()
+
+	scheme, err := kube.CreateScheme()
+	g.Expect(err).To(BeNil())
+
+	ns := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "flux-system"}}
+	apps := newKustomization("apps", ns.Name, false, metav1.ConditionTrue)
+
+	k := fake.NewClientBuilder().WithScheme(scheme).WithObjects(ns, apps).Build()
+
+	cfg := makeServerConfig(k, t, "")
+	cfg.FreezeStore = freeze.NewConfigMapStore(k, ns.Name, freeze.DefaultConfigMapName)
+
+	c := makeServer(cfg, t)
+
+	controller, err := server.NewFreezeController(cfg)
+	g.Expect(err).NotTo(HaveOccurred())
+
+	anneCtx := metadata.NewOutgoingContext(ctx, metadata.Pairs(MetadataUserKey, "anne", MetadataGroupsKey, "system:masters"))
+	bobCtx := metadata.NewOutgoingContext(ctx, metadata.Pairs(MetadataUserKey, "bob", MetadataGroupsKey, "system:masters"))
+
+	until := time.Now().Add(time.Hour).UTC().Truncate(time.Second)
+
+	msg := &pb.ToggleSuspendResourceRequest{
+		Objects: []*pb.ObjectRef{{
+			Kind:        kustomizev1.KustomizationKind,
+			Name:        apps.Name,
+			Namespace:   apps.Namespace,
+			ClusterName: "Default",
+		}},
+		Suspend: true,
+		Until:   until.Format(time.RFC3339),
+	}
+
+	// The freeze resuming the objects can't be created with a restricted
+	// access token.
+	restrictedCtx := metadata.NewOutgoingContext(ctx, metadata.Pairs(MetadataUserKey, "anne", MetadataGroupsKey, "system:masters", MetadataClustersKey, "Default"))
+
+	_, err = c.ToggleSuspendResource(restrictedCtx, msg)
+	g.Expect(status.Code(err)).To(Equal(codes.PermissionDenied))
+
+	g.Expect(k.Get(ctx, client.ObjectKeyFromObject(apps), apps)).To(Succeed())
+	g.Expect(apps.Spec.Suspend).To(BeFalse())
+
+	res, err := c.ToggleSuspendResource(anneCtx, msg)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(res.Results).To(HaveLen(1))
+	g.Expect(res.Results[0].Status).To(Equal("Success"))
+
+	g.Expect(k.Get(ctx, client.ObjectKeyFromObject(apps), apps)).To(Succeed())
+	g.Expect(apps.Spec.Suspend).To(BeTrue())
+	g.Expect(apps.Annotations).To(HaveKeyWithValue(server.SuspendedUntilAnnotation, until.Format(time.RFC3339)))
+
+	list, err := c.ListFreezes(anneCtx, &pb.ListFreezesRequest{})
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(list.Freezes).To(HaveLen(1))
+	g.Expect(apps.Annotations).To(HaveKeyWithValue(server.SuspendedByFreezeAnnotation, list.Freezes[0].Name))
+
+	// Only the creator can delete the freeze.
+	_, err = c.DeleteFreeze(bobCtx, &pb.DeleteFreezeRequest{Name: list.Freezes[0].Name})
+	g.Expect(status.Code(err)).To(Equal(codes.PermissionDenied))
+
+	g.Expect(controller.Reconcile(ctx, until)).To(Succeed())
+
+	g.Expect(k.Get(ctx, client.ObjectKeyFromObject(apps), apps)).To(Succeed())
+	g.Expect(apps.Spec.Suspend).To(BeFalse())
+
+	msg.Suspend = false
+
+	_, err = c.ToggleSuspendResource(anneCtx, msg)
+	g.Expect(status.Code(err)).To(Equal(codes.InvalidArgument))
+
+	msg.Suspend = true
+	msg.Until = time.Now().Add(-time.Hour).Format(time.RFC3339)
+
+	_, err = c.ToggleSuspendResource(anneCtx, msg)
+	g.Expect(status.Code(err)).To(Equal(codes.InvalidArgument))
+}
+
+func TestDeleteFreeze(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	ctx := context.Background()
+
+	scheme, err := kube.CreateScheme()
+	g.Expect(err).To(BeNil())
+
+	ns := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "flux-system"}}
+	apps := newKustomization("apps", ns.Name, false, metav1.ConditionTrue)
+
+	k := fake.NewClientBuilder().WithScheme(scheme).WithObjects(ns, apps).Build()
+
+	cfg := makeServerConfig(k, t, "")
+	cfg.FreezeStore = freeze.NewConfigMapStore(k, ns.Name, freeze.DefaultConfigMapName)
+
+	c := makeServer(cfg, t)
+
+	md := metadata.Pairs(MetadataUserKey, "anne", MetadataGroupsKey, "system:masters")
+	outgoingCtx := metadata.NewOutgoingContext(ctx, md)
+
+	// Every minute, for a day.
+	_, err = c.CreateFreeze(outgoingCtx, &pb.CreateFreezeRequest{
+		Freeze: &pb.Freeze{
+			Name: "always",
+			Objects: []*pb.ObjectRef{{
+				Kind:        kustomizev1.KustomizationKind,
+				Name:        apps.Name,
+				Namespace:   apps.Namespace,
+				ClusterName: "Default",
+			}},
+			Schedule: "* * * * *",
+			Duration: "24h",
+		},
+	})
+	g.Expect(err).NotTo(HaveOccurred())
+
+	g.Expect(k.Get(ctx, client.ObjectKeyFromObject(apps), apps)).To(Succeed())
+	g.Expect(apps.Spec.Suspend).To(BeTrue())
+
+	list, err := c.ListFreezes(outgoingCtx, &pb.ListFreezesRequest{})
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(list.Freezes).To(HaveLen(1))
+	g.Expect(list.Freezes[0].Duration).To(Equal("24h0m0s"))
+	g.Expect(list.Freezes[0].NextStart).NotTo(BeEmpty())
+
+	res, err := c.DeleteFreeze(outgoingCtx, &pb.DeleteFreezeRequest{Name: "always"})
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(res.Results).To(HaveLen(1))
+	g.Expect(res.Results[0].Status).To(Equal("Success"))
+
+	g.Expect(k.Get(ctx, client.ObjectKeyFromObject(apps), apps)).To(Succeed())
+	g.Expect(apps.Spec.Suspend).To(BeFalse())
+
+	_, err = c.DeleteFreeze(outgoingCtx, &pb.DeleteFreezeRequest{Name: "always"})
+	g.Expect(status.Code(err)).To(Equal(codes.NotFound))
+
+	// Without a store, freezes are disabled.
+	c = makeServer(makeServerConfig(k, t, ""), t)
+
+	_, err = c.ListFreezes(outgoingCtx, &pb.ListFreezesRequest{})
+	g.Expect(status.Code(err)).To(Equal(codes.Unavailable))
+}
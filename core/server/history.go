@@ -0,0 +1,157 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/weaveworks/weave-gitops/core/clustersmngr/cluster"
+	"github.com/weaveworks/weave-gitops/core/history"
+	pb "github.com/weaveworks/weave-gitops/pkg/api/core"
+	"github.com/weaveworks/weave-gitops/pkg/server/auth"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	rbacv1 "k8s.io/api/rbac/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func (cs *coreServer) GetObjectHistory(ctx context.Context, msg *pb.GetObjectHistoryRequest) (*pb.GetObjectHistoryResponse, error) {
+	if cs.history == nil {
+		return nil, status.Error(codes.Unavailable, "object history is not enabled")
+	}
+
+	query := history.Query{
+		Object: history.ObjectRef{
+			ClusterName: msg.ClusterName,
+			Kind:        msg.Kind,
+			Namespace:   msg.Namespace,
+			Name:        msg.Name,
+		},
+		Limit: int(msg.Limit),
+	}
+
+	var err error
+
+	if query.Since, err = parseHistoryTime(msg.Since); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid since: %v", err)
+	}
+
+	if query.Until, err = parseHistoryTime(msg.Until); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid until: %v", err)
+	}
+
+	gvk, err := cs.primaryKinds.Lookup(msg.Kind)
+	if err != nil {
+		return nil, err
+	}
+
+	// The timeline is recorded with the server's permissions, and outlives
+	// the object: the user needs to be able to read the objects of its kind
+	// in its namespace to read it.
+	if err := cs.checkCanReadHistory(ctx, auth.Principal(ctx), msg.ClusterName, msg.Namespace, *gvk); err != nil {
+		return nil, err
+	}
+
+	events, err := cs.history.List(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("listing history: %w", err)
+	}
+
+	result := []*pb.HistoryEvent{}
+	for _, e := range events {
+		result = append(result, &pb.HistoryEvent{
+			Type:      e.Type,
+			Timestamp: e.Timestamp.Format(time.RFC3339),
+			Revision:  e.Revision,
+			Status:    e.Status,
+			Reason:    e.Reason,
+			Message:   e.Message,
+			Principal: e.Principal,
+		})
+	}
+
+	return &pb.GetObjectHistoryResponse{Events: result}, nil
+}
+
+// checkCanReadHistory returns an error unless the user can list or get the
+// objects of a kind in a namespace of a cluster.
+func (cs *coreServer) checkCanReadHistory(ctx context.Context, principal *auth.UserPrincipal, clusterName, namespace string, gvk schema.GroupVersionKind) error {
+	if principal == nil {
+		return status.Error(codes.Unauthenticated, "no user supplied")
+	}
+
+	var cl cluster.Cluster
+
+	for _, c := range cs.clustersManager.GetClusters() {
+		if c.GetName() == clusterName && principal.AllowsCluster(clusterName) {
+			cl = c
+			break
+		}
+	}
+
+	if cl == nil {
+		return status.Errorf(codes.NotFound, "cluster not found: %s", clusterName)
+	}
+
+	clientset, err := cl.GetUserClientset(principal)
+	if err != nil {
+		return fmt.Errorf("error creating client for cluster: %w", err)
+	}
+
+	plural, _ := meta.UnsafeGuessKindToResource(gvk)
+	resource := plural.Resource
+	cacheKey := fmt.Sprintf("%s/%s", cl.GetName(), principal.Hash())
+
+	for _, verb := range []string{"list", "get"} {
+		ok, err := cs.rulesReviewer.Can(ctx, clientset.AuthorizationV1(), cacheKey, namespace, rbacv1.PolicyRule{
+			APIGroups: []string{gvk.Group},
+			Resources: []string{resource},
+			Verbs:     []string{verb},
+		})
+		if err != nil {
+			return err
+		}
+
+		if ok {
+			return nil
+		}
+	}
+
+	return status.Errorf(codes.PermissionDenied, "not allowed to read the %s of namespace %s", resource, namespace)
+}
+
+// recordHistory adds an event caused by a user to the timeline of an object.
+// Failing to record it doesn't fail the request.
+func (cs *coreServer) recordHistory(ctx context.Context, clusterName string, obj client.Object, kind, eventType string, principal *auth.UserPrincipal) {
+	if cs.history == nil {
+		return
+	}
+
+	event := history.Event{
+		Object: history.ObjectRef{
+			ClusterName: clusterName,
+			Kind:        kind,
+			Namespace:   obj.GetNamespace(),
+			Name:        obj.GetName(),
+		},
+		Type: eventType,
+	}
+
+	if principal != nil {
+		event.Principal = principal.ID
+	}
+
+	if err := cs.history.Record(ctx, event); err != nil {
+		cs.logger.Error(err, "failed recording history event", "object", event.Object.String(), "type", eventType)
+	}
+}
+
+func parseHistoryTime(value string) (time.Time, error) {
+	if value == "" {
+		return time.Time{}, nil
+	}
+
+	return time.Parse(time.RFC3339, value)
+}
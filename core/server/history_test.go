@@ -0,0 +1,154 @@
+package server_test
+
+import (
+	"context"
+	"testing"
+
+	kustomizev1 "github.com/fluxcd/kustomize-controller/api/v1"
+	"github.com/go-logr/logr"
+	. "github.com/onsi/gomega"
+	"github.com/weaveworks/weave-gitops/core/clustersmngr"
+	"github.com/weaveworks/weave-gitops/core/clustersmngr/cluster/clusterfakes"
+	"github.com/weaveworks/weave-gitops/core/clustersmngr/fetcher"
+	"github.com/weaveworks/weave-gitops/core/history"
+	pb "github.com/weaveworks/weave-gitops/pkg/api/core"
+	"github.com/weaveworks/weave-gitops/pkg/kube"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	authorizationv1 "k8s.io/api/authorization/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientsetfake "k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestGetObjectHistory(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	ctx := context.Background()
+
+	scheme, err := kube.CreateScheme()
+	g.Expect(err).To(BeNil())
+
+	ns := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "flux-system"}}
+	other := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "other"}}
+	ks := newKustomization("podinfo", ns.Name, false, metav1.ConditionTrue)
+	ks.Status.LastAppliedRevision = "main@sha1:a"
+
+	k := fake.NewClientBuilder().WithScheme(scheme).WithRuntimeObjects(ns, other, ks).Build()
+
+	// the user can only read the kustomizations of flux-system
+	clientset := clientsetfake.NewSimpleClientset()
+	clientset.PrependReactor("create", "selfsubjectrulesreviews", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		review := action.(k8stesting.CreateAction).GetObject().(*authorizationv1.SelfSubjectRulesReview)
+		if review.Spec.Namespace == ns.Name {
+			review.Status.ResourceRules = []authorizationv1.ResourceRule{{
+				APIGroups: []string{"kustomize.toolkit.fluxcd.io"},
+				Resources: []string{"kustomizations"},
+				Verbs:     []string{"list"},
+			}}
+		}
+
+		return true, review, nil
+	})
+
+	cl := &clusterfakes.FakeCluster{}
+	cl.GetNameReturns("Default")
+	cl.GetUserClientsetReturns(clientset, nil)
+	cl.GetServerClientReturns(k, nil)
+	cl.GetUserClientReturns(k, nil)
+
+	cfg := makeServerConfig(k, t, "")
+	cfg.ClustersManager = clustersmngr.NewClustersManager([]clustersmngr.ClusterFetcher{fetcher.NewSingleClusterFetcher(cl)}, &nsChecker, logr.Discard())
+	cfg.HistoryRecorder = history.NewRecorder(logr.Discard(), history.NewMemoryStore(), cfg.ClustersManager, history.DefaultKinds, 0)
+
+	c := makeServer(cfg, t)
+
+	g.Expect(cfg.ClustersManager.UpdateClusters(ctx)).To(Succeed())
+	g.Expect(cfg.HistoryRecorder.Poll(ctx)).To(Succeed())
+
+	ks.Status.LastAppliedRevision = "main@sha1:b"
+	g.Expect(k.Update(ctx, ks)).To(Succeed())
+	g.Expect(cfg.HistoryRecorder.Poll(ctx)).To(Succeed())
+
+	md := metadata.Pairs(MetadataUserKey, "anne", MetadataGroupsKey, "system:masters")
+	outgoingCtx := metadata.NewOutgoingContext(ctx, md)
+
+	_, err = c.ToggleSuspendResource(outgoingCtx, &pb.ToggleSuspendResourceRequest{
+		Objects: []*pb.ObjectRef{{
+			Kind:        kustomizev1.KustomizationKind,
+			Name:        ks.Name,
+			Namespace:   ks.Namespace,
+			ClusterName: "Default",
+		}},
+		Suspend: true,
+	})
+	g.Expect(err).NotTo(HaveOccurred())
+
+	// The suspension made through the API isn't recorded twice.
+	g.Expect(cfg.HistoryRecorder.Poll(ctx)).To(Succeed())
+
+	req := &pb.GetObjectHistoryRequest{
+		Kind:        kustomizev1.KustomizationKind,
+		Name:        ks.Name,
+		Namespace:   ks.Namespace,
+		ClusterName: "Default",
+	}
+
+	res, err := c.GetObjectHistory(outgoingCtx, req)
+	g.Expect(err).NotTo(HaveOccurred())
+
+	types := []string{}
+	for _, e := range res.Events {
+		types = append(types, e.Type)
+	}
+
+	g.Expect(types).To(Equal([]string{history.EventSuspended, history.EventRevisionChanged}))
+	g.Expect(res.Events[0].Principal).To(Equal("anne"))
+	g.Expect(res.Events[1].Revision).To(Equal("main@sha1:b"))
+
+	req.Limit = 1
+	res, err = c.GetObjectHistory(outgoingCtx, req)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(res.Events).To(HaveLen(1))
+
+	// The timeline outlives the object.
+	g.Expect(k.Delete(ctx, ks)).To(Succeed())
+
+	res, err = c.GetObjectHistory(outgoingCtx, req)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(res.Events).To(HaveLen(1))
+
+	// The user can't read the kustomizations of the other namespace.
+	_, err = c.GetObjectHistory(outgoingCtx, &pb.GetObjectHistoryRequest{
+		Kind:        kustomizev1.KustomizationKind,
+		Name:        ks.Name,
+		Namespace:   other.Name,
+		ClusterName: "Default",
+	})
+	g.Expect(status.Code(err)).To(Equal(codes.PermissionDenied))
+
+	req.Since = "not a time"
+	_, err = c.GetObjectHistory(outgoingCtx, req)
+	g.Expect(status.Code(err)).To(Equal(codes.InvalidArgument))
+}
+
+func TestGetObjectHistoryDisabled(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	scheme, err := kube.CreateScheme()
+	g.Expect(err).To(BeNil())
+
+	fakeClient := fake.NewClientBuilder().WithScheme(scheme).Build()
+
+	c := makeServer(makeServerConfig(fakeClient, t, ""), t)
+
+	_, err = c.GetObjectHistory(context.Background(), &pb.GetObjectHistoryRequest{
+		Kind: kustomizev1.KustomizationKind,
+		Name: "podinfo",
+	})
+	g.Expect(status.Code(err)).To(Equal(codes.Unavailable))
+}
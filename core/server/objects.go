@@ -90,7 +90,7 @@ func (cs *coreServer) ListObjects(ctx context.Context, msg *pb.ListObjectsReques
 		}
 	}
 
-	var results []*pb.Object
+	var listed []clusteredObject
 
 	queriedNamespaces := clist.Namespaces()
 
@@ -102,56 +102,71 @@ func (cs *coreServer) ListObjects(ctx context.Context, msg *pb.ListObjectsReques
 			}
 
 			for _, unstructuredObj := range list.Items {
-				tenant := GetTenant(unstructuredObj.GetNamespace(), clusterName, queriedNamespaces)
-
-				var obj client.Object = &unstructuredObj
-
-				var inventory []*pb.GroupVersionKind = nil
-				var info string
-
-				switch gvk.Kind {
-				case "Secret":
-					obj, err = sanitizeSecret(&unstructuredObj)
-					if err != nil {
-						respErrors = append(respErrors, &pb.ListError{ClusterName: clusterName, Message: fmt.Sprintf("error sanitizing secrets: %v", err)})
-						continue
-					}
-				case helmv2.HelmReleaseKind:
-					inventory, err = getUnstructuredHelmReleaseInventory(ctx, unstructuredObj, clustersClient, clusterName)
-					if err != nil {
-						respErrors = append(respErrors, &pb.ListError{ClusterName: clusterName, Message: err.Error()})
-						inventory = nil // We can still display most things without inventory
-
-						cs.logger.V(logger.LogLevelDebug).Info("Couldn't grab inventory for helm release", "error", err)
-					}
-				case "StatefulSet":
-					clusterName, kind, err := parseSessionInfo(unstructuredObj)
-					if err != nil {
-						break
-					}
-
-					created, _ := cs.sessionObjectsCreated(ctx, clusterName, "flux-system", kind)
-
-					if created {
-						info = sessionObjectsInfo
-					}
-				}
+				listed = append(listed, clusteredObject{clusterName: clusterName, obj: unstructuredObj})
+			}
+		}
+	}
 
-				o, err := types.K8sObjectToProto(obj, clusterName, tenant, inventory, info)
-				if err != nil {
-					respErrors = append(respErrors, &pb.ListError{ClusterName: clusterName, Message: "converting items: " + err.Error()})
-					continue
-				}
+	page, total, nextPageToken, err := queryObjects(listed, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	var results []*pb.Object
+
+	for _, item := range page {
+		clusterName, unstructuredObj := item.clusterName, item.obj
+
+		tenant := GetTenant(unstructuredObj.GetNamespace(), clusterName, queriedNamespaces)
 
-				results = append(results, o)
+		var obj client.Object = &unstructuredObj
+
+		var inventory []*pb.GroupVersionKind = nil
+		var info string
+
+		switch gvk.Kind {
+		case "Secret":
+			obj, err = sanitizeSecret(&unstructuredObj)
+			if err != nil {
+				respErrors = append(respErrors, &pb.ListError{ClusterName: clusterName, Message: fmt.Sprintf("error sanitizing secrets: %v", err)})
+				continue
 			}
+		case helmv2.HelmReleaseKind:
+			inventory, err = getUnstructuredHelmReleaseInventory(ctx, unstructuredObj, clustersClient, clusterName)
+			if err != nil {
+				respErrors = append(respErrors, &pb.ListError{ClusterName: clusterName, Message: err.Error()})
+				inventory = nil // We can still display most things without inventory
+
+				cs.logger.V(logger.LogLevelDebug).Info("Couldn't grab inventory for helm release", "error", err)
+			}
+		case "StatefulSet":
+			clusterName, kind, err := parseSessionInfo(unstructuredObj)
+			if err != nil {
+				break
+			}
+
+			created, _ := cs.sessionObjectsCreated(ctx, clusterName, "flux-system", kind)
+
+			if created {
+				info = sessionObjectsInfo
+			}
+		}
+
+		o, err := types.K8sObjectToProto(obj, clusterName, tenant, inventory, info)
+		if err != nil {
+			respErrors = append(respErrors, &pb.ListError{ClusterName: clusterName, Message: "converting items: " + err.Error()})
+			continue
 		}
+
+		results = append(results, o)
 	}
 
 	return &pb.ListObjectsResponse{
 		Objects:            results,
 		Errors:             respErrors,
 		SearchedNamespaces: GetClusterUserNamespacesNames(queriedNamespaces),
+		NextPageToken:      nextPageToken,
+		Total:              int32(total),
 	}, nil
 }
 
@@ -0,0 +1,274 @@
+package server
+
+import (
+	"encoding/base64"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	pb "github.com/weaveworks/weave-gitops/pkg/api/core"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+const (
+	sortByName    = "name"
+	sortByAge     = "age"
+	sortByStatus  = "status"
+	sortByCluster = "cluster"
+
+	readyConditionType = "Ready"
+)
+
+// statusRank orders objects by how much attention they need, failures first.
+var statusRank = map[string]int{
+	"Failed":      0,
+	"Reconciling": 1,
+	"Suspended":   2,
+	"Ready":       3,
+	"Unknown":     4,
+}
+
+// clusteredObject is an object listed from a cluster, before it's converted
+// to its proto representation.
+type clusteredObject struct {
+	clusterName string
+	obj         unstructured.Unstructured
+}
+
+// queryObjects filters, sorts and paginates the objects as requested. It
+// returns the objects for the requested page, the total number of objects
+// matching the filters and the token for the next page.
+//
+// The page tokens are offsets in the sorted objects, not continue tokens:
+// the objects of all the clusters have to be listed to be sorted, so the
+// lists aren't paginated.
+func queryObjects(objects []clusteredObject, msg *pb.ListObjectsRequest) ([]clusteredObject, int, string, error) {
+	less, err := objectsLessFunc(msg.SortBy)
+	if err != nil {
+		return nil, 0, "", err
+	}
+
+	filtered := []clusteredObject{}
+
+	for _, o := range objects {
+		if matchesFilters(o.obj, msg.Filters) {
+			filtered = append(filtered, o)
+		}
+	}
+
+	sort.SliceStable(filtered, func(i, j int) bool {
+		if msg.SortDescending {
+			return less(filtered[j], filtered[i])
+		}
+
+		return less(filtered[i], filtered[j])
+	})
+
+	total := len(filtered)
+
+	if msg.Pagination == nil || msg.Pagination.PageSize <= 0 {
+		return filtered, total, "", nil
+	}
+
+	offset, err := decodePageToken(msg.Pagination.PageToken)
+	if err != nil {
+		return nil, 0, "", status.Errorf(codes.InvalidArgument, "invalid page token: %v", err)
+	}
+
+	if offset >= total {
+		return []clusteredObject{}, total, "", nil
+	}
+
+	end := offset + int(msg.Pagination.PageSize)
+	if end >= total {
+		return filtered[offset:], total, "", nil
+	}
+
+	return filtered[offset:end], total, encodePageToken(end), nil
+}
+
+func objectsLessFunc(sortBy string) (func(a, b clusteredObject) bool, error) {
+	byLocation := func(a, b clusteredObject) bool {
+		if a.clusterName != b.clusterName {
+			return a.clusterName < b.clusterName
+		}
+
+		if a.obj.GetNamespace() != b.obj.GetNamespace() {
+			return a.obj.GetNamespace() < b.obj.GetNamespace()
+		}
+
+		return a.obj.GetName() < b.obj.GetName()
+	}
+
+	switch strings.ToLower(sortBy) {
+	case "", sortByCluster:
+		return byLocation, nil
+	case sortByName:
+		return func(a, b clusteredObject) bool {
+			if a.obj.GetName() != b.obj.GetName() {
+				return a.obj.GetName() < b.obj.GetName()
+			}
+
+			return byLocation(a, b)
+		}, nil
+	case sortByAge:
+		// Youngest first, like sorting by the age column of a table.
+		return func(a, b clusteredObject) bool {
+			at, bt := a.obj.GetCreationTimestamp(), b.obj.GetCreationTimestamp()
+			if !at.Equal(&bt) {
+				return bt.Before(&at)
+			}
+
+			return byLocation(a, b)
+		}, nil
+	case sortByStatus:
+		return func(a, b clusteredObject) bool {
+			ar, br := statusRank[objectStatus(a.obj)], statusRank[objectStatus(b.obj)]
+			if ar != br {
+				return ar < br
+			}
+
+			return byLocation(a, b)
+		}, nil
+	}
+
+	return nil, status.Errorf(codes.InvalidArgument, "unsupported sortBy %q, must be one of %s, %s, %s or %s", sortBy, sortByName, sortByAge, sortByStatus, sortByCluster)
+}
+
+func matchesFilters(obj unstructured.Unstructured, filters *pb.ListObjectsFilters) bool {
+	if filters == nil {
+		return true
+	}
+
+	if filters.Ready != "" && !strings.EqualFold(filters.Ready, readyConditionStatus(obj)) {
+		return false
+	}
+
+	if filters.Suspended != "" {
+		suspended, _, _ := unstructured.NestedBool(obj.Object, "spec", "suspend")
+		if !strings.EqualFold(filters.Suspended, strconv.FormatBool(suspended)) {
+			return false
+		}
+	}
+
+	if ref := filters.SourceRef; ref != nil {
+		sourceRef, found := objectSourceRef(obj)
+		if !found {
+			return false
+		}
+
+		if ref.Kind != "" && ref.Kind != sourceRef.Kind ||
+			ref.Name != "" && ref.Name != sourceRef.Name ||
+			ref.Namespace != "" && ref.Namespace != sourceRef.Namespace {
+			return false
+		}
+	}
+
+	return true
+}
+
+// objectStatus summarises the state of a Flux object the same way the UI
+// does: suspended objects first, then the status of the Ready condition.
+func objectStatus(obj unstructured.Unstructured) string {
+	if suspended, _, _ := unstructured.NestedBool(obj.Object, "spec", "suspend"); suspended {
+		return "Suspended"
+	}
+
+	switch readyConditionStatus(obj) {
+	case "True":
+		return "Ready"
+	case "False":
+		return "Failed"
+	}
+
+	if _, found := readyCondition(obj); found {
+		return "Reconciling"
+	}
+
+	return "Unknown"
+}
+
+func readyConditionStatus(obj unstructured.Unstructured) string {
+	cond, found := readyCondition(obj)
+	if !found {
+		return "Unknown"
+	}
+
+	status, _, _ := unstructured.NestedString(cond, "status")
+	if status == "" {
+		return "Unknown"
+	}
+
+	return status
+}
+
+func readyCondition(obj unstructured.Unstructured) (map[string]interface{}, bool) {
+	conditions, _, _ := unstructured.NestedSlice(obj.Object, "status", "conditions")
+
+	for _, c := range conditions {
+		cond, ok := c.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		if cond["type"] == readyConditionType {
+			return cond, true
+		}
+	}
+
+	return nil, false
+}
+
+// objectSourceRef returns the source referenced by a Kustomization
+// (spec.sourceRef) or a HelmRelease (spec.chart.spec.sourceRef). An empty
+// namespace defaults to the namespace of the object.
+func objectSourceRef(obj unstructured.Unstructured) (*pb.ObjectRef, bool) {
+	ref, found, _ := unstructured.NestedStringMap(obj.Object, "spec", "sourceRef")
+	if !found {
+		ref, found, _ = unstructured.NestedStringMap(obj.Object, "spec", "chart", "spec", "sourceRef")
+	}
+
+	if !found {
+		return nil, false
+	}
+
+	namespace := ref["namespace"]
+	if namespace == "" {
+		namespace = obj.GetNamespace()
+	}
+
+	return &pb.ObjectRef{
+		Kind:      ref["kind"],
+		Name:      ref["name"],
+		Namespace: namespace,
+	}, true
+}
+
+func encodePageToken(offset int) string {
+	return base64.StdEncoding.EncodeToString([]byte(strconv.Itoa(offset)))
+}
+
+func decodePageToken(token string) (int, error) {
+	if token == "" {
+		return 0, nil
+	}
+
+	decoded, err := base64.StdEncoding.DecodeString(token)
+	if err != nil {
+		return 0, err
+	}
+
+	offset, err := strconv.Atoi(string(decoded))
+	if err != nil {
+		return 0, err
+	}
+
+	if offset < 0 {
+		return 0, fmt.Errorf("negative offset %d", offset)
+	}
+
+	return offset, nil
+}
@@ -21,6 +21,7 @@ import (
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 )
@@ -827,6 +828,168 @@ func TestListObjectsLabels(t *testing.T) {
 	g.Expect(data["metadata"].(map[string]interface{})["name"]).To(Equal(deployment1.Name))
 }
 
+func TestListObjectsPagination(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	ns := &corev1.Namespace{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "test-namespace",
+		},
+	}
+
+	objects := []runtime.Object{ns}
+	for _, name := range []string{"kust-c", "kust-a", "kust-e", "kust-b", "kust-d"} {
+		objects = append(objects, newKustomization(name, ns.Name, false, metav1.ConditionTrue))
+	}
+
+	ctx := context.Background()
+
+	scheme, err := kube.CreateScheme()
+	g.Expect(err).To(BeNil())
+
+	fakeClient := fake.NewClientBuilder().WithScheme(scheme).WithRuntimeObjects(objects...).Build()
+
+	cfg := makeServerConfig(fakeClient, t, "")
+	c := makeServer(cfg, t)
+
+	var (
+		names     []string
+		pageToken string
+	)
+
+	for i := 0; i < 3; i++ {
+		res, err := c.ListObjects(ctx, &pb.ListObjectsRequest{
+			Kind:       kustomizev1.KustomizationKind,
+			Pagination: &pb.Pagination{PageSize: 2, PageToken: pageToken},
+		})
+		g.Expect(err).NotTo(HaveOccurred())
+		g.Expect(res.Total).To(Equal(int32(5)))
+
+		names = append(names, objectNames(g, res.Objects)...)
+		pageToken = res.NextPageToken
+	}
+
+	g.Expect(pageToken).To(BeEmpty())
+	g.Expect(names).To(Equal([]string{"kust-a", "kust-b", "kust-c", "kust-d", "kust-e"}))
+
+	_, err = c.ListObjects(ctx, &pb.ListObjectsRequest{
+		Kind:       kustomizev1.KustomizationKind,
+		Pagination: &pb.Pagination{PageSize: 2, PageToken: "not a token"},
+	})
+	g.Expect(err).To(HaveOccurred())
+}
+
+func TestListObjectsSortingAndFilters(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	ns := &corev1.Namespace{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "test-namespace",
+		},
+	}
+
+	ready := newKustomization("ready", ns.Name, false, metav1.ConditionTrue)
+	failed := newKustomization("failed", ns.Name, false, metav1.ConditionFalse)
+	suspended := newKustomization("suspended", ns.Name, true, metav1.ConditionTrue)
+	suspended.Spec.SourceRef.Name = "other-repo"
+
+	ctx := context.Background()
+
+	scheme, err := kube.CreateScheme()
+	g.Expect(err).To(BeNil())
+
+	fakeClient := fake.NewClientBuilder().WithScheme(scheme).WithRuntimeObjects(ns, ready, failed, suspended).Build()
+
+	cfg := makeServerConfig(fakeClient, t, "")
+	c := makeServer(cfg, t)
+
+	tests := []struct {
+		name     string
+		request  *pb.ListObjectsRequest
+		expected []string
+	}{
+		{
+			name:     "sort by name descending",
+			request:  &pb.ListObjectsRequest{SortBy: "name", SortDescending: true},
+			expected: []string{"suspended", "ready", "failed"},
+		},
+		{
+			name:     "sort by status",
+			request:  &pb.ListObjectsRequest{SortBy: "status"},
+			expected: []string{"failed", "suspended", "ready"},
+		},
+		{
+			name:     "filter by ready status",
+			request:  &pb.ListObjectsRequest{Filters: &pb.ListObjectsFilters{Ready: "True"}},
+			expected: []string{"ready", "suspended"},
+		},
+		{
+			name:     "filter by suspended",
+			request:  &pb.ListObjectsRequest{Filters: &pb.ListObjectsFilters{Suspended: "true"}},
+			expected: []string{"suspended"},
+		},
+		{
+			name: "filter by source",
+			request: &pb.ListObjectsRequest{Filters: &pb.ListObjectsFilters{
+				SourceRef: &pb.ObjectRef{Kind: sourcev1.GitRepositoryKind, Name: "my-repo", Namespace: ns.Name},
+			}},
+			expected: []string{"failed", "ready"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewGomegaWithT(t)
+
+			tt.request.Kind = kustomizev1.KustomizationKind
+
+			res, err := c.ListObjects(ctx, tt.request)
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(objectNames(g, res.Objects)).To(Equal(tt.expected))
+		})
+	}
+
+	_, err = c.ListObjects(ctx, &pb.ListObjectsRequest{
+		Kind:   kustomizev1.KustomizationKind,
+		SortBy: "colour",
+	})
+	g.Expect(err).To(HaveOccurred())
+}
+
+func newKustomization(name, namespace string, suspend bool, ready metav1.ConditionStatus) *kustomizev1.Kustomization {
+	return &kustomizev1.Kustomization{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: namespace,
+		},
+		Spec: kustomizev1.KustomizationSpec{
+			Suspend: suspend,
+			SourceRef: kustomizev1.CrossNamespaceSourceReference{
+				Kind: sourcev1.GitRepositoryKind,
+				Name: "my-repo",
+			},
+		},
+		Status: kustomizev1.KustomizationStatus{
+			Conditions: []metav1.Condition{
+				{Type: "Ready", Status: ready},
+			},
+		},
+	}
+}
+
+func objectNames(g *WithT, objects []*pb.Object) []string {
+	names := []string{}
+
+	for _, o := range objects {
+		var data map[string]interface{}
+		g.Expect(json.Unmarshal([]byte(o.Payload), &data)).To(Succeed())
+
+		names = append(names, data["metadata"].(map[string]interface{})["name"].(string))
+	}
+
+	return names
+}
+
 func TestListObjectsGitOpsRunSessions(t *testing.T) {
 	g := NewGomegaWithT(t)
 
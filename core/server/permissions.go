@@ -0,0 +1,183 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	helmv2 "github.com/fluxcd/helm-controller/api/v2beta2"
+	imgautomationv1 "github.com/fluxcd/image-automation-controller/api/v1beta1"
+	reflectorv1 "github.com/fluxcd/image-reflector-controller/api/v1beta2"
+	kustomizev1 "github.com/fluxcd/kustomize-controller/api/v1"
+	notificationv1 "github.com/fluxcd/notification-controller/api/v1"
+	notificationv1b2 "github.com/fluxcd/notification-controller/api/v1beta2"
+	sourcev1 "github.com/fluxcd/source-controller/api/v1"
+	sourcev1b2 "github.com/fluxcd/source-controller/api/v1beta2"
+	"github.com/weaveworks/weave-gitops/core/clustersmngr/cluster"
+	pb "github.com/weaveworks/weave-gitops/pkg/api/core"
+	"github.com/weaveworks/weave-gitops/pkg/server/auth"
+	v1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// userPermissionsTTL is how long what users can do in a namespace is cached.
+const userPermissionsTTL = 30 * time.Second
+
+// permissionKinds are the Flux kinds the users' permissions are reported for,
+// with the resources RBAC rules refer to them by.
+var permissionKinds = []struct {
+	kind     string
+	group    string
+	resource string
+}{
+	{kustomizev1.KustomizationKind, kustomizev1.GroupVersion.Group, "kustomizations"},
+	{helmv2.HelmReleaseKind, helmv2.GroupVersion.Group, "helmreleases"},
+	{sourcev1.GitRepositoryKind, sourcev1.GroupVersion.Group, "gitrepositories"},
+	{sourcev1b2.OCIRepositoryKind, sourcev1b2.GroupVersion.Group, "ocirepositories"},
+	{sourcev1b2.BucketKind, sourcev1b2.GroupVersion.Group, "buckets"},
+	{sourcev1b2.HelmRepositoryKind, sourcev1b2.GroupVersion.Group, "helmrepositories"},
+	{sourcev1b2.HelmChartKind, sourcev1b2.GroupVersion.Group, "helmcharts"},
+	{reflectorv1.ImageRepositoryKind, reflectorv1.GroupVersion.Group, "imagerepositories"},
+	{reflectorv1.ImagePolicyKind, reflectorv1.GroupVersion.Group, "imagepolicies"},
+	{imgautomationv1.ImageUpdateAutomationKind, imgautomationv1.GroupVersion.Group, "imageupdateautomations"},
+	{notificationv1b2.AlertKind, notificationv1b2.GroupVersion.Group, "alerts"},
+	{notificationv1b2.ProviderKind, notificationv1b2.GroupVersion.Group, "providers"},
+	{notificationv1.ReceiverKind, notificationv1.GroupVersion.Group, "receivers"},
+}
+
+// GetUserPermissions returns what the user can do with each Flux kind, in the
+// namespaces of each cluster where they can do anything with them. Unlike the
+// namespaces the user can access, this doesn't require a fixed set of
+// permissions, so users only allowed to read some kinds see those.
+func (cs *coreServer) GetUserPermissions(ctx context.Context, msg *pb.GetUserPermissionsRequest) (*pb.GetUserPermissionsResponse, error) {
+	principal := auth.Principal(ctx)
+	if principal == nil {
+		return nil, errors.New("no user supplied")
+	}
+
+	clustersNamespaces := cs.clustersManager.GetClustersNamespaces()
+
+	var (
+		mu          sync.Mutex
+		wg          sync.WaitGroup
+		permissions = []*pb.NamespacePermissions{}
+		respErrors  = []*pb.ListError{}
+	)
+
+	for _, cl := range cs.clustersManager.GetClusters() {
+		if msg.ClusterName != "" && cl.GetName() != msg.ClusterName {
+			continue
+		}
+
+		if !principal.AllowsCluster(cl.GetName()) {
+			continue
+		}
+
+		namespaces, found := clustersNamespaces[cl.GetName()]
+		if !found {
+			// the namespaces are looked up with the user client
+			namespaces = cs.clustersManager.GetUserNamespaces(principal)[cl.GetName()]
+		}
+
+		if msg.Namespace != "" {
+			namespaces = []v1.Namespace{{ObjectMeta: metav1.ObjectMeta{Name: msg.Namespace}}}
+		}
+
+		wg.Add(1)
+
+		go func(cl cluster.Cluster, namespaces []v1.Namespace) {
+			defer wg.Done()
+
+			clusterPermissions, err := cs.clusterPermissions(ctx, principal, cl, namespaces)
+
+			mu.Lock()
+			defer mu.Unlock()
+
+			if err != nil {
+				respErrors = append(respErrors, &pb.ListError{ClusterName: cl.GetName(), Message: err.Error()})
+				return
+			}
+
+			permissions = append(permissions, clusterPermissions...)
+		}(cl, namespaces)
+	}
+
+	wg.Wait()
+
+	sort.Slice(permissions, func(i, j int) bool {
+		if permissions[i].ClusterName != permissions[j].ClusterName {
+			return permissions[i].ClusterName < permissions[j].ClusterName
+		}
+
+		return permissions[i].Namespace < permissions[j].Namespace
+	})
+
+	return &pb.GetUserPermissionsResponse{
+		Permissions: permissions,
+		Errors:      respErrors,
+	}, nil
+}
+
+// clusterPermissions returns the user's permissions in the namespaces of the
+// cluster where they can do anything with the Flux kinds.
+func (cs *coreServer) clusterPermissions(ctx context.Context, user *auth.UserPrincipal, cl cluster.Cluster, namespaces []v1.Namespace) ([]*pb.NamespacePermissions, error) {
+	clientset, err := cl.GetUserClientset(user)
+	if err != nil {
+		return nil, fmt.Errorf("error creating client for cluster: %w", err)
+	}
+
+	authClient := clientset.AuthorizationV1()
+	cacheKey := fmt.Sprintf("%s/%s", cl.GetName(), user.Hash())
+
+	result := []*pb.NamespacePermissions{}
+
+	for _, ns := range namespaces {
+		nsPermissions := &pb.NamespacePermissions{
+			ClusterName: cl.GetName(),
+			Namespace:   ns.Name,
+		}
+
+		allowed := false
+
+		for _, k := range permissionKinds {
+			kindPermissions := &pb.KindPermissions{Kind: k.kind}
+
+			for verb, permission := range map[string]*bool{
+				"list":  &kindPermissions.List,
+				"get":   &kindPermissions.Get,
+				"patch": &kindPermissions.Patch,
+			} {
+				rule := rbacv1.PolicyRule{
+					APIGroups: []string{k.group},
+					Resources: []string{k.resource},
+					Verbs:     []string{verb},
+				}
+
+				ok, err := cs.rulesReviewer.Can(ctx, authClient, cacheKey, ns.Name, rule)
+				if err != nil {
+					return nil, err
+				}
+
+				// read-only users can't patch anything whatever their RBAC
+				if verb == "patch" && user.ReadOnly {
+					ok = false
+				}
+
+				*permission = ok
+				allowed = allowed || ok
+			}
+
+			nsPermissions.Kinds = append(nsPermissions.Kinds, kindPermissions)
+		}
+
+		if allowed {
+			result = append(result, nsPermissions)
+		}
+	}
+
+	return result, nil
+}
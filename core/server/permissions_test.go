@@ -0,0 +1,120 @@
+package server_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/gomega"
+	"github.com/weaveworks/weave-gitops/core/clustersmngr"
+	"github.com/weaveworks/weave-gitops/core/clustersmngr/cluster/clusterfakes"
+	"github.com/weaveworks/weave-gitops/core/clustersmngr/fetcher"
+	pb "github.com/weaveworks/weave-gitops/pkg/api/core"
+	"github.com/weaveworks/weave-gitops/pkg/kube"
+	"google.golang.org/grpc/metadata"
+	authorizationv1 "k8s.io/api/authorization/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestGetUserPermissions(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	ctx := context.Background()
+
+	scheme, err := kube.CreateScheme()
+	g.Expect(err).To(BeNil())
+
+	k := fakeclient.NewClientBuilder().WithScheme(scheme).WithObjects(
+		&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "team-a"}},
+		&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "team-b"}},
+		&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "team-c"}},
+	).Build()
+
+	// the user can read and sync the kustomizations of team-a, and only read
+	// the helm releases of team-b
+	rules := map[string][]authorizationv1.ResourceRule{
+		"team-a": {{
+			APIGroups: []string{"kustomize.toolkit.fluxcd.io"},
+			Resources: []string{"kustomizations"},
+			Verbs:     []string{"get", "list", "patch"},
+		}},
+		"team-b": {{
+			APIGroups: []string{"helm.toolkit.fluxcd.io"},
+			Resources: []string{"*"},
+			Verbs:     []string{"get", "list"},
+		}},
+	}
+
+	clientset := fake.NewSimpleClientset()
+	clientset.PrependReactor("create", "selfsubjectrulesreviews", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		review := action.(k8stesting.CreateAction).GetObject().(*authorizationv1.SelfSubjectRulesReview)
+		review.Status.ResourceRules = rules[review.Spec.Namespace]
+
+		return true, review, nil
+	})
+
+	cl := &clusterfakes.FakeCluster{}
+	cl.GetNameReturns("Default")
+	cl.GetServerClientsetReturns(clientset, nil)
+	cl.GetUserClientsetReturns(clientset, nil)
+	cl.GetServerClientReturns(k, nil)
+	cl.GetUserClientReturns(k, nil)
+
+	cfg := makeServerConfig(k, t, "")
+	cfg.ClustersManager = clustersmngr.NewClustersManager([]clustersmngr.ClusterFetcher{fetcher.NewSingleClusterFetcher(cl)}, &nsChecker, logr.Discard())
+	g.Expect(cfg.ClustersManager.UpdateClusters(ctx)).To(Succeed())
+	g.Expect(cfg.ClustersManager.UpdateNamespaces(ctx)).To(Succeed())
+
+	c := makeServer(cfg, t)
+
+	md := metadata.Pairs(MetadataUserKey, "anne", MetadataGroupsKey, "developers")
+	outgoingCtx := metadata.NewOutgoingContext(ctx, md)
+
+	kindPermissions := func(ns *pb.NamespacePermissions, kind string) *pb.KindPermissions {
+		for _, k := range ns.Kinds {
+			if k.Kind == kind {
+				return k
+			}
+		}
+
+		return nil
+	}
+
+	t.Run("returns the permissions of each kind in the namespaces the user can use", func(t *testing.T) {
+		g := NewGomegaWithT(t)
+
+		res, err := c.GetUserPermissions(outgoingCtx, &pb.GetUserPermissionsRequest{})
+		g.Expect(err).NotTo(HaveOccurred())
+		g.Expect(res.Errors).To(BeEmpty())
+		g.Expect(res.Permissions).To(HaveLen(2), "team-c is left out")
+
+		teamA := res.Permissions[0]
+		g.Expect(teamA.ClusterName).To(Equal("Default"))
+		g.Expect(teamA.Namespace).To(Equal("team-a"))
+		g.Expect(kindPermissions(teamA, "Kustomization")).To(Equal(&pb.KindPermissions{Kind: "Kustomization", List: true, Get: true, Patch: true}))
+		g.Expect(kindPermissions(teamA, "HelmRelease")).To(Equal(&pb.KindPermissions{Kind: "HelmRelease"}))
+
+		teamB := res.Permissions[1]
+		g.Expect(teamB.Namespace).To(Equal("team-b"))
+		g.Expect(kindPermissions(teamB, "HelmRelease")).To(Equal(&pb.KindPermissions{Kind: "HelmRelease", List: true, Get: true}))
+		g.Expect(kindPermissions(teamB, "Kustomization")).To(Equal(&pb.KindPermissions{Kind: "Kustomization"}))
+	})
+
+	t.Run("filters by cluster and namespace", func(t *testing.T) {
+		g := NewGomegaWithT(t)
+
+		res, err := c.GetUserPermissions(outgoingCtx, &pb.GetUserPermissionsRequest{ClusterName: "Default", Namespace: "team-b"})
+		g.Expect(err).NotTo(HaveOccurred())
+		g.Expect(res.Permissions).To(HaveLen(1))
+		g.Expect(res.Permissions[0].Namespace).To(Equal("team-b"))
+
+		res, err = c.GetUserPermissions(outgoingCtx, &pb.GetUserPermissionsRequest{ClusterName: "other"})
+		g.Expect(err).NotTo(HaveOccurred())
+		g.Expect(res.Permissions).To(BeEmpty())
+	})
+}
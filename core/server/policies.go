@@ -2,21 +2,17 @@ package server
 
 import (
 	"context"
-	"encoding/json"
 	"errors"
 	"fmt"
-	"strconv"
 	"strings"
 	"time"
 
-	"github.com/golang/protobuf/ptypes/any"
 	"github.com/hashicorp/go-multierror"
 	pacv2beta2 "github.com/weaveworks/policy-agent/api/v2beta2"
 	"github.com/weaveworks/weave-gitops/core/clustersmngr"
 	pb "github.com/weaveworks/weave-gitops/pkg/api/core"
+	"github.com/weaveworks/weave-gitops/pkg/policies"
 	"github.com/weaveworks/weave-gitops/pkg/server/auth"
-	"google.golang.org/protobuf/types/known/anypb"
-	"google.golang.org/protobuf/types/known/wrapperspb"
 	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
@@ -25,58 +21,6 @@ const (
 	DefaultCluster = "Default"
 )
 
-func getPolicyParamValue(param pacv2beta2.PolicyParameters, policyID string) (*anypb.Any, error) {
-	if param.Value == nil {
-		return nil, nil
-	}
-	var anyValue *any.Any
-	var err error
-	switch param.Type {
-	case "string":
-		var strValue string
-		// attempt to clean up extra quotes if not successful show as is
-		unquotedValue, UnquoteErr := strconv.Unquote(string(param.Value.Raw))
-		if UnquoteErr != nil {
-			strValue = string(param.Value.Raw)
-		} else {
-			strValue = unquotedValue
-		}
-		value := wrapperspb.String(strValue)
-		anyValue, err = anypb.New(value)
-	case "integer":
-		intValue, convErr := strconv.Atoi(string(param.Value.Raw))
-		if convErr != nil {
-			err = convErr
-			break
-		}
-		value := wrapperspb.Int32(int32(intValue))
-		anyValue, err = anypb.New(value)
-	case "boolean":
-		boolValue, convErr := strconv.ParseBool(string(param.Value.Raw))
-		if convErr != nil {
-			err = convErr
-			break
-		}
-		value := wrapperspb.Bool(boolValue)
-		anyValue, err = anypb.New(value)
-	case "array":
-		var arrayValue []string
-		convErr := json.Unmarshal(param.Value.Raw, &arrayValue)
-		if convErr != nil {
-			err = convErr
-			break
-		}
-		value := &pb.PolicyParamRepeatedString{Value: arrayValue}
-		anyValue, err = anypb.New(value)
-	default:
-		return nil, fmt.Errorf("found unsupported policy parameter type %s in policy %s", param.Type, policyID)
-	}
-	if err != nil {
-		return nil, fmt.Errorf("failed to serialize parameter value %s in policy %s: %w", param.Name, policyID, err)
-	}
-	return anyValue, nil
-}
-
 func policyToPolicyRespone(policyCRD pacv2beta2.Policy, clusterName string) (*pb.PolicyObj, error) {
 	policySpec := policyCRD.Spec
 
@@ -109,7 +53,7 @@ func policyToPolicyRespone(policyCRD pacv2beta2.Policy, clusterName string) (*pb
 			Required: param.Required,
 			Type:     param.Type,
 		}
-		value, err := getPolicyParamValue(param, policySpec.ID)
+		value, err := policies.ParameterAny(param, policySpec.ID)
 		if err != nil {
 			return nil, err
 		}
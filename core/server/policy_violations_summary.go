@@ -0,0 +1,219 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-multierror"
+	pacv2beta2 "github.com/weaveworks/policy-agent/api/v2beta2"
+	"github.com/weaveworks/weave-gitops/core/clustersmngr"
+	"github.com/weaveworks/weave-gitops/core/violations"
+	pb "github.com/weaveworks/weave-gitops/pkg/api/core"
+	"github.com/weaveworks/weave-gitops/pkg/server/auth"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	defaultSummaryPeriod = 7 * 24 * time.Hour
+	defaultSummaryWindow = 24 * time.Hour
+)
+
+func (cs *coreServer) GetPolicyValidationsSummary(ctx context.Context, msg *pb.GetPolicyValidationsSummaryRequest) (*pb.GetPolicyValidationsSummaryResponse, error) {
+	if cs.violations == nil {
+		return nil, status.Error(codes.Unavailable, "the policy validations summary is not enabled")
+	}
+
+	opts := violations.SummaryOptions{
+		Window:  defaultSummaryWindow,
+		GroupBy: msg.GroupBy,
+	}
+
+	var err error
+
+	if opts.Until, err = parseHistoryTime(msg.Until); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid until: %v", err)
+	}
+
+	if opts.Until.IsZero() {
+		opts.Until = time.Now().UTC()
+	}
+
+	if opts.Since, err = parseHistoryTime(msg.Since); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid since: %v", err)
+	}
+
+	if opts.Since.IsZero() {
+		opts.Since = opts.Until.Add(-defaultSummaryPeriod)
+	}
+
+	if msg.Window != "" {
+		if opts.Window, err = time.ParseDuration(msg.Window); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid window: %v", err)
+		}
+	}
+
+	if err := violations.ValidateDimensions(msg.GroupBy); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	respErrors := []*pb.ListError{}
+
+	principal := auth.Principal(ctx)
+
+	clustersClient, err := cs.clustersManager.GetImpersonatedClient(ctx, principal)
+	if err != nil {
+		if merr, ok := err.(*multierror.Error); ok {
+			for _, err := range merr.Errors {
+				if cerr, ok := err.(*clustersmngr.ClientError); ok {
+					respErrors = append(respErrors, &pb.ListError{ClusterName: cerr.ClusterName, Message: cerr.Error()})
+				}
+			}
+		} else {
+			return nil, fmt.Errorf("unexpected error while getting clusters client, error: %w", err)
+		}
+	}
+
+	recorded, err := cs.violations.List(ctx, violations.Query{
+		ClusterName: msg.ClusterName,
+		Type:        msg.ValidationType,
+		Since:       opts.Since,
+		Until:       opts.Until,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("listing policy violations: %w", err)
+	}
+
+	// The violations are recorded with the server's permissions, the users
+	// only see those whose events they can read.
+	accessible := map[string]map[string]bool{}
+
+	for clusterName, namespaces := range cs.clustersManager.GetUserNamespaces(principal) {
+		if !principal.AllowsCluster(clusterName) {
+			continue
+		}
+
+		accessible[clusterName] = map[string]bool{}
+
+		for _, ns := range namespaces {
+			accessible[clusterName][ns.Name] = true
+		}
+	}
+
+	var visible []violations.Violation
+
+	for _, v := range recorded {
+		if accessible[v.ClusterName][v.EventNamespace] {
+			visible = append(visible, v)
+		}
+	}
+
+	policies, policiesErrors := cs.summaryPolicies(ctx, clustersClient, msg.ClusterName)
+	respErrors = append(respErrors, policiesErrors...)
+	opts.Policies = policies
+
+	summary, err := violations.Summarise(visible, opts)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	res := &pb.GetPolicyValidationsSummaryResponse{
+		Total:   int32(summary.Total),
+		Windows: windowsToProto(summary.Windows),
+		Errors:  respErrors,
+	}
+
+	for _, g := range summary.Groupings {
+		grouping := &pb.PolicyValidationsGrouping{Dimension: g.Dimension}
+
+		for _, group := range g.Groups {
+			grouping.Groups = append(grouping.Groups, &pb.PolicyValidationsGroup{
+				Value:   group.Value,
+				Name:    group.Name,
+				Total:   int32(group.Total),
+				Windows: windowsToProto(group.Windows),
+			})
+		}
+
+		res.Groupings = append(res.Groupings, grouping)
+	}
+
+	for _, c := range summary.Compliance {
+		res.Applications = append(res.Applications, &pb.ApplicationCompliance{
+			ClusterName:      c.ClusterName,
+			Kind:             c.Application.Kind,
+			Namespace:        c.Application.Namespace,
+			Name:             c.Application.Name,
+			Score:            c.Score,
+			Violations:       int32(c.Violations),
+			ViolatedPolicies: c.ViolatedPolicies,
+		})
+	}
+
+	return res, nil
+}
+
+// summaryPolicies returns the policies of the clusters the user can read,
+// which the compliance of the applications is computed against.
+func (cs *coreServer) summaryPolicies(ctx context.Context, clustersClient clustersmngr.Client, clusterName string) (map[string][]violations.Policy, []*pb.ListError) {
+	respErrors := []*pb.ListError{}
+
+	clist := clustersmngr.NewClusteredList(func() client.ObjectList {
+		return &pacv2beta2.PolicyList{}
+	})
+
+	if err := clustersClient.ClusteredList(ctx, clist, false); err != nil {
+		var errs clustersmngr.ClusteredListError
+		if !errors.As(err, &errs) {
+			return nil, append(respErrors, &pb.ListError{ClusterName: clusterName, Message: err.Error()})
+		}
+
+		for _, e := range errs.Errors {
+			if !strings.Contains(e.Err.Error(), "no matches for kind \"Policy\"") {
+				respErrors = append(respErrors, &pb.ListError{ClusterName: e.Cluster, Message: e.Err.Error()})
+			}
+		}
+	}
+
+	policies := map[string][]violations.Policy{}
+
+	for listClusterName, lists := range clist.Lists() {
+		if clusterName != "" && listClusterName != clusterName {
+			continue
+		}
+
+		for _, l := range lists {
+			list, ok := l.(*pacv2beta2.PolicyList)
+			if !ok {
+				continue
+			}
+
+			for _, p := range list.Items {
+				policies[listClusterName] = append(policies[listClusterName], violations.Policy{
+					ID:       p.Spec.ID,
+					Severity: p.Spec.Severity,
+				})
+			}
+		}
+	}
+
+	return policies, respErrors
+}
+
+func windowsToProto(windows []violations.Window) []*pb.PolicyValidationsWindow {
+	result := []*pb.PolicyValidationsWindow{}
+
+	for _, w := range windows {
+		result = append(result, &pb.PolicyValidationsWindow{
+			Start: w.Start.Format(time.RFC3339),
+			End:   w.End.Format(time.RFC3339),
+			Count: int32(w.Count),
+		})
+	}
+
+	return result
+}
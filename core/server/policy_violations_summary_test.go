@@ -0,0 +1,160 @@
+package server_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	pacv2beta2 "github.com/weaveworks/policy-agent/api/v2beta2"
+	"github.com/weaveworks/weave-gitops/core/violations"
+	pb "github.com/weaveworks/weave-gitops/pkg/api/core"
+	"github.com/weaveworks/weave-gitops/pkg/kube"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestGetPolicyValidationsSummary(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	ctx := context.Background()
+
+	scheme, err := kube.CreateScheme()
+	g.Expect(err).To(BeNil())
+
+	ns := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "default"}}
+	policy := &pacv2beta2.Policy{
+		ObjectMeta: metav1.ObjectMeta{Name: "weave.policies.containers-minimum-replica-count"},
+		Spec: pacv2beta2.PolicySpec{
+			Name:     "Containers Minimum Replica Count",
+			ID:       "weave.policies.containers-minimum-replica-count",
+			Severity: "medium",
+		},
+	}
+	other := &pacv2beta2.Policy{
+		ObjectMeta: metav1.ObjectMeta{Name: "weave.policies.missing-owner-label"},
+		Spec: pacv2beta2.PolicySpec{
+			Name:     "Missing Owner Label",
+			ID:       "weave.policies.missing-owner-label",
+			Severity: "low",
+		},
+	}
+
+	fakeClient := fake.NewClientBuilder().WithScheme(scheme).WithRuntimeObjects(ns, policy, other).Build()
+
+	until := time.Now().UTC().Truncate(time.Hour)
+	app := violations.ApplicationRef{Kind: "Kustomization", Namespace: "flux-system", Name: "apps"}
+
+	store := violations.NewMemoryStore()
+	g.Expect(store.Record(ctx, []violations.Violation{
+		{
+			ID:             "a",
+			Type:           violations.TypeAudit,
+			ClusterName:    "Default",
+			EventNamespace: "default",
+			PolicyID:       policy.Spec.ID,
+			PolicyName:     policy.Spec.Name,
+			Severity:       policy.Spec.Severity,
+			Namespace:      "default",
+			Application:    app,
+			Timestamp:      until.Add(-90 * time.Minute),
+		},
+		{
+			ID:             "b",
+			Type:           violations.TypeAdmission,
+			ClusterName:    "Default",
+			EventNamespace: "default",
+			PolicyID:       policy.Spec.ID,
+			PolicyName:     policy.Spec.Name,
+			Severity:       policy.Spec.Severity,
+			Namespace:      "default",
+			Application:    app,
+			Timestamp:      until.Add(-30 * time.Minute),
+		},
+		// The events of the namespace can't be read by the user.
+		{
+			ID:             "c",
+			Type:           violations.TypeAudit,
+			ClusterName:    "Default",
+			EventNamespace: "hidden",
+			PolicyID:       policy.Spec.ID,
+			Timestamp:      until.Add(-30 * time.Minute),
+		},
+	})).To(Succeed())
+
+	cfg := makeServerConfig(fakeClient, t, "")
+	cfg.PolicyViolations = store
+
+	c := makeServer(cfg, t)
+
+	g.Expect(cfg.ClustersManager.UpdateClusters(ctx)).To(Succeed())
+
+	md := metadata.Pairs(MetadataUserKey, "anne", MetadataGroupsKey, "system:masters")
+	outgoingCtx := metadata.NewOutgoingContext(ctx, md)
+
+	req := &pb.GetPolicyValidationsSummaryRequest{
+		Since:   until.Add(-2 * time.Hour).Format(time.RFC3339),
+		Until:   until.Format(time.RFC3339),
+		Window:  "1h",
+		GroupBy: []string{violations.ByPolicy},
+	}
+
+	res, err := c.GetPolicyValidationsSummary(outgoingCtx, req)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(res.Errors).To(BeEmpty())
+	g.Expect(res.Total).To(Equal(int32(2)))
+	g.Expect(res.Windows).To(HaveLen(2))
+	g.Expect(res.Windows[0].Start).To(Equal(until.Add(-2 * time.Hour).Format(time.RFC3339)))
+	g.Expect(res.Windows[0].Count).To(Equal(int32(1)))
+	g.Expect(res.Windows[1].Count).To(Equal(int32(1)))
+
+	g.Expect(res.Groupings).To(HaveLen(1))
+	g.Expect(res.Groupings[0].Groups).To(HaveLen(1))
+	g.Expect(res.Groupings[0].Groups[0].Value).To(Equal(policy.Spec.ID))
+	g.Expect(res.Groupings[0].Groups[0].Name).To(Equal(policy.Spec.Name))
+
+	// Out of a weight of 3, the application violates 2.
+	g.Expect(res.Applications).To(HaveLen(1))
+	g.Expect(res.Applications[0].Name).To(Equal("apps"))
+	g.Expect(res.Applications[0].Violations).To(Equal(int32(2)))
+	g.Expect(res.Applications[0].Score).To(BeNumerically("~", 100.0/3, 0.001))
+
+	// A token restricted to other clusters doesn't see their violations.
+	restrictedCtx := metadata.NewOutgoingContext(ctx, metadata.Pairs(MetadataUserKey, "anne", MetadataGroupsKey, "system:masters", MetadataClustersKey, "other"))
+	res, err = c.GetPolicyValidationsSummary(restrictedCtx, req)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(res.Total).To(BeZero())
+	g.Expect(res.Applications).To(BeEmpty())
+
+	req.ValidationType = violations.TypeAdmission
+	res, err = c.GetPolicyValidationsSummary(outgoingCtx, req)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(res.Total).To(Equal(int32(1)))
+
+	req.Window = "not a duration"
+	_, err = c.GetPolicyValidationsSummary(outgoingCtx, req)
+	g.Expect(status.Code(err)).To(Equal(codes.InvalidArgument))
+
+	req.Window = "1h"
+	req.GroupBy = []string{"owner"}
+	_, err = c.GetPolicyValidationsSummary(outgoingCtx, req)
+	g.Expect(status.Code(err)).To(Equal(codes.InvalidArgument))
+}
+
+func TestGetPolicyValidationsSummaryDisabled(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	scheme, err := kube.CreateScheme()
+	g.Expect(err).To(BeNil())
+
+	fakeClient := fake.NewClientBuilder().WithScheme(scheme).Build()
+
+	c := makeServer(makeServerConfig(fakeClient, t, ""), t)
+
+	_, err = c.GetPolicyValidationsSummary(context.Background(), &pb.GetPolicyValidationsSummaryRequest{})
+	g.Expect(status.Code(err)).To(Equal(codes.Unavailable))
+}
@@ -3,13 +3,19 @@ package server
 import (
 	"context"
 	"fmt"
+	"net/http"
 
 	"github.com/go-logr/logr"
 	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/weaveworks/weave-gitops/core/audit"
 	"github.com/weaveworks/weave-gitops/core/clustersmngr"
+	"github.com/weaveworks/weave-gitops/core/freeze"
+	"github.com/weaveworks/weave-gitops/core/history"
 	"github.com/weaveworks/weave-gitops/core/nsaccess"
+	"github.com/weaveworks/weave-gitops/core/violations"
 	pb "github.com/weaveworks/weave-gitops/pkg/api/core"
 	"github.com/weaveworks/weave-gitops/pkg/health"
+	"github.com/weaveworks/weave-gitops/pkg/server/auth"
 	"github.com/weaveworks/weave-gitops/pkg/services/crd"
 	"k8s.io/client-go/rest"
 )
@@ -24,6 +30,14 @@ func Hydrate(ctx context.Context, mux *runtime.ServeMux, cfg CoreServerConfig) e
 		return fmt.Errorf("could not register new app server: %w", err)
 	}
 
+	if err = mux.HandlePath(http.MethodGet, "/v1/objects/watch", watchObjectsHandler(mux, appsServer)); err != nil {
+		return fmt.Errorf("could not register objects watch handler: %w", err)
+	}
+
+	if err = mux.HandlePath(http.MethodPost, "/v1/sync/stream", syncFluxObjectStreamHandler(mux, appsServer)); err != nil {
+		return fmt.Errorf("could not register sync stream handler: %w", err)
+	}
+
 	return nil
 }
 
@@ -38,6 +52,16 @@ type coreServer struct {
 	primaryKinds    *PrimaryKinds
 	crd             crd.Fetcher
 	healthChecker   health.HealthChecker
+	history         *history.Recorder
+	freezes         freeze.Store
+	rulesReviewer   *nsaccess.RulesReviewer
+	audit           *audit.Recorder
+	auditEvents     audit.Store
+	auditAdmins     audit.Admins
+	accessTokens    auth.AccessTokenStore
+	sessions        auth.Sessions
+	sessionAdmins   audit.Admins
+	violations      violations.Store
 }
 
 type CoreServerConfig struct {
@@ -49,6 +73,34 @@ type CoreServerConfig struct {
 	PrimaryKinds    *PrimaryKinds
 	CRDService      crd.Fetcher
 	HealthChecker   health.HealthChecker
+	// HistoryRecorder records the timeline of Flux objects, object history
+	// is disabled when nil.
+	HistoryRecorder *history.Recorder
+	// FreezeStore keeps the change freezes, which are disabled when nil.
+	FreezeStore freeze.Store
+	// RulesReviewer caches what the users can do in each namespace, one is
+	// created when nil.
+	RulesReviewer *nsaccess.RulesReviewer
+	// AuditRecorder records the mutating calls, the audit log is disabled
+	// when nil.
+	AuditRecorder *audit.Recorder
+	// AuditEvents are listed from this store, which the recorder writes
+	// to, rather than from the recent events kept by the recorder when set.
+	AuditEvents audit.Store
+	// AuditAdmins are the users allowed to list the audit events.
+	AuditAdmins audit.Admins
+	// AccessTokens keeps the personal access tokens, which are disabled
+	// when nil.
+	AccessTokens auth.AccessTokenStore
+	// Sessions lists and revokes the sessions of the users, which can't be
+	// managed when nil.
+	Sessions auth.Sessions
+	// SessionAdmins are the users allowed to manage the sessions of others.
+	SessionAdmins audit.Admins
+	// PolicyViolations keeps the policy violations ingested from the events
+	// of the policy agent, the summary of the policy validations is
+	// disabled when nil.
+	PolicyViolations violations.Store
 }
 
 func NewCoreConfig(log logr.Logger, cfg *rest.Config, clusterName string, clustersManager clustersmngr.ClustersManager, healthChecker health.HealthChecker) (CoreServerConfig, error) {
@@ -73,6 +125,10 @@ func NewCoreServer(cfg CoreServerConfig) (pb.CoreServer, error) {
 		cfg.CRDService = crd.NewFetcher(cfg.log, cfg.ClustersManager)
 	}
 
+	if cfg.RulesReviewer == nil {
+		cfg.RulesReviewer = nsaccess.NewRulesReviewer(userPermissionsTTL)
+	}
+
 	return &coreServer{
 		logger:          cfg.log,
 		nsChecker:       cfg.NSAccess,
@@ -80,5 +136,15 @@ func NewCoreServer(cfg CoreServerConfig) (pb.CoreServer, error) {
 		primaryKinds:    cfg.PrimaryKinds,
 		crd:             cfg.CRDService,
 		healthChecker:   cfg.HealthChecker,
+		history:         cfg.HistoryRecorder,
+		freezes:         cfg.FreezeStore,
+		rulesReviewer:   cfg.RulesReviewer,
+		audit:           cfg.AuditRecorder,
+		auditEvents:     cfg.AuditEvents,
+		auditAdmins:     cfg.AuditAdmins,
+		accessTokens:    cfg.AccessTokens,
+		sessions:        cfg.Sessions,
+		sessionAdmins:   cfg.SessionAdmins,
+		violations:      cfg.PolicyViolations,
 	}, nil
 }
@@ -0,0 +1,99 @@
+package server
+
+import (
+	"context"
+	"time"
+
+	"github.com/weaveworks/weave-gitops/core/audit"
+	pb "github.com/weaveworks/weave-gitops/pkg/api/core"
+	"github.com/weaveworks/weave-gitops/pkg/server/auth"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (cs *coreServer) ListSessions(ctx context.Context, msg *pb.ListSessionsRequest) (*pb.ListSessionsResponse, error) {
+	userID, err := cs.sessionsUser(ctx, msg.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	sessions, err := cs.sessions.ListSessions(ctx, userID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "listing sessions: %v", err)
+	}
+
+	result := []*pb.Session{}
+	for _, s := range sessions {
+		result = append(result, sessionToProto(s))
+	}
+
+	return &pb.ListSessionsResponse{Sessions: result}, nil
+}
+
+func (cs *coreServer) RevokeSessions(ctx context.Context, msg *pb.RevokeSessionsRequest) (*pb.RevokeSessionsResponse, error) {
+	res, err := cs.revokeSessions(ctx, msg)
+
+	user := msg.UserId
+	if user == "" {
+		user = auth.Principal(ctx).ID
+	}
+
+	cs.recordAudit(ctx, audit.ActionRevokeSessions, []*pb.ObjectRef{{Kind: "User", Name: user}}, err)
+
+	return res, err
+}
+
+func (cs *coreServer) revokeSessions(ctx context.Context, msg *pb.RevokeSessionsRequest) (*pb.RevokeSessionsResponse, error) {
+	userID, err := cs.sessionsUser(ctx, msg.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	if auth.Principal(ctx).ReadOnly {
+		return nil, status.Error(codes.PermissionDenied, "the user is restricted to read-only access")
+	}
+
+	revoked, err := cs.sessions.RevokeSessions(ctx, userID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "revoking sessions: %v", err)
+	}
+
+	return &pb.RevokeSessionsResponse{Revoked: int32(revoked)}, nil
+}
+
+// sessionsUser returns the user whose sessions are requested, checking the
+// caller is allowed to manage them.
+func (cs *coreServer) sessionsUser(ctx context.Context, userID string) (string, error) {
+	if cs.sessions == nil {
+		return "", status.Error(codes.Unavailable, "sessions are not enabled")
+	}
+
+	principal := auth.Principal(ctx)
+
+	if userID == "" {
+		return principal.Identity(), nil
+	}
+
+	if userID != principal.Identity() && !cs.sessionAdmins.Allows(principal.Identity(), principal.IdentityGroups()) {
+		return "", status.Error(codes.PermissionDenied, "only admins can manage the sessions of other users")
+	}
+
+	return userID, nil
+}
+
+func sessionToProto(s auth.SessionInfo) *pb.Session {
+	session := &pb.Session{
+		Id:     s.ID,
+		UserId: s.UserID,
+	}
+
+	if !s.CreatedAt.IsZero() {
+		session.CreatedAt = s.CreatedAt.UTC().Format(time.RFC3339)
+	}
+
+	if !s.TokenExpiry.IsZero() {
+		session.TokenExpiry = s.TokenExpiry.UTC().Format(time.RFC3339)
+	}
+
+	return session
+}
@@ -0,0 +1,170 @@
+package server_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/gomega"
+	"github.com/weaveworks/weave-gitops/core/audit"
+	pb "github.com/weaveworks/weave-gitops/pkg/api/core"
+	"github.com/weaveworks/weave-gitops/pkg/server/auth"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestSessions(t *testing.T) {
+	ctx := context.Background()
+
+	sessions := &fakeSessions{sessions: []auth.SessionInfo{
+		{ID: "a1", UserID: "anne", CreatedAt: time.Now()},
+		{ID: "a2", UserID: "anne"},
+		{ID: "b1", UserID: "bob"},
+		{ID: "g1", UserID: "gitlab:anne"},
+	}}
+
+	recorder := audit.NewRecorder(logr.Discard(), audit.DefaultRetained)
+
+	cfg := makeServerConfig(fake.NewClientBuilder().Build(), t, "")
+	cfg.Sessions = sessions
+	cfg.SessionAdmins = audit.Admins{Groups: []string{"admins"}}
+	cfg.AuditRecorder = recorder
+
+	c := makeServer(cfg, t)
+
+	anneCtx := metadata.NewOutgoingContext(ctx, metadata.Pairs(MetadataUserKey, "anne"))
+	adminCtx := metadata.NewOutgoingContext(ctx, metadata.Pairs(MetadataUserKey, "carol", MetadataGroupsKey, "admins"))
+
+	t.Run("users list their own sessions", func(t *testing.T) {
+		g := NewGomegaWithT(t)
+
+		res, err := c.ListSessions(anneCtx, &pb.ListSessionsRequest{})
+		g.Expect(err).NotTo(HaveOccurred())
+		g.Expect(res.Sessions).To(HaveLen(2))
+		g.Expect(res.Sessions[0].UserId).To(Equal("anne"))
+		g.Expect(res.Sessions[0].CreatedAt).NotTo(BeEmpty())
+		g.Expect(res.Sessions[1].CreatedAt).To(BeEmpty())
+
+		_, err = c.ListSessions(anneCtx, &pb.ListSessionsRequest{UserId: "bob"})
+		g.Expect(status.Code(err)).To(Equal(codes.PermissionDenied))
+	})
+
+	t.Run("tells apart the users of different providers", func(t *testing.T) {
+		g := NewGomegaWithT(t)
+
+		gitlabAnneCtx := metadata.NewOutgoingContext(ctx, metadata.Pairs(MetadataUserKey, "anne", MetadataPrefixKey, "gitlab:"))
+		gitlabAdminCtx := metadata.NewOutgoingContext(ctx, metadata.Pairs(MetadataUserKey, "carol", MetadataGroupsKey, "admins", MetadataPrefixKey, "gitlab:"))
+
+		res, err := c.ListSessions(gitlabAnneCtx, &pb.ListSessionsRequest{})
+		g.Expect(err).NotTo(HaveOccurred())
+		g.Expect(res.Sessions).To(HaveLen(1))
+		g.Expect(res.Sessions[0].Id).To(Equal("g1"))
+
+		_, err = c.ListSessions(gitlabAnneCtx, &pb.ListSessionsRequest{UserId: "anne"})
+		g.Expect(status.Code(err)).To(Equal(codes.PermissionDenied))
+
+		_, err = c.ListSessions(gitlabAdminCtx, &pb.ListSessionsRequest{UserId: "bob"})
+		g.Expect(status.Code(err)).To(Equal(codes.PermissionDenied), "the admins group is the one of the default provider")
+
+		revoked, err := c.RevokeSessions(gitlabAnneCtx, &pb.RevokeSessionsRequest{})
+		g.Expect(err).NotTo(HaveOccurred())
+		g.Expect(revoked.Revoked).To(Equal(int32(1)))
+	})
+
+	t.Run("admins list the sessions of others", func(t *testing.T) {
+		g := NewGomegaWithT(t)
+
+		res, err := c.ListSessions(adminCtx, &pb.ListSessionsRequest{UserId: "bob"})
+		g.Expect(err).NotTo(HaveOccurred())
+		g.Expect(res.Sessions).To(HaveLen(1))
+		g.Expect(res.Sessions[0].Id).To(Equal("b1"))
+	})
+
+	t.Run("users can't revoke the sessions of others", func(t *testing.T) {
+		g := NewGomegaWithT(t)
+
+		_, err := c.RevokeSessions(anneCtx, &pb.RevokeSessionsRequest{UserId: "bob"})
+		g.Expect(status.Code(err)).To(Equal(codes.PermissionDenied))
+	})
+
+	t.Run("read-only tokens can't revoke sessions", func(t *testing.T) {
+		g := NewGomegaWithT(t)
+
+		tokenCtx := metadata.NewOutgoingContext(ctx, metadata.Pairs(MetadataUserKey, "anne", MetadataAccessTokenKey, "abc"))
+
+		_, err := c.RevokeSessions(tokenCtx, &pb.RevokeSessionsRequest{})
+		g.Expect(status.Code(err)).To(Equal(codes.PermissionDenied))
+	})
+
+	t.Run("users revoke their own sessions", func(t *testing.T) {
+		g := NewGomegaWithT(t)
+
+		res, err := c.RevokeSessions(anneCtx, &pb.RevokeSessionsRequest{})
+		g.Expect(err).NotTo(HaveOccurred())
+		g.Expect(res.Revoked).To(Equal(int32(2)))
+	})
+
+	t.Run("admins revoke the sessions of others", func(t *testing.T) {
+		g := NewGomegaWithT(t)
+
+		res, err := c.RevokeSessions(adminCtx, &pb.RevokeSessionsRequest{UserId: "bob"})
+		g.Expect(err).NotTo(HaveOccurred())
+		g.Expect(res.Revoked).To(Equal(int32(1)))
+
+		g.Expect(sessions.sessions).To(BeEmpty())
+	})
+
+	t.Run("audits revoking sessions", func(t *testing.T) {
+		g := NewGomegaWithT(t)
+
+		events := recorder.List(audit.Query{Action: audit.ActionRevokeSessions, Principal: "carol"})
+		g.Expect(events).To(HaveLen(1))
+		g.Expect(events[0].Outcome).To(Equal(audit.OutcomeSuccess))
+		g.Expect(events[0].Objects[0].Name).To(Equal("bob"))
+	})
+}
+
+func TestSessionsDisabled(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	c := makeServer(makeServerConfig(fake.NewClientBuilder().Build(), t, ""), t)
+
+	ctx := metadata.NewOutgoingContext(context.Background(), metadata.Pairs(MetadataUserKey, "anne"))
+
+	_, err := c.ListSessions(ctx, &pb.ListSessionsRequest{})
+	g.Expect(status.Code(err)).To(Equal(codes.Unavailable))
+}
+
+type fakeSessions struct {
+	sessions []auth.SessionInfo
+}
+
+func (f *fakeSessions) ListSessions(_ context.Context, userID string) ([]auth.SessionInfo, error) {
+	result := []auth.SessionInfo{}
+
+	for _, s := range f.sessions {
+		if userID == "" || s.UserID == userID {
+			result = append(result, s)
+		}
+	}
+
+	return result, nil
+}
+
+func (f *fakeSessions) RevokeSessions(_ context.Context, userID string) (int, error) {
+	kept := []auth.SessionInfo{}
+
+	for _, s := range f.sessions {
+		if s.UserID != userID {
+			kept = append(kept, s)
+		}
+	}
+
+	revoked := len(f.sessions) - len(kept)
+	f.sessions = kept
+
+	return revoked, nil
+}
@@ -0,0 +1,153 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/grpc/metadata"
+)
+
+const sseContentType = "text/event-stream"
+
+// httpServerStream implements grpc.ServerStream by writing each message as a
+// server-sent event, named by eventName, to the clients accepting
+// text/event-stream, like a browser's EventSource. The other clients get a
+// line of newline delimited JSON per message, wrapped in a "result" field,
+// the way the gateway streams responses and the UI's fetchStreamingRequest
+// reads them. It backs the handlers serving server-streaming calls, which
+// the in-process gateway doesn't support.
+type httpServerStream struct {
+	ctx        context.Context
+	w          http.ResponseWriter
+	rc         *http.ResponseController
+	marshaler  runtime.Marshaler
+	delimiter  []byte
+	eventName  func(m interface{}) string
+	sse        bool
+	headerSent bool
+}
+
+func newHTTPServerStream(w http.ResponseWriter, r *http.Request, marshaler runtime.Marshaler, eventName func(m interface{}) string) *httpServerStream {
+	delimiter := []byte("\n")
+	if d, ok := marshaler.(runtime.Delimited); ok {
+		delimiter = d.Delimiter()
+	}
+
+	return &httpServerStream{
+		ctx:       r.Context(),
+		w:         w,
+		rc:        http.NewResponseController(w),
+		marshaler: marshaler,
+		delimiter: delimiter,
+		eventName: eventName,
+		sse:       acceptsEventStream(r),
+	}
+}
+
+// acceptsEventStream tells whether the client asked for server-sent events.
+func acceptsEventStream(r *http.Request) bool {
+	for _, accept := range r.Header.Values("Accept") {
+		for _, mediaType := range strings.Split(accept, ",") {
+			if mediaType, _, _ := strings.Cut(mediaType, ";"); strings.TrimSpace(mediaType) == sseContentType {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+func (s *httpServerStream) SendMsg(m interface{}) error {
+	if err := s.SendHeader(nil); err != nil {
+		return err
+	}
+
+	if s.sse {
+		return s.sendEvent(m)
+	}
+
+	data, err := s.marshaler.Marshal(map[string]interface{}{"result": m})
+	if err != nil {
+		return fmt.Errorf("marshaling message: %w", err)
+	}
+
+	if _, err := s.w.Write(append(data, s.delimiter...)); err != nil {
+		return err
+	}
+
+	return s.flush()
+}
+
+// sendEvent writes a message as a server-sent event, each line of the
+// marshaled message on a data field.
+func (s *httpServerStream) sendEvent(m interface{}) error {
+	data, err := s.marshaler.Marshal(m)
+	if err != nil {
+		return fmt.Errorf("marshaling event: %w", err)
+	}
+
+	var event bytes.Buffer
+
+	fmt.Fprintf(&event, "event: %s\n", s.eventName(m))
+
+	for _, line := range bytes.Split(data, []byte("\n")) {
+		fmt.Fprintf(&event, "data: %s\n", line)
+	}
+
+	event.WriteString("\n")
+
+	if _, err := s.w.Write(event.Bytes()); err != nil {
+		return err
+	}
+
+	return s.flush()
+}
+
+func (s *httpServerStream) SetHeader(metadata.MD) error {
+	return nil
+}
+
+func (s *httpServerStream) SendHeader(metadata.MD) error {
+	if s.headerSent {
+		return nil
+	}
+
+	if s.sse {
+		s.w.Header().Set("Content-Type", sseContentType)
+	} else {
+		s.w.Header().Set("Content-Type", s.marshaler.ContentType(nil))
+	}
+
+	s.w.Header().Set("Cache-Control", "no-cache")
+	s.w.WriteHeader(http.StatusOK)
+
+	s.headerSent = true
+
+	return s.flush()
+}
+
+// flush sends what has been written so far to the client. Writers that
+// can't flush, and don't unwrap to one that can, still get the messages,
+// only once the stream ends.
+func (s *httpServerStream) flush() error {
+	if err := s.rc.Flush(); err != nil && !errors.Is(err, http.ErrNotSupported) {
+		return err
+	}
+
+	return nil
+}
+
+func (s *httpServerStream) SetTrailer(metadata.MD) {}
+
+func (s *httpServerStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *httpServerStream) RecvMsg(interface{}) error {
+	return errors.New("receiving is not supported on a server stream")
+}
@@ -0,0 +1,240 @@
+package server_test
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/alexedwards/scs/v2"
+	kustomizev1 "github.com/fluxcd/kustomize-controller/api/v1"
+	sourcev1 "github.com/fluxcd/source-controller/api/v1"
+	"github.com/go-logr/logr"
+	. "github.com/onsi/gomega"
+	"github.com/weaveworks/weave-gitops/core/server"
+	pb "github.com/weaveworks/weave-gitops/pkg/api/core"
+	"github.com/weaveworks/weave-gitops/pkg/kube"
+	wegoserver "github.com/weaveworks/weave-gitops/pkg/server"
+	"github.com/weaveworks/weave-gitops/pkg/server/auth"
+	"github.com/weaveworks/weave-gitops/pkg/server/middleware"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestWatchObjectsHTTP(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	scheme, err := kube.CreateScheme()
+	g.Expect(err).To(BeNil())
+
+	ns := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "test-namespace"}}
+
+	k := fake.NewClientBuilder().WithScheme(scheme).WithRuntimeObjects(ns).Build()
+
+	ts := makeHTTPServer(t, k, makeServerConfig(k, t, ""))
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, ts.URL+"/v1/objects/watch?kind="+kustomizev1.KustomizationKind, nil)
+	g.Expect(err).NotTo(HaveOccurred())
+	req.Header.Set("Accept-Encoding", "gzip")
+
+	res, err := ts.Client().Do(req)
+	g.Expect(err).NotTo(HaveOccurred())
+
+	defer res.Body.Close()
+
+	g.Expect(res.StatusCode).To(Equal(http.StatusOK))
+	g.Expect(res.Header.Get("Content-Type")).To(Equal("application/json"))
+	g.Expect(res.Header.Get("Content-Encoding")).To(BeEmpty())
+
+	lines := readLines(res.Body)
+
+	kust := &kustomizev1.Kustomization{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "my-kustomization",
+			Namespace: ns.Name,
+		},
+		Spec: kustomizev1.KustomizationSpec{
+			SourceRef: kustomizev1.CrossNamespaceSourceReference{
+				Kind: sourcev1.GitRepositoryKind,
+			},
+		},
+	}
+
+	// The response never ends, the events only arrive if each is flushed
+	// through the middleware.
+	var event *pb.WatchObjectsResponse
+
+	g.Eventually(func() *pb.WatchObjectsResponse {
+		if kust.ResourceVersion == "" {
+			g.Expect(k.Create(ctx, kust)).To(Succeed())
+		} else {
+			kust.Spec.Path = time.Now().String()
+			g.Expect(k.Update(ctx, kust)).To(Succeed())
+		}
+
+		select {
+		case line := <-lines:
+			event = &pb.WatchObjectsResponse{}
+			unmarshalResult(g, line, event)
+		case <-time.After(100 * time.Millisecond):
+		}
+
+		return event
+	}, 5*time.Second).ShouldNot(BeNil())
+
+	g.Expect(event.Type).To(BeElementOf("ADDED", "MODIFIED"))
+	g.Expect(event.Object.Payload).To(ContainSubstring("my-kustomization"))
+}
+
+func TestWatchObjectsEventStream(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	scheme, err := kube.CreateScheme()
+	g.Expect(err).To(BeNil())
+
+	ns := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "test-namespace"}}
+
+	k := fake.NewClientBuilder().WithScheme(scheme).WithRuntimeObjects(ns).Build()
+
+	ts := makeHTTPServer(t, k, makeServerConfig(k, t, ""))
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, ts.URL+"/v1/objects/watch?kind="+kustomizev1.KustomizationKind, nil)
+	g.Expect(err).NotTo(HaveOccurred())
+	// as sent by EventSource
+	req.Header.Set("Accept", "text/event-stream")
+
+	res, err := ts.Client().Do(req)
+	g.Expect(err).NotTo(HaveOccurred())
+
+	defer res.Body.Close()
+
+	g.Expect(res.StatusCode).To(Equal(http.StatusOK))
+	g.Expect(res.Header.Get("Content-Type")).To(Equal("text/event-stream"))
+
+	lines := readLines(res.Body)
+
+	kust := &kustomizev1.Kustomization{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "my-kustomization",
+			Namespace: ns.Name,
+		},
+		Spec: kustomizev1.KustomizationSpec{
+			SourceRef: kustomizev1.CrossNamespaceSourceReference{
+				Kind: sourcev1.GitRepositoryKind,
+			},
+		},
+	}
+
+	var eventName string
+
+	g.Eventually(func() string {
+		if kust.ResourceVersion == "" {
+			g.Expect(k.Create(ctx, kust)).To(Succeed())
+		} else {
+			kust.Spec.Path = time.Now().String()
+			g.Expect(k.Update(ctx, kust)).To(Succeed())
+		}
+
+		select {
+		case line := <-lines:
+			eventName = string(line)
+		case <-time.After(100 * time.Millisecond):
+		}
+
+		return eventName
+	}, 5*time.Second).ShouldNot(BeEmpty())
+
+	g.Expect(eventName).To(BeElementOf("event: ADDED", "event: MODIFIED"))
+
+	data := string(<-lines)
+	g.Expect(data).To(HavePrefix("data: "))
+
+	event := &pb.WatchObjectsResponse{}
+	g.Expect(protojson.Unmarshal([]byte(strings.TrimPrefix(data, "data: ")), event)).To(Succeed())
+	g.Expect(event.Object.Payload).To(ContainSubstring("my-kustomization"))
+
+	g.Expect(<-lines).To(BeEmpty(), "the events end with a blank line")
+}
+
+// makeHTTPServer serves the core API through the middleware the server
+// wraps it in, with an anonymous user.
+func makeHTTPServer(t *testing.T, k client.Client, cfg server.CoreServerConfig) *httptest.Server {
+	log := logr.Discard()
+	sm := scs.New()
+
+	// The server keeps these up to date in the background.
+	if err := cfg.ClustersManager.UpdateClusters(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := cfg.ClustersManager.UpdateNamespaces(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+
+	authServer, err := auth.InitAuthServer(context.Background(), log, k, auth.AuthParams{
+		NoAuthUser:     "anne",
+		SessionManager: sm,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	handlers, err := wegoserver.NewHandlers(context.Background(), log, &wegoserver.Config{
+		CoreServerConfig: cfg,
+		AuthServer:       authServer,
+	}, sm)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	mux := http.NewServeMux()
+	mux.Handle("/v1/", wegoserver.WithCompression(handlers))
+
+	handler := middleware.WithLogging(log, mux)
+	handler = wegoserver.WithSessions(sm, handler)
+
+	ts := httptest.NewServer(handler)
+	t.Cleanup(ts.Close)
+
+	return ts
+}
+
+// readLines sends the lines of body as they're read.
+func readLines(body io.Reader) <-chan []byte {
+	lines := make(chan []byte)
+
+	go func() {
+		defer close(lines)
+
+		scanner := bufio.NewScanner(body)
+		for scanner.Scan() {
+			lines <- append([]byte{}, scanner.Bytes()...)
+		}
+	}()
+
+	return lines
+}
+
+func unmarshalResult(g *WithT, line []byte, m proto.Message) {
+	var chunk struct {
+		Result json.RawMessage `json:"result"`
+	}
+
+	g.Expect(json.Unmarshal(line, &chunk)).To(Succeed())
+	g.Expect(protojson.Unmarshal(chunk.Result, m)).To(Succeed())
+}
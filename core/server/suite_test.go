@@ -92,6 +92,7 @@ func makeGRPCServer(cfg *rest.Config, t *testing.T) pb.CoreClient {
 
 	s := grpc.NewServer(
 		withClientsPoolInterceptor(clustersManager),
+		withClientsPoolStreamInterceptor(clustersManager),
 	)
 
 	pb.RegisterCoreServer(s, core)
@@ -131,36 +132,83 @@ var UserKey = userKey{}
 const (
 	MetadataUserKey   string = "test_principal_user"
 	MetadataGroupsKey string = "test_principal_groups"
+	// MetadataAccessTokenKey authenticates the principal with a read-only
+	// access token of this ID.
+	MetadataAccessTokenKey string = "test_principal_access_token"
+	// MetadataClustersKey restricts the principal to these clusters, like an
+	// access token limited to them.
+	MetadataClustersKey string = "test_principal_clusters"
+	// MetadataPrefixKey makes the principal a user of an OIDC provider with
+	// its own prefix, for both their name and groups.
+	MetadataPrefixKey string = "test_principal_prefix"
 )
 
 func withClientsPoolInterceptor(clustersManager clustersmngr.ClustersManager) grpc.ServerOption {
 	return grpc.UnaryInterceptor(func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
-		if err := clustersManager.UpdateClusters(ctx); err != nil {
-			return nil, err
-		}
-		if err := clustersManager.UpdateNamespaces(ctx); err != nil {
+		ctx, err := withTestPrincipal(ctx, clustersManager)
+		if err != nil {
 			return nil, err
 		}
 
-		md, ok := metadata.FromIncomingContext(ctx)
-		if !ok {
-			return nil, fmt.Errorf("getting metadata from context failed")
-		}
+		return handler(ctx, req)
+	})
+}
 
-		var user string
-		if len(md[MetadataUserKey]) > 0 {
-			user = md[MetadataUserKey][0]
+func withClientsPoolStreamInterceptor(clustersManager clustersmngr.ClustersManager) grpc.ServerOption {
+	return grpc.StreamInterceptor(func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx, err := withTestPrincipal(ss.Context(), clustersManager)
+		if err != nil {
+			return err
 		}
-		groups := md[MetadataGroupsKey]
-		principal := auth.UserPrincipal{ID: user, Groups: groups}
-		clustersManager.UpdateUserNamespaces(ctx, &principal)
 
-		ctx = auth.WithPrincipal(ctx, &principal)
-
-		return handler(ctx, req)
+		return handler(srv, &principalServerStream{ServerStream: ss, ctx: ctx})
 	})
 }
 
+// withTestPrincipal refreshes the clusters and namespaces and adds the
+// principal found in the request metadata to the context.
+func withTestPrincipal(ctx context.Context, clustersManager clustersmngr.ClustersManager) (context.Context, error) {
+	if err := clustersManager.UpdateClusters(ctx); err != nil {
+		return nil, err
+	}
+	if err := clustersManager.UpdateNamespaces(ctx); err != nil {
+		return nil, err
+	}
+
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return nil, fmt.Errorf("getting metadata from context failed")
+	}
+
+	var user string
+	if len(md[MetadataUserKey]) > 0 {
+		user = md[MetadataUserKey][0]
+	}
+	groups := md[MetadataGroupsKey]
+	principal := auth.UserPrincipal{ID: user, Groups: groups}
+	if len(md[MetadataAccessTokenKey]) > 0 {
+		principal.AccessTokenID = md[MetadataAccessTokenKey][0]
+		principal.ReadOnly = true
+	}
+	principal.Clusters = md[MetadataClustersKey]
+	if len(md[MetadataPrefixKey]) > 0 {
+		prefix := md[MetadataPrefixKey][0]
+		principal.Prefixes = &auth.ImpersonationPrefixes{Username: prefix, Groups: prefix}
+	}
+	clustersManager.UpdateUserNamespaces(ctx, &principal)
+
+	return auth.WithPrincipal(ctx, &principal), nil
+}
+
+type principalServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *principalServerStream) Context() context.Context {
+	return s.ctx
+}
+
 func makeServerConfig(fakeClient client.Client, t *testing.T, clusterName string) server.CoreServerConfig {
 	log := logr.Discard()
 	nsChecker = nsaccessfakes.FakeChecker{}
@@ -209,6 +257,7 @@ func makeServer(cfg server.CoreServerConfig, t *testing.T) pb.CoreClient {
 
 	s := grpc.NewServer(
 		withClientsPoolInterceptor(cfg.ClustersManager),
+		withClientsPoolStreamInterceptor(cfg.ClustersManager),
 	)
 
 	pb.RegisterCoreServer(s, core)
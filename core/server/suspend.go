@@ -2,84 +2,200 @@ package server
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"time"
 
-	"github.com/hashicorp/go-multierror"
+	"github.com/weaveworks/weave-gitops/core/audit"
+	"github.com/weaveworks/weave-gitops/core/clustersmngr"
 	"github.com/weaveworks/weave-gitops/core/fluxsync"
+	"github.com/weaveworks/weave-gitops/core/freeze"
+	"github.com/weaveworks/weave-gitops/core/history"
 	pb "github.com/weaveworks/weave-gitops/pkg/api/core"
 	"github.com/weaveworks/weave-gitops/pkg/server/auth"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/rand"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
 const SuspendedByAnnotation = "metadata.weave.works/suspended-by"
 const SuspendedCommentAnnotation = "metadata.weave.works/suspended-comment"
 
+// SuspendedUntilAnnotation and SuspendedByFreezeAnnotation record the time
+// an object is resumed at, and the change freeze resuming it.
+const SuspendedUntilAnnotation = "metadata.weave.works/suspended-until"
+const SuspendedByFreezeAnnotation = "metadata.weave.works/suspended-by-freeze"
+
+// errNotFrozen is returned when a freeze leaves an object alone, because it
+// was suspended or resumed by someone else.
+var errNotFrozen = errors.New("not suspended by the freeze")
+
+// suspendWindow ties the suspension of an object to the freeze resuming it.
+type suspendWindow struct {
+	freeze string
+	until  time.Time
+	// skipSuspended leaves the objects already suspended otherwise alone.
+	skipSuspended bool
+}
+
 func (cs *coreServer) ToggleSuspendResource(ctx context.Context, msg *pb.ToggleSuspendResourceRequest) (*pb.ToggleSuspendResourceResponse, error) {
+	action := audit.ActionResume
+	if msg.Suspend {
+		action = audit.ActionSuspend
+	}
+
+	results, err := cs.toggleSuspendResources(ctx, msg)
+	if err != nil {
+		cs.recordAudit(ctx, action, msg.Objects, err)
+		return nil, err
+	}
+
+	cs.recordAuditResults(ctx, action, results)
+
+	return &pb.ToggleSuspendResourceResponse{Results: results}, nil
+}
+
+// toggleSuspendResources suspends or resumes the objects selected, and
+// returns the result for each.
+func (cs *coreServer) toggleSuspendResources(ctx context.Context, msg *pb.ToggleSuspendResourceRequest) ([]*pb.ObjectResult, error) {
 	principal := auth.Principal(ctx)
-	respErrors := multierror.Error{}
 
-	for _, obj := range msg.Objects {
-		clusterName := obj.ClusterName
-		clustersClient, err := cs.clustersManager.GetImpersonatedClient(ctx, principal)
+	var window *suspendWindow
+
+	if msg.Until != "" {
+		until, err := time.Parse(time.RFC3339, msg.Until)
 		if err != nil {
-			respErrors = *multierror.Append(fmt.Errorf("error getting impersonating client: %w", err), respErrors.Errors...)
-			continue
+			return nil, status.Errorf(codes.InvalidArgument, "invalid until: %v", err)
 		}
 
-		c, err := clustersClient.Scoped(clusterName)
-		if err != nil {
-			respErrors = *multierror.Append(fmt.Errorf("getting cluster client: %w", err), respErrors.Errors...)
-			continue
+		if !msg.Suspend {
+			return nil, status.Error(codes.InvalidArgument, "until is only valid when suspending")
 		}
 
-		key := client.ObjectKey{
-			Name:      obj.Name,
-			Namespace: obj.Namespace,
+		if !until.After(time.Now()) {
+			return nil, status.Error(codes.InvalidArgument, "until is in the past")
 		}
 
-		gvk, err := cs.primaryKinds.Lookup(obj.Kind)
-		if err != nil {
-			respErrors = *multierror.Append(fmt.Errorf("looking up GVK for %q: %w", obj.Kind, err), respErrors.Errors...)
-			continue
+		if cs.freezes == nil {
+			return nil, status.Error(codes.Unavailable, "change freezes are not enabled")
 		}
 
-		obj := fluxsync.ToReconcileable(*gvk)
+		if err := checkCanCreateFreeze(principal); err != nil {
+			return nil, err
+		}
 
-		log := cs.logger.WithValues(
-			"user", principal.ID,
-			"kind", obj.GroupVersionKind().Kind,
-			"name", key.Name,
-			"namespace", key.Namespace,
-			"principal", principal.ID,
-			"cluster", clusterName,
-		)
+		// The objects are resumed by a one-off freeze, saved before
+		// suspending them so they can't be left suspended.
+		f := freeze.Freeze{
+			Name:        "suspend-" + rand.String(8),
+			Objects:     freezeObjectRefs(msg.Objects),
+			Selectors:   freezeSelectors(msg.Selectors),
+			End:         until,
+			Comment:     msg.Comment,
+			CreatedBy:   freeze.Principal{ID: principal.ID, Groups: principal.Groups},
+			ActiveUntil: until,
+		}
 
-		if err := c.Get(ctx, key, obj.AsClientObject()); err != nil {
-			respErrors = *multierror.Append(fmt.Errorf("getting reconcilable object: %w", err), respErrors.Errors...)
-			continue
+		if err := cs.freezes.Save(ctx, f); err != nil {
+			return nil, fmt.Errorf("saving freeze: %w", err)
 		}
 
-		patch := client.MergeFrom(obj.DeepCopyClientObject())
+		window = &suspendWindow{freeze: f.Name, until: until}
+	}
 
-		err = obj.SetSuspended(msg.Suspend)
-		if err != nil {
-			return nil, err
-		}
+	clustersClient, err := cs.clustersManager.GetImpersonatedClient(ctx, principal)
+	if err != nil {
+		return nil, fmt.Errorf("error getting impersonating client: %w", err)
+	}
+
+	objects, results, err := cs.selectObjects(ctx, clustersClient, msg.Objects, msg.Selectors)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, ref := range objects {
+		err := cs.toggleSuspend(ctx, clustersClient, ref, msg.Suspend, msg.Comment, principal, window)
+		results = append(results, objectResult(ref, err))
+	}
+
+	return results, nil
+}
+
+// toggleSuspend suspends or resumes an object. Suspending it within a window
+// ties it to the freeze resuming it, which only resumes the objects it
+// suspended.
+func (cs *coreServer) toggleSuspend(ctx context.Context, clustersClient clustersmngr.Client, ref *pb.ObjectRef, suspend bool, comment string, principal *auth.UserPrincipal, window *suspendWindow) error {
+	c, err := clustersClient.Scoped(ref.ClusterName)
+	if err != nil {
+		return fmt.Errorf("getting cluster client: %w", err)
+	}
+
+	key := client.ObjectKey{
+		Name:      ref.Name,
+		Namespace: ref.Namespace,
+	}
+
+	gvk, err := cs.primaryKinds.Lookup(ref.Kind)
+	if err != nil {
+		return fmt.Errorf("looking up GVK for %q: %w", ref.Kind, err)
+	}
+
+	obj := fluxsync.ToReconcileable(*gvk)
 
-		changeSuspendAnnotations(obj, msg.Suspend, msg.Comment, principal)
+	log := cs.logger.WithValues(
+		"user", principal.ID,
+		"kind", obj.GroupVersionKind().Kind,
+		"name", key.Name,
+		"namespace", key.Namespace,
+		"principal", principal.ID,
+		"cluster", ref.ClusterName,
+	)
 
-		if msg.Suspend {
-			log.Info("Suspending resource")
-		} else {
-			log.Info("Resuming resource")
+	if err := c.Get(ctx, key, obj.AsClientObject()); err != nil {
+		return fmt.Errorf("getting reconcilable object: %w", err)
+	}
+
+	if window != nil {
+		frozenBy := obj.GetAnnotations()[SuspendedByFreezeAnnotation]
+
+		if suspend && window.skipSuspended && isSuspended(obj.AsClientObject()) && frozenBy != window.freeze {
+			return errNotFrozen
 		}
 
-		if err := c.Patch(ctx, obj.AsClientObject(), patch); err != nil {
-			respErrors = *multierror.Append(fmt.Errorf("patching object: %w", err), respErrors.Errors...)
+		if !suspend && frozenBy != window.freeze {
+			return errNotFrozen
 		}
 	}
 
-	return &pb.ToggleSuspendResourceResponse{}, respErrors.ErrorOrNil()
+	patch := client.MergeFrom(obj.DeepCopyClientObject())
+
+	if err := obj.SetSuspended(suspend); err != nil {
+		return err
+	}
+
+	changeSuspendAnnotations(obj, suspend, comment, principal)
+	changeFreezeAnnotations(obj, suspend, window)
+
+	if suspend {
+		log.Info("Suspending resource")
+	} else {
+		log.Info("Resuming resource")
+	}
+
+	if err := c.Patch(ctx, obj.AsClientObject(), patch); err != nil {
+		return fmt.Errorf("patching object: %w", err)
+	}
+
+	if suspend {
+		cs.recordHistory(ctx, ref.ClusterName, obj.AsClientObject(), gvk.Kind, history.EventSuspended, principal)
+	} else {
+		cs.recordHistory(ctx, ref.ClusterName, obj.AsClientObject(), gvk.Kind, history.EventResumed, principal)
+	}
+
+	return nil
 }
 
 func changeSuspendAnnotations(obj fluxsync.Reconcilable, suspend bool, comment string, principal *auth.UserPrincipal) {
@@ -99,3 +215,33 @@ func changeSuspendAnnotations(obj fluxsync.Reconcilable, suspend bool, comment s
 		obj.SetAnnotations(annotations)
 	}
 }
+
+// changeFreezeAnnotations ties a suspended object to the freeze resuming it.
+// Suspending or resuming an object outside of a freeze unties it.
+func changeFreezeAnnotations(obj fluxsync.Reconcilable, suspend bool, window *suspendWindow) {
+	annotations := obj.GetAnnotations()
+	if annotations == nil {
+		annotations = map[string]string{}
+	}
+
+	if suspend && window != nil {
+		annotations[SuspendedUntilAnnotation] = window.until.UTC().Format(time.RFC3339)
+		annotations[SuspendedByFreezeAnnotation] = window.freeze
+	} else {
+		delete(annotations, SuspendedUntilAnnotation)
+		delete(annotations, SuspendedByFreezeAnnotation)
+	}
+
+	obj.SetAnnotations(annotations)
+}
+
+func isSuspended(obj client.Object) bool {
+	u, err := runtime.DefaultUnstructuredConverter.ToUnstructured(obj)
+	if err != nil {
+		return false
+	}
+
+	suspended, _, _ := unstructured.NestedBool(u, "spec", "suspend")
+
+	return suspended
+}
@@ -140,10 +140,10 @@ func TestSuspend_Suspend(t *testing.T) {
 		}
 	})
 
-	t.Run("will error", func(t *testing.T) {
+	t.Run("reports failures", func(t *testing.T) {
 		md := metadata.Pairs(MetadataUserKey, "anne", MetadataGroupsKey, "system:masters")
 		outgoingCtx := metadata.NewOutgoingContext(ctx, md)
-		_, err = c.ToggleSuspendResource(outgoingCtx, &api.ToggleSuspendResourceRequest{
+		res, err := c.ToggleSuspendResource(outgoingCtx, &api.ToggleSuspendResourceRequest{
 
 			Objects: []*api.ObjectRef{{
 				Kind:        sourcev1.GitRepositoryKind,
@@ -157,7 +157,12 @@ func TestSuspend_Suspend(t *testing.T) {
 			Suspend: true,
 		})
 
-		g.Expect(err.Error()).To(ContainSubstring("2 errors occurred"))
+		g.Expect(err).NotTo(HaveOccurred())
+		g.Expect(res.Results).To(HaveLen(2))
+
+		for _, result := range res.Results {
+			g.Expect(result.Status).To(Equal("NotFound"))
+		}
 	})
 }
 
@@ -4,111 +4,326 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"io"
+	"net/http"
+	"sync"
+	"time"
 
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/weaveworks/weave-gitops/core/audit"
+	"github.com/weaveworks/weave-gitops/core/clustersmngr"
 	"github.com/weaveworks/weave-gitops/core/fluxsync"
+	"github.com/weaveworks/weave-gitops/core/history"
 	pb "github.com/weaveworks/weave-gitops/pkg/api/core"
 	"github.com/weaveworks/weave-gitops/pkg/server/auth"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
 func (cs *coreServer) SyncFluxObject(ctx context.Context, msg *pb.SyncFluxObjectRequest) (*pb.SyncFluxObjectResponse, error) {
-	principal := auth.Principal(ctx)
-	var syncErr error
+	results, err := cs.syncFluxObjects(ctx, msg, nil)
 
-	for _, sync := range msg.Objects {
-		clustersClient, err := cs.clustersManager.GetImpersonatedClient(ctx, principal)
-		if err != nil {
-			syncErr = errors.Join(syncErr, fmt.Errorf("error getting impersonating client: %w", err))
-			continue
-		}
+	return &pb.SyncFluxObjectResponse{Results: results}, err
+}
 
-		c, err := clustersClient.Scoped(sync.ClusterName)
-		if err != nil {
-			syncErr = errors.Join(syncErr, fmt.Errorf("getting cluster client: %w", err))
-			continue
+func (cs *coreServer) SyncFluxObjectStream(msg *pb.SyncFluxObjectRequest, stream pb.Core_SyncFluxObjectStreamServer) error {
+	var sendErr error
+
+	_, err := cs.syncFluxObjects(stream.Context(), msg, func(progress *pb.SyncFluxObjectProgress) {
+		if sendErr == nil {
+			sendErr = stream.Send(progress)
 		}
+	})
 
-		key := client.ObjectKey{
-			Name:      sync.Name,
-			Namespace: sync.Namespace,
+	return errors.Join(err, sendErr)
+}
+
+// syncFluxObjects syncs the objects, and records the outcome of syncing each
+// in the audit log.
+func (cs *coreServer) syncFluxObjects(ctx context.Context, msg *pb.SyncFluxObjectRequest, report func(*pb.SyncFluxObjectProgress)) ([]*pb.SyncResult, error) {
+	results, err := cs.requestSyncs(ctx, msg, report)
+	if err != nil {
+		cs.recordAudit(ctx, audit.ActionSync, msg.Objects, err)
+		return nil, err
+	}
+
+	for _, r := range results {
+		message := r.Status
+		if r.Message != "" {
+			message = fmt.Sprintf("%s: %s", r.Status, r.Message)
 		}
 
-		gvk, err := cs.primaryKinds.Lookup(sync.Kind)
+		cs.recordAuditOutcome(ctx, audit.ActionSync, []*pb.ObjectRef{r.Object}, syncAuditOutcome(r), message)
+	}
+
+	return results, nil
+}
+
+// requestSyncs requests the reconciliation of the objects, and waits for the
+// controllers to handle it, or for the objects to be ready if asked to. The
+// stages the objects go through are passed to report, which may be nil.
+func (cs *coreServer) requestSyncs(ctx context.Context, msg *pb.SyncFluxObjectRequest, report func(*pb.SyncFluxObjectProgress)) ([]*pb.SyncResult, error) {
+	principal := auth.Principal(ctx)
+
+	timeout := fluxsync.DefaultWaitTimeout
+	if msg.Timeout != "" {
+		var err error
+
+		timeout, err = time.ParseDuration(msg.Timeout)
 		if err != nil {
-			syncErr = errors.Join(syncErr, fmt.Errorf("looking up GVK for %q: %w", sync.Kind, err))
-			continue
+			return nil, status.Errorf(codes.InvalidArgument, "invalid timeout: %v", err)
 		}
+	}
 
-		obj := fluxsync.ToReconcileable(*gvk)
-		if err := c.Get(ctx, key, obj.AsClientObject()); err != nil {
-			syncErr = errors.Join(syncErr, fmt.Errorf("error getting object: %w", err))
-			continue
+	clustersClient, err := cs.clustersManager.GetImpersonatedClient(ctx, principal)
+	if err != nil {
+		return nil, fmt.Errorf("error getting impersonating client: %w", err)
+	}
+
+	objects, failed, err := cs.selectObjects(ctx, clustersClient, msg.Objects, msg.Selectors)
+	if err != nil {
+		return nil, err
+	}
+
+	// The objects are synced concurrently, so that they all share the
+	// timeout, but their stages are reported one at a time.
+	var reportMu sync.Mutex
+
+	reporter := func(ref *pb.ObjectRef) func(fluxsync.Progress) {
+		return func(p fluxsync.Progress) {
+			if report == nil {
+				return
+			}
+
+			reportMu.Lock()
+			defer reportMu.Unlock()
+
+			report(&pb.SyncFluxObjectProgress{
+				Object:   ref,
+				Stage:    p.Stage,
+				Revision: p.Revision,
+				Message:  p.Message,
+			})
 		}
+	}
+
+	results := []*pb.SyncResult{}
+
+	for _, f := range failed {
+		reporter(f.Object)(fluxsync.Progress{Stage: f.Status, Message: f.Message})
+		results = append(results, &pb.SyncResult{Object: f.Object, Status: f.Status, Message: f.Message})
+	}
 
-		automation, isAutomation := obj.(fluxsync.Automation)
-		if msg.WithSource && isAutomation {
-			sourceRef := automation.SourceRef()
+	deadline := time.Now().Add(timeout)
+	synced := make([]*pb.SyncResult, len(objects))
 
-			sourceGVK, err := cs.primaryKinds.Lookup(sourceRef.Kind())
+	var wg sync.WaitGroup
+
+	for i, ref := range objects {
+		wg.Add(1)
+
+		go func(i int, ref *pb.ObjectRef) {
+			defer wg.Done()
+
+			result, err := cs.syncFluxObject(ctx, clustersClient, ref, msg, deadline, reporter)
 			if err != nil {
-				return nil, err
+				result = &pb.SyncResult{
+					Object:  ref,
+					Status:  resultStatus(err),
+					Message: err.Error(),
+				}
+				reporter(ref)(fluxsync.Progress{Stage: result.Status, Message: result.Message})
 			}
 
-			sourceObj := fluxsync.ToReconcileable(*sourceGVK)
-			sourceNs := sourceRef.Namespace()
+			synced[i] = result
+		}(i, ref)
+	}
 
-			// sourceRef.Namespace is an optional field in flux
-			// From the flux type reference:
-			// "Namespace of the referent, defaults to the namespace of the Kubernetes resource object that contains the reference."
-			// https://github.com/fluxcd/kustomize-controller/blob/4da17e1ffb9c2b9e057ff3440f66500394a4f765/api/v1beta2/reference_types.go#L37
-			if sourceNs == "" {
-				sourceNs = sync.Namespace
-			}
+	wg.Wait()
 
-			sourceKey := client.ObjectKey{
-				Name:      sourceRef.Name(),
-				Namespace: sourceNs,
-			}
+	results = append(results, synced...)
 
-			sourceGvk := sourceObj.GroupVersionKind()
+	return results, nil
+}
 
-			log := cs.logger.WithValues(
-				"user", principal.ID,
-				"kind", sourceRef.Kind(),
-				"name", sourceRef.Name(),
-				"namespace", sourceNs,
-			)
-			log.Info("Syncing resource")
+func (cs *coreServer) syncFluxObject(ctx context.Context, clustersClient clustersmngr.Client, sync *pb.ObjectRef, msg *pb.SyncFluxObjectRequest, deadline time.Time, reporter func(*pb.ObjectRef) func(fluxsync.Progress)) (*pb.SyncResult, error) {
+	principal := auth.Principal(ctx)
 
-			if err := fluxsync.RequestReconciliation(ctx, c, sourceKey, sourceGvk); err != nil {
-				syncErr = errors.Join(syncErr, fmt.Errorf("requesting source reconciliation: %w", err))
-				continue
-			}
+	c, err := clustersClient.Scoped(sync.ClusterName)
+	if err != nil {
+		return nil, fmt.Errorf("getting cluster client: %w", err)
+	}
 
-			if err := fluxsync.WaitForSync(ctx, c, sourceKey, sourceObj); err != nil {
-				syncErr = errors.Join(syncErr, fmt.Errorf("syncing source: %w", err))
-				continue
-			}
+	key := client.ObjectKey{
+		Name:      sync.Name,
+		Namespace: sync.Namespace,
+	}
+
+	gvk, err := cs.primaryKinds.Lookup(sync.Kind)
+	if err != nil {
+		return nil, fmt.Errorf("looking up GVK for %q: %w", sync.Kind, err)
+	}
+
+	obj := fluxsync.ToReconcileable(*gvk)
+	if err := c.Get(ctx, key, obj.AsClientObject()); err != nil {
+		return nil, fmt.Errorf("error getting object: %w", err)
+	}
+
+	automation, isAutomation := obj.(fluxsync.Automation)
+	if msg.WithSource && isAutomation {
+		sourceRef := automation.SourceRef()
+
+		sourceGVK, err := cs.primaryKinds.Lookup(sourceRef.Kind())
+		if err != nil {
+			return nil, fmt.Errorf("looking up GVK for %q: %w", sourceRef.Kind(), err)
+		}
+
+		sourceObj := fluxsync.ToReconcileable(*sourceGVK)
+		sourceNs := sourceRef.Namespace()
+
+		// sourceRef.Namespace is an optional field in flux
+		// From the flux type reference:
+		// "Namespace of the referent, defaults to the namespace of the Kubernetes resource object that contains the reference."
+		// https://github.com/fluxcd/kustomize-controller/blob/4da17e1ffb9c2b9e057ff3440f66500394a4f765/api/v1beta2/reference_types.go#L37
+		if sourceNs == "" {
+			sourceNs = sync.Namespace
+		}
+
+		sourceKey := client.ObjectKey{
+			Name:      sourceRef.Name(),
+			Namespace: sourceNs,
 		}
 
+		sourceGvk := sourceObj.GroupVersionKind()
+
 		log := cs.logger.WithValues(
 			"user", principal.ID,
-			"kind", obj.GroupVersionKind().Kind,
-			"name", key.Name,
-			"namespace", key.Namespace,
+			"kind", sourceRef.Kind(),
+			"name", sourceRef.Name(),
+			"namespace", sourceNs,
 		)
 		log.Info("Syncing resource")
 
-		if err := fluxsync.RequestReconciliation(ctx, c, key, *gvk); err != nil {
-			syncErr = errors.Join(syncErr, fmt.Errorf("requesting reconciliation: %w", err))
-			continue
+		if err := c.Get(ctx, sourceKey, sourceObj.AsClientObject()); err != nil {
+			return nil, fmt.Errorf("getting source: %w", err)
+		}
+
+		lastSourceReconcile := sourceObj.GetLastHandledReconcileRequest()
+
+		if err := fluxsync.RequestReconciliation(ctx, c, sourceKey, sourceGvk); err != nil {
+			return nil, fmt.Errorf("requesting source reconciliation: %w", err)
 		}
 
+		sourceProgress := reporter(&pb.ObjectRef{
+			Kind:        sourceRef.Kind(),
+			Name:        sourceKey.Name,
+			Namespace:   sourceKey.Namespace,
+			ClusterName: sync.ClusterName,
+		})
+		sourceProgress(fluxsync.Progress{Stage: fluxsync.StageRequested})
+
+		if msg.Wait {
+			progress, err := fluxsync.WaitForReady(ctx, c, sourceKey, sourceObj, lastSourceReconcile, deadline, sourceProgress)
+			if err != nil {
+				return nil, fmt.Errorf("syncing source: %w", err)
+			}
+
+			// The automation would only apply the previous revision of the
+			// source, or fail the same way.
+			if progress.Stage != fluxsync.StageReady {
+				return &pb.SyncResult{
+					Object:  sync,
+					Status:  progress.Stage,
+					Message: fmt.Sprintf("source %s/%s: %s", sourceKey.Namespace, sourceKey.Name, progress.Message),
+				}, nil
+			}
+		} else if err := fluxsync.WaitForSync(ctx, c, sourceKey, sourceObj); err != nil {
+			return nil, fmt.Errorf("syncing source: %w", err)
+		}
+	}
+
+	log := cs.logger.WithValues(
+		"user", principal.ID,
+		"kind", obj.GroupVersionKind().Kind,
+		"name", key.Name,
+		"namespace", key.Namespace,
+	)
+	log.Info("Syncing resource")
+
+	lastReconcile := obj.GetLastHandledReconcileRequest()
+
+	if err := fluxsync.RequestReconciliation(ctx, c, key, *gvk); err != nil {
+		return nil, fmt.Errorf("requesting reconciliation: %w", err)
+	}
+
+	cs.recordHistory(ctx, sync.ClusterName, obj.AsClientObject(), gvk.Kind, history.EventSyncRequested, principal)
+
+	objProgress := reporter(sync)
+	objProgress(fluxsync.Progress{Stage: fluxsync.StageRequested})
+
+	var progress fluxsync.Progress
+
+	if msg.Wait {
+		progress, err = fluxsync.WaitForReady(ctx, c, key, obj, lastReconcile, deadline, objProgress)
+		if err != nil {
+			return nil, fmt.Errorf("syncing automation: %w", err)
+		}
+	} else {
 		if err := fluxsync.WaitForSync(ctx, c, key, obj); err != nil {
-			syncErr = errors.Join(syncErr, fmt.Errorf("syncing automation: %w", err))
-			continue
+			return nil, fmt.Errorf("syncing automation: %w", err)
+		}
+
+		stages := fluxsync.SyncProgress(obj)
+		for _, p := range stages {
+			objProgress(p)
 		}
+
+		progress = stages[len(stages)-1]
 	}
 
-	return &pb.SyncFluxObjectResponse{}, syncErr
+	return &pb.SyncResult{
+		Object:   sync,
+		Status:   progress.Stage,
+		Revision: progress.Revision,
+		Message:  progress.Message,
+	}, nil
 }
+
+// syncFluxObjectStreamHandler streams the stages reached by
+// SyncFluxObjectStream as server-sent events named after the stages, or
+// newline delimited JSON.
+func syncFluxObjectStreamHandler(mux *runtime.ServeMux, core pb.CoreServer) runtime.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request, pathParams map[string]string) {
+		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, r)
+
+		msg := &pb.SyncFluxObjectRequest{}
+		if err := inboundMarshaler.NewDecoder(r.Body).Decode(msg); err != nil && !errors.Is(err, io.EOF) {
+			runtime.HTTPError(r.Context(), mux, outboundMarshaler, w, r, status.Errorf(codes.InvalidArgument, "%v", err))
+			return
+		}
+
+		stream := &httpSyncFluxObjectStreamServer{httpServerStream: newHTTPServerStream(w, r, outboundMarshaler, func(m interface{}) string {
+			return m.(*pb.SyncFluxObjectProgress).Stage
+		})}
+
+		if err := core.SyncFluxObjectStream(msg, stream); err != nil {
+			if !stream.headerSent {
+				runtime.HTTPError(r.Context(), mux, outboundMarshaler, w, r, err)
+				return
+			}
+
+			_ = stream.Send(&pb.SyncFluxObjectProgress{Stage: fluxsync.StageFailed, Message: err.Error()})
+		}
+	}
+}
+
+type httpSyncFluxObjectStreamServer struct {
+	*httpServerStream
+}
+
+func (s *httpSyncFluxObjectStreamServer) Send(msg *pb.SyncFluxObjectProgress) error {
+	return s.SendMsg(msg)
+}
+
+var _ pb.Core_SyncFluxObjectStreamServer = &httpSyncFluxObjectStreamServer{}
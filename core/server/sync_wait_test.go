@@ -0,0 +1,251 @@
+package server_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	kustomizev1 "github.com/fluxcd/kustomize-controller/api/v1"
+	"github.com/fluxcd/pkg/apis/meta"
+	. "github.com/onsi/gomega"
+	"github.com/weaveworks/weave-gitops/core/fluxsync"
+	pb "github.com/weaveworks/weave-gitops/pkg/api/core"
+	"github.com/weaveworks/weave-gitops/pkg/kube"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestSyncFluxObjectStream(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	scheme, err := kube.CreateScheme()
+	g.Expect(err).To(BeNil())
+
+	ns := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "flux-system"}}
+	ks := newKustomization("podinfo", ns.Name, false, metav1.ConditionTrue)
+
+	k := fake.NewClientBuilder().WithScheme(scheme).WithObjects(ns, ks).WithStatusSubresource(ks).Build()
+
+	c := makeServer(makeServerConfig(k, t, ""), t)
+
+	go handleReconcileRequests(ctx, k, ks)
+
+	md := metadata.Pairs(MetadataUserKey, "anne", MetadataGroupsKey, "system:masters")
+	outgoingCtx := metadata.NewOutgoingContext(ctx, md)
+
+	ref := &pb.ObjectRef{
+		Kind:        kustomizev1.KustomizationKind,
+		Name:        ks.Name,
+		Namespace:   ks.Namespace,
+		ClusterName: "Default",
+	}
+
+	stream, err := c.SyncFluxObjectStream(outgoingCtx, &pb.SyncFluxObjectRequest{
+		Objects: []*pb.ObjectRef{ref},
+		Wait:    true,
+		Timeout: "30s",
+	})
+	g.Expect(err).NotTo(HaveOccurred())
+
+	progress := []*pb.SyncFluxObjectProgress{}
+
+	for {
+		p, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+
+		g.Expect(err).NotTo(HaveOccurred())
+
+		progress = append(progress, p)
+	}
+
+	stages := []string{}
+	for _, p := range progress {
+		g.Expect(p.Object.Name).To(Equal(ks.Name))
+		stages = append(stages, p.Stage)
+	}
+
+	g.Expect(stages).To(Equal([]string{fluxsync.StageRequested, fluxsync.StageRevisionApplied, fluxsync.StageReady}))
+	g.Expect(progress[2].Revision).To(Equal("main@sha1:b"))
+	g.Expect(progress[2].Message).To(Equal("Applied revision: main@sha1:b"))
+}
+
+func TestSyncFluxObjectStreamHTTP(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	scheme, err := kube.CreateScheme()
+	g.Expect(err).To(BeNil())
+
+	ns := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "flux-system"}}
+	ks := newKustomization("podinfo", ns.Name, false, metav1.ConditionTrue)
+
+	k := fake.NewClientBuilder().WithScheme(scheme).WithObjects(ns, ks).WithStatusSubresource(ks).Build()
+
+	ts := makeHTTPServer(t, k, makeServerConfig(k, t, ""))
+
+	go handleReconcileRequests(ctx, k, ks)
+
+	body := `{"objects": [{"kind": "Kustomization", "name": "podinfo", "namespace": "flux-system", "clusterName": "Default"}], "wait": true, "timeout": "20s"}`
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, ts.URL+"/v1/sync/stream", strings.NewReader(body))
+	g.Expect(err).NotTo(HaveOccurred())
+	req.Header.Set("Accept-Encoding", "gzip")
+
+	res, err := ts.Client().Do(req)
+	g.Expect(err).NotTo(HaveOccurred())
+
+	defer res.Body.Close()
+
+	g.Expect(res.StatusCode).To(Equal(http.StatusOK))
+	g.Expect(res.Header.Get("Content-Encoding")).To(BeEmpty())
+
+	stages := []string{}
+
+	for line := range readLines(res.Body) {
+		p := &pb.SyncFluxObjectProgress{}
+		unmarshalResult(g, line, p)
+
+		stages = append(stages, p.Stage)
+	}
+
+	g.Expect(stages).To(Equal([]string{fluxsync.StageRequested, fluxsync.StageRevisionApplied, fluxsync.StageReady}))
+
+	// Invalid requests fail before anything is streamed.
+	res, err = ts.Client().Post(ts.URL+"/v1/sync/stream", "application/json", strings.NewReader(`{"timeout": "soon"}`))
+	g.Expect(err).NotTo(HaveOccurred())
+
+	defer res.Body.Close()
+
+	g.Expect(res.StatusCode).To(Equal(http.StatusBadRequest))
+}
+
+func TestSyncFluxObjectWait(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	ctx := context.Background()
+
+	scheme, err := kube.CreateScheme()
+	g.Expect(err).To(BeNil())
+
+	ns := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "flux-system"}}
+	ks := newKustomization("podinfo", ns.Name, false, metav1.ConditionTrue)
+
+	k := fake.NewClientBuilder().WithScheme(scheme).WithObjects(ns, ks).Build()
+
+	c := makeServer(makeServerConfig(k, t, ""), t)
+
+	md := metadata.Pairs(MetadataUserKey, "anne", MetadataGroupsKey, "system:masters")
+	outgoingCtx := metadata.NewOutgoingContext(ctx, md)
+
+	msg := &pb.SyncFluxObjectRequest{
+		Objects: []*pb.ObjectRef{{
+			Kind:        kustomizev1.KustomizationKind,
+			Name:        ks.Name,
+			Namespace:   ks.Namespace,
+			ClusterName: "Default",
+		}},
+		Wait:    true,
+		Timeout: "10ms",
+	}
+
+	// Nothing handles the request.
+	res, err := c.SyncFluxObject(outgoingCtx, msg)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(res.Results).To(HaveLen(1))
+	g.Expect(res.Results[0].Status).To(Equal(fluxsync.StageTimedOut))
+
+	msg.Timeout = "soon"
+
+	_, err = c.SyncFluxObject(outgoingCtx, msg)
+	g.Expect(status.Code(err)).To(Equal(codes.InvalidArgument))
+}
+
+func TestSyncFluxObjectWaitSharesTimeout(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	ctx := context.Background()
+
+	scheme, err := kube.CreateScheme()
+	g.Expect(err).To(BeNil())
+
+	ns := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "flux-system"}}
+	k := fake.NewClientBuilder().WithScheme(scheme).WithObjects(ns).Build()
+
+	refs := []*pb.ObjectRef{}
+
+	for _, name := range []string{"apps", "infra", "monitoring", "podinfo"} {
+		g.Expect(k.Create(ctx, newKustomization(name, ns.Name, false, metav1.ConditionTrue))).To(Succeed())
+
+		refs = append(refs, &pb.ObjectRef{
+			Kind:        kustomizev1.KustomizationKind,
+			Name:        name,
+			Namespace:   ns.Name,
+			ClusterName: "Default",
+		})
+	}
+
+	c := makeServer(makeServerConfig(k, t, ""), t)
+
+	md := metadata.Pairs(MetadataUserKey, "anne", MetadataGroupsKey, "system:masters")
+	outgoingCtx := metadata.NewOutgoingContext(ctx, md)
+
+	start := time.Now()
+
+	// Nothing handles the requests, so every object waits until the timeout
+	// of the request, rather than a timeout each.
+	res, err := c.SyncFluxObject(outgoingCtx, &pb.SyncFluxObjectRequest{
+		Objects: refs,
+		Wait:    true,
+		Timeout: "500ms",
+	})
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(time.Since(start)).To(BeNumerically("<", 1500*time.Millisecond))
+
+	names := []string{}
+	for _, r := range res.Results {
+		g.Expect(r.Status).To(Equal(fluxsync.StageTimedOut))
+		names = append(names, r.Object.Name)
+	}
+
+	g.Expect(names).To(Equal([]string{"apps", "infra", "monitoring", "podinfo"}))
+}
+
+// handleReconcileRequests acts as the kustomize-controller, handling the
+// reconciliation requests of ks by applying a new revision.
+func handleReconcileRequests(ctx context.Context, k client.Client, ks *kustomizev1.Kustomization) {
+	for ctx.Err() == nil {
+		time.Sleep(100 * time.Millisecond)
+
+		obj := &kustomizev1.Kustomization{}
+		if err := k.Get(ctx, client.ObjectKeyFromObject(ks), obj); err != nil {
+			continue
+		}
+
+		requested := obj.GetAnnotations()[meta.ReconcileRequestAnnotation]
+		if requested == "" || requested == obj.Status.LastHandledReconcileAt {
+			continue
+		}
+
+		obj.Status.LastHandledReconcileAt = requested
+		obj.Status.LastAppliedRevision = "main@sha1:b"
+		obj.Status.Conditions = []metav1.Condition{
+			{Type: meta.ReadyCondition, Status: metav1.ConditionTrue, Message: "Applied revision: main@sha1:b"},
+		}
+		_ = k.Status().Update(ctx, obj)
+	}
+}
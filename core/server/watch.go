@@ -0,0 +1,398 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	helmv2 "github.com/fluxcd/helm-controller/api/v2beta2"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/utilities"
+	"github.com/hashicorp/go-multierror"
+	"github.com/weaveworks/weave-gitops/core/clustersmngr"
+	"github.com/weaveworks/weave-gitops/core/logger"
+	"github.com/weaveworks/weave-gitops/core/server/types"
+	pb "github.com/weaveworks/weave-gitops/pkg/api/core"
+	"github.com/weaveworks/weave-gitops/pkg/server/auth"
+	v1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	apiruntime "k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/watch"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	watchEventError = "ERROR"
+
+	// watchRestartDelay is how long to wait before re-opening a watch that
+	// was closed by the API server.
+	watchRestartDelay = time.Second
+)
+
+// watchNamespacesInterval is how often the namespaces watched one by one are
+// looked up again.
+var watchNamespacesInterval = 30 * time.Second
+
+func (cs *coreServer) WatchObjects(msg *pb.WatchObjectsRequest, stream pb.Core_WatchObjectsServer) error {
+	ctx, cancel := context.WithCancel(stream.Context())
+	defer cancel()
+
+	gvk, err := cs.primaryKinds.Lookup(msg.Kind)
+	if err != nil {
+		return err
+	}
+
+	var clustersClient clustersmngr.Client
+
+	if msg.ClusterName != "" {
+		clustersClient, err = cs.clustersManager.GetImpersonatedClientForCluster(ctx, auth.Principal(ctx), msg.ClusterName)
+	} else {
+		clustersClient, err = cs.clustersManager.GetImpersonatedClient(ctx, auth.Principal(ctx))
+	}
+
+	if clustersClient == nil {
+		return doClientError(err)
+	}
+
+	if err != nil {
+		if merr, ok := err.(*multierror.Error); ok {
+			for _, err := range merr.Errors {
+				if cerr, ok := err.(*clustersmngr.ClientError); ok {
+					if sendErr := stream.Send(watchErrorResponse(cerr.ClusterName, "", cerr)); sendErr != nil {
+						return sendErr
+					}
+				}
+			}
+		}
+	}
+
+	// Let the client know the watch is open, it may be a while before the
+	// first event.
+	if err := stream.SendHeader(nil); err != nil {
+		return err
+	}
+
+	events := make(chan *pb.WatchObjectsResponse)
+	principal := auth.Principal(ctx)
+
+	var wg sync.WaitGroup
+
+	for clusterName := range clustersClient.ClientsPool().Clients() {
+		wg.Add(1)
+
+		go func(clusterName string) {
+			defer wg.Done()
+
+			cs.watchCluster(ctx, principal, clustersClient, clusterName, msg, *gvk, events)
+		}(clusterName)
+	}
+
+	go func() {
+		wg.Wait()
+		close(events)
+	}()
+
+	for event := range events {
+		if err := stream.Send(event); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// watchCluster watches the objects of a cluster with a single watch across
+// the namespaces, unless the user can't list them all, then each of the
+// namespaces the user can read is watched.
+func (cs *coreServer) watchCluster(ctx context.Context, principal *auth.UserPrincipal, clustersClient clustersmngr.Client, clusterName string, msg *pb.WatchObjectsRequest, gvk schema.GroupVersionKind, events chan<- *pb.WatchObjectsResponse) {
+	if msg.Namespace == "" {
+		err := cs.watchNamespace(ctx, clustersClient, clusterName, metav1.NamespaceAll, gvk, msg.Labels, events)
+		if !k8serrors.IsForbidden(err) {
+			if err != nil {
+				sendWatchEvent(ctx, events, watchErrorResponse(clusterName, "", err))
+			}
+
+			return
+		}
+	}
+
+	cs.watchUserNamespaces(ctx, principal, clustersClient, clusterName, msg, gvk, events)
+}
+
+// watchUserNamespaces watches each of the namespaces of a cluster the user
+// can read. The namespaces are looked up again every
+// watchNamespacesInterval, to watch those created since, and stop watching
+// those the user can't read anymore.
+func (cs *coreServer) watchUserNamespaces(ctx context.Context, principal *auth.UserPrincipal, clustersClient clustersmngr.Client, clusterName string, msg *pb.WatchObjectsRequest, gvk schema.GroupVersionKind, events chan<- *pb.WatchObjectsResponse) {
+	var wg sync.WaitGroup
+	defer wg.Wait()
+
+	watched := map[string]context.CancelFunc{}
+	namespaces, found := clustersClient.Namespaces()[clusterName]
+
+	for {
+		if found {
+			readable := map[string]bool{}
+
+			for _, ns := range namespaces {
+				if msg.Namespace != "" && msg.Namespace != ns.Name {
+					continue
+				}
+
+				readable[ns.Name] = true
+
+				if _, ok := watched[ns.Name]; ok {
+					continue
+				}
+
+				nsCtx, cancel := context.WithCancel(ctx)
+				watched[ns.Name] = cancel
+
+				wg.Add(1)
+
+				go func(namespace string) {
+					defer wg.Done()
+
+					if err := cs.watchNamespace(nsCtx, clustersClient, clusterName, namespace, gvk, msg.Labels, events); err != nil {
+						sendWatchEvent(ctx, events, watchErrorResponse(clusterName, namespace, err))
+					}
+				}(ns.Name)
+			}
+
+			for namespace, cancel := range watched {
+				if !readable[namespace] {
+					cancel()
+					delete(watched, namespace)
+				}
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(watchNamespacesInterval):
+		}
+
+		namespaces, found = cs.userNamespaces(ctx, principal)[clusterName]
+	}
+}
+
+// userNamespaces returns the namespaces the user can read, looking them up
+// again once they've expired from the cache.
+func (cs *coreServer) userNamespaces(ctx context.Context, principal *auth.UserPrincipal) map[string][]v1.Namespace {
+	namespaces := cs.clustersManager.GetUserNamespaces(principal)
+	if len(namespaces) == 0 {
+		cs.clustersManager.UpdateUserNamespaces(ctx, principal)
+		namespaces = cs.clustersManager.GetUserNamespaces(principal)
+	}
+
+	return namespaces
+}
+
+// watchNamespace watches a single namespace of a cluster, or all of them,
+// until the context is cancelled, re-opening the watch from the last seen
+// resource version whenever the API server closes it. It returns the error
+// if the watch can't be opened.
+func (cs *coreServer) watchNamespace(ctx context.Context, clustersClient clustersmngr.Client, clusterName, namespace string, gvk schema.GroupVersionKind, labels map[string]string, events chan<- *pb.WatchObjectsResponse) error {
+	resourceVersion := ""
+
+	for {
+		list := &unstructured.UnstructuredList{}
+		list.SetGroupVersionKind(gvk)
+
+		opts := []client.ListOption{
+			client.InNamespace(namespace),
+			&client.ListOptions{Raw: &metav1.ListOptions{
+				ResourceVersion:     resourceVersion,
+				AllowWatchBookmarks: true,
+			}},
+		}
+		if len(labels) > 0 {
+			opts = append(opts, client.MatchingLabels(labels))
+		}
+
+		w, err := clustersClient.Watch(ctx, clusterName, list, opts...)
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+
+			return err
+		}
+
+		resourceVersion = cs.forwardWatchEvents(ctx, clustersClient, clusterName, gvk, w, resourceVersion, events)
+
+		w.Stop()
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(watchRestartDelay):
+		}
+	}
+}
+
+// forwardWatchEvents sends the events of a watch to the events channel until
+// the watch is closed and returns the resource version to resume from.
+func (cs *coreServer) forwardWatchEvents(ctx context.Context, clustersClient clustersmngr.Client, clusterName string, gvk schema.GroupVersionKind, w watch.Interface, resourceVersion string, events chan<- *pb.WatchObjectsResponse) string {
+	for {
+		var (
+			event watch.Event
+			ok    bool
+		)
+
+		select {
+		case <-ctx.Done():
+			return resourceVersion
+		case event, ok = <-w.ResultChan():
+			if !ok {
+				return resourceVersion
+			}
+		}
+
+		if event.Type == watch.Error {
+			err := k8serrors.FromObject(event.Object)
+			if k8serrors.IsResourceExpired(err) || k8serrors.IsGone(err) {
+				// The resource version is too old, start over with a fresh list.
+				return ""
+			}
+
+			sendWatchEvent(ctx, events, watchErrorResponse(clusterName, "", err))
+
+			continue
+		}
+
+		unstructuredObj, err := toUnstructured(event.Object, gvk)
+		if err != nil {
+			sendWatchEvent(ctx, events, watchErrorResponse(clusterName, "", err))
+			continue
+		}
+
+		resourceVersion = unstructuredObj.GetResourceVersion()
+
+		if event.Type == watch.Bookmark {
+			continue
+		}
+
+		obj, err := cs.watchedObjectToProto(ctx, clustersClient, clusterName, unstructuredObj)
+		if err != nil {
+			sendWatchEvent(ctx, events, watchErrorResponse(clusterName, unstructuredObj.GetNamespace(), err))
+			continue
+		}
+
+		sendWatchEvent(ctx, events, &pb.WatchObjectsResponse{
+			Type:   string(event.Type),
+			Object: obj,
+		})
+	}
+}
+
+func (cs *coreServer) watchedObjectToProto(ctx context.Context, clustersClient clustersmngr.Client, clusterName string, unstructuredObj *unstructured.Unstructured) (*pb.Object, error) {
+	var (
+		obj       client.Object = unstructuredObj
+		inventory []*pb.GroupVersionKind
+		err       error
+	)
+
+	switch unstructuredObj.GetKind() {
+	case "Secret":
+		obj, err = sanitizeSecret(unstructuredObj)
+		if err != nil {
+			return nil, fmt.Errorf("error sanitizing secrets: %w", err)
+		}
+	case helmv2.HelmReleaseKind:
+		inventory, err = getUnstructuredHelmReleaseInventory(ctx, *unstructuredObj, clustersClient, clusterName)
+		if err != nil {
+			inventory = nil // We can still display most things without inventory
+
+			cs.logger.V(logger.LogLevelDebug).Info("Couldn't grab inventory for helm release", "error", err)
+		}
+	}
+
+	tenant := GetTenant(obj.GetNamespace(), clusterName, clustersClient.Namespaces())
+
+	o, err := types.K8sObjectToProto(obj, clusterName, tenant, inventory, "")
+	if err != nil {
+		return nil, fmt.Errorf("converting object to proto: %w", err)
+	}
+
+	return o, nil
+}
+
+func toUnstructured(obj apiruntime.Object, gvk schema.GroupVersionKind) (*unstructured.Unstructured, error) {
+	if u, ok := obj.(*unstructured.Unstructured); ok {
+		return u, nil
+	}
+
+	content, err := apiruntime.DefaultUnstructuredConverter.ToUnstructured(obj)
+	if err != nil {
+		return nil, fmt.Errorf("converting watched object to unstructured: %w", err)
+	}
+
+	u := &unstructured.Unstructured{Object: content}
+	u.SetGroupVersionKind(gvk)
+
+	return u, nil
+}
+
+func watchErrorResponse(clusterName, namespace string, err error) *pb.WatchObjectsResponse {
+	return &pb.WatchObjectsResponse{
+		Type: watchEventError,
+		Error: &pb.ListError{
+			ClusterName: clusterName,
+			Namespace:   namespace,
+			Message:     err.Error(),
+		},
+	}
+}
+
+func sendWatchEvent(ctx context.Context, events chan<- *pb.WatchObjectsResponse, event *pb.WatchObjectsResponse) {
+	select {
+	case <-ctx.Done():
+	case events <- event:
+	}
+}
+
+// watchObjectsHandler streams WatchObjects as server-sent events named after
+// the type of the events, or newline delimited JSON. The
+// in-process gateway registered by RegisterCoreHandlerServer doesn't support
+// server-streaming calls, so this handler takes over the route.
+func watchObjectsHandler(mux *runtime.ServeMux, core pb.CoreServer) runtime.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request, pathParams map[string]string) {
+		_, outboundMarshaler := runtime.MarshalerForRequest(mux, r)
+
+		msg := &pb.WatchObjectsRequest{}
+		if err := runtime.PopulateQueryParameters(msg, r.URL.Query(), &utilities.DoubleArray{}); err != nil {
+			runtime.HTTPError(r.Context(), mux, outboundMarshaler, w, r, err)
+			return
+		}
+
+		stream := &httpWatchObjectsServer{httpServerStream: newHTTPServerStream(w, r, outboundMarshaler, func(m interface{}) string {
+			return m.(*pb.WatchObjectsResponse).Type
+		})}
+
+		if err := core.WatchObjects(msg, stream); err != nil {
+			if !stream.headerSent {
+				runtime.HTTPError(r.Context(), mux, outboundMarshaler, w, r, err)
+				return
+			}
+
+			_ = stream.Send(watchErrorResponse("", "", err))
+		}
+	}
+}
+
+type httpWatchObjectsServer struct {
+	*httpServerStream
+}
+
+func (s *httpWatchObjectsServer) Send(msg *pb.WatchObjectsResponse) error {
+	return s.SendMsg(msg)
+}
+
+var _ pb.Core_WatchObjectsServer = &httpWatchObjectsServer{}
@@ -0,0 +1,164 @@
+package server
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	kustomizev1 "github.com/fluxcd/kustomize-controller/api/v1"
+	sourcev1 "github.com/fluxcd/source-controller/api/v1"
+	"github.com/go-logr/logr"
+	. "github.com/onsi/gomega"
+	"github.com/weaveworks/weave-gitops/core/clustersmngr"
+	"github.com/weaveworks/weave-gitops/core/clustersmngr/cluster/clusterfakes"
+	"github.com/weaveworks/weave-gitops/core/clustersmngr/fetcher"
+	"github.com/weaveworks/weave-gitops/core/nsaccess/nsaccessfakes"
+	pb "github.com/weaveworks/weave-gitops/pkg/api/core"
+	"github.com/weaveworks/weave-gitops/pkg/health"
+	"github.com/weaveworks/weave-gitops/pkg/kube"
+	"github.com/weaveworks/weave-gitops/pkg/server/auth"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/watch"
+	clientsetfake "k8s.io/client-go/kubernetes/fake"
+	typedauth "k8s.io/client-go/kubernetes/typed/authorization/v1"
+	"k8s.io/client-go/rest"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+)
+
+func TestWatchObjectsWatchesEachNamespaceWithoutClusterWideAccess(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	defer func(interval time.Duration) { watchNamespacesInterval = interval }(watchNamespacesInterval)
+	watchNamespacesInterval = 50 * time.Millisecond
+
+	scheme, err := kube.CreateScheme()
+	g.Expect(err).To(BeNil())
+
+	var (
+		mu      sync.Mutex
+		watched = map[string]int{}
+	)
+
+	// The user can only watch a namespace at a time.
+	k := interceptor.NewClient(
+		fake.NewClientBuilder().WithScheme(scheme).WithRuntimeObjects(&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "test-namespace"}}).Build(),
+		interceptor.Funcs{
+			Watch: func(ctx context.Context, c client.WithWatch, list client.ObjectList, opts ...client.ListOption) (watch.Interface, error) {
+				listOpts := &client.ListOptions{}
+				listOpts.ApplyOptions(opts)
+
+				mu.Lock()
+				watched[listOpts.Namespace]++
+				mu.Unlock()
+
+				if listOpts.Namespace == "" {
+					return nil, k8serrors.NewForbidden(schema.GroupResource{Group: kustomizev1.GroupVersion.Group, Resource: "kustomizations"}, "", nil)
+				}
+
+				return c.Watch(ctx, list, opts...)
+			},
+		},
+	)
+
+	nsChecker := &nsaccessfakes.FakeChecker{}
+	nsChecker.FilterAccessibleNamespacesStub = func(ctx context.Context, _ typedauth.AuthorizationV1Interface, n []corev1.Namespace) ([]corev1.Namespace, error) {
+		return n, nil
+	}
+
+	cl := &clusterfakes.FakeCluster{}
+	cl.GetNameReturns("Default")
+	cl.GetUserClientReturns(k, nil)
+	cl.GetUserClientsetReturns(clientsetfake.NewSimpleClientset(), nil)
+	cl.GetServerClientReturns(k, nil)
+
+	clustersManager := clustersmngr.NewClustersManager([]clustersmngr.ClusterFetcher{fetcher.NewSingleClusterFetcher(cl)}, nsChecker, logr.Discard())
+
+	principal := &auth.UserPrincipal{ID: "anne"}
+
+	g.Expect(clustersManager.UpdateClusters(ctx)).To(Succeed())
+	g.Expect(clustersManager.UpdateNamespaces(ctx)).To(Succeed())
+	clustersManager.UpdateUserNamespaces(ctx, principal)
+
+	cfg, err := NewCoreConfig(logr.Discard(), &rest.Config{}, "foobar", clustersManager, health.NewHealthChecker())
+	g.Expect(err).NotTo(HaveOccurred())
+
+	core, err := NewCoreServer(cfg)
+	g.Expect(err).NotTo(HaveOccurred())
+
+	stream := &fakeWatchObjectsServer{ctx: auth.WithPrincipal(ctx, principal), events: make(chan *pb.WatchObjectsResponse)}
+
+	go func() {
+		_ = core.WatchObjects(&pb.WatchObjectsRequest{Kind: kustomizev1.KustomizationKind}, stream)
+	}()
+
+	watchedNamespaces := func() map[string]int {
+		mu.Lock()
+		defer mu.Unlock()
+
+		namespaces := map[string]int{}
+		for ns, n := range watched {
+			namespaces[ns] = n
+		}
+
+		return namespaces
+	}
+
+	g.Eventually(watchedNamespaces, 5*time.Second).Should(HaveKey("test-namespace"))
+
+	// The namespaces created since the watch started are watched too.
+	g.Expect(k.Create(ctx, &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "new-namespace"}})).To(Succeed())
+	g.Expect(clustersManager.UpdateNamespaces(ctx)).To(Succeed())
+	clustersManager.UpdateUserNamespaces(ctx, principal)
+
+	g.Eventually(watchedNamespaces, 5*time.Second).Should(HaveKey("new-namespace"))
+
+	g.Expect(k.Create(ctx, &kustomizev1.Kustomization{
+		ObjectMeta: metav1.ObjectMeta{Name: "my-kustomization", Namespace: "new-namespace"},
+		Spec: kustomizev1.KustomizationSpec{
+			SourceRef: kustomizev1.CrossNamespaceSourceReference{Kind: sourcev1.GitRepositoryKind},
+		},
+	})).To(Succeed())
+
+	var event *pb.WatchObjectsResponse
+
+	g.Eventually(stream.events, 5*time.Second).Should(Receive(&event))
+	g.Expect(event.Error).To(BeNil())
+	g.Expect(event.Object.Payload).To(ContainSubstring("my-kustomization"))
+
+	g.Expect(watchedNamespaces()).To(HaveKeyWithValue("", 1), "the watch across the namespaces isn't tried again")
+	g.Expect(watchedNamespaces()).To(HaveKeyWithValue("test-namespace", 1), "the watched namespaces are only watched once")
+}
+
+type fakeWatchObjectsServer struct {
+	grpc.ServerStream
+	ctx    context.Context
+	events chan *pb.WatchObjectsResponse
+}
+
+func (s *fakeWatchObjectsServer) Context() context.Context {
+	return s.ctx
+}
+
+func (s *fakeWatchObjectsServer) SendHeader(metadata.MD) error {
+	return nil
+}
+
+func (s *fakeWatchObjectsServer) Send(msg *pb.WatchObjectsResponse) error {
+	select {
+	case s.events <- msg:
+		return nil
+	case <-s.ctx.Done():
+		return s.ctx.Err()
+	}
+}
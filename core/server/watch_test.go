@@ -0,0 +1,119 @@
+package server_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	kustomizev1 "github.com/fluxcd/kustomize-controller/api/v1"
+	sourcev1 "github.com/fluxcd/source-controller/api/v1"
+	. "github.com/onsi/gomega"
+	pb "github.com/weaveworks/weave-gitops/pkg/api/core"
+	"github.com/weaveworks/weave-gitops/pkg/kube"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestWatchObjects(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	scheme, err := kube.CreateScheme()
+	g.Expect(err).To(BeNil())
+
+	ns := &corev1.Namespace{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "test-namespace",
+		},
+	}
+
+	fakeClient := fake.NewClientBuilder().WithScheme(scheme).WithRuntimeObjects(ns).Build()
+
+	cfg := makeServerConfig(fakeClient, t, "")
+	c := makeServer(cfg, t)
+
+	stream, err := c.WatchObjects(ctx, &pb.WatchObjectsRequest{
+		Kind: kustomizev1.KustomizationKind,
+	})
+	g.Expect(err).NotTo(HaveOccurred())
+
+	events := make(chan *pb.WatchObjectsResponse)
+
+	go func() {
+		defer close(events)
+
+		for {
+			res, err := stream.Recv()
+			if err != nil {
+				return
+			}
+
+			events <- res
+		}
+	}()
+
+	kust := &kustomizev1.Kustomization{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "my-kustomization",
+			Namespace: ns.Name,
+		},
+		Spec: kustomizev1.KustomizationSpec{
+			SourceRef: kustomizev1.CrossNamespaceSourceReference{
+				Kind: sourcev1.GitRepositoryKind,
+			},
+		},
+	}
+
+	// The watch is opened asynchronously, keep poking the object until the
+	// first event comes through.
+	var res *pb.WatchObjectsResponse
+
+	g.Eventually(func() *pb.WatchObjectsResponse {
+		if kust.ResourceVersion == "" {
+			g.Expect(fakeClient.Create(ctx, kust)).To(Succeed())
+		} else {
+			kust.Spec.Path = time.Now().String()
+			g.Expect(fakeClient.Update(ctx, kust)).To(Succeed())
+		}
+
+		select {
+		case res = <-events:
+		case <-time.After(100 * time.Millisecond):
+		}
+
+		return res
+	}, 5*time.Second).ShouldNot(BeNil())
+
+	g.Expect(res.Type).To(BeElementOf("ADDED", "MODIFIED"))
+	g.Expect(res.Object.ClusterName).To(Equal("Default"))
+	g.Expect(res.Object.Payload).To(ContainSubstring("my-kustomization"))
+
+	g.Expect(fakeClient.Delete(ctx, kust)).To(Succeed())
+
+	g.Eventually(events, 5*time.Second).Should(Receive(HaveField("Type", "DELETED")))
+}
+
+func TestWatchObjects_UnknownKind(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	ctx := context.Background()
+
+	scheme, err := kube.CreateScheme()
+	g.Expect(err).To(BeNil())
+
+	fakeClient := fake.NewClientBuilder().WithScheme(scheme).Build()
+
+	cfg := makeServerConfig(fakeClient, t, "")
+	c := makeServer(cfg, t)
+
+	stream, err := c.WatchObjects(ctx, &pb.WatchObjectsRequest{
+		Kind: "SomethingElse",
+	})
+	g.Expect(err).NotTo(HaveOccurred())
+
+	_, err = stream.Recv()
+	g.Expect(err).To(HaveOccurred())
+}
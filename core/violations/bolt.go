@@ -0,0 +1,150 @@
+package violations
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	bolt "go.etcd.io/bbolt"
+)
+
+var (
+	violationsBucket = []byte("violations")
+	// idsBucket indexes the keys of the violations by ID.
+	idsBucket = []byte("ids")
+)
+
+type boltStore struct {
+	db *bolt.DB
+}
+
+// NewBoltStore returns a Store persisting the violations in a bbolt
+// database at path, creating it if it doesn't exist.
+func NewBoltStore(path string) (Store, error) {
+	db, err := bolt.Open(path, 0o600, &bolt.Options{Timeout: 5 * time.Second})
+	if err != nil {
+		return nil, fmt.Errorf("opening policy violations database: %w", err)
+	}
+
+	if err := db.Update(func(tx *bolt.Tx) error {
+		if _, err := tx.CreateBucketIfNotExists(violationsBucket); err != nil {
+			return err
+		}
+
+		_, err := tx.CreateBucketIfNotExists(idsBucket)
+
+		return err
+	}); err != nil {
+		db.Close()
+		return nil, fmt.Errorf("initialising policy violations database: %w", err)
+	}
+
+	return &boltStore{db: db}, nil
+}
+
+// Record stores the violations keyed by timestamp and ID, so the keys sort
+// chronologically.
+func (s *boltStore) Record(ctx context.Context, violations []Violation) error {
+	return s.db.Update(func(tx *bolt.Tx) error {
+		ids := tx.Bucket(idsBucket)
+		bucket := tx.Bucket(violationsBucket)
+
+		for _, v := range violations {
+			if ids.Get([]byte(v.ID)) != nil {
+				continue
+			}
+
+			data, err := json.Marshal(v)
+			if err != nil {
+				return err
+			}
+
+			key := violationKey(v.Timestamp, v.ID)
+
+			if err := bucket.Put(key, data); err != nil {
+				return err
+			}
+
+			if err := ids.Put([]byte(v.ID), key); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
+func (s *boltStore) List(ctx context.Context, query Query) ([]Violation, error) {
+	violations := []Violation{}
+
+	err := s.db.View(func(tx *bolt.Tx) error {
+		c := tx.Bucket(violationsBucket).Cursor()
+
+		k, v := c.First()
+		if !query.Since.IsZero() {
+			k, v = c.Seek(timeKey(query.Since))
+		}
+
+		for ; k != nil; k, v = c.Next() {
+			var violation Violation
+			if err := json.Unmarshal(v, &violation); err != nil {
+				return fmt.Errorf("decoding policy violation: %w", err)
+			}
+
+			if !query.Until.IsZero() && violation.Timestamp.After(query.Until) {
+				break
+			}
+
+			if query.matches(violation) {
+				violations = append(violations, violation)
+			}
+		}
+
+		return nil
+	})
+
+	return violations, err
+}
+
+func (s *boltStore) DeleteBefore(ctx context.Context, before time.Time) error {
+	return s.db.Update(func(tx *bolt.Tx) error {
+		ids := tx.Bucket(idsBucket)
+		c := tx.Bucket(violationsBucket).Cursor()
+
+		end := timeKey(before)
+
+		// The first key is the oldest violation left after each deletion.
+		for k, v := c.First(); k != nil && bytes.Compare(k, end) < 0; k, v = c.First() {
+			var violation Violation
+			if err := json.Unmarshal(v, &violation); err == nil {
+				if err := ids.Delete([]byte(violation.ID)); err != nil {
+					return err
+				}
+			}
+
+			if err := c.Delete(); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
+func (s *boltStore) Close() error {
+	return s.db.Close()
+}
+
+func timeKey(t time.Time) []byte {
+	key := make([]byte, 8)
+	binary.BigEndian.PutUint64(key, uint64(t.UnixNano()))
+
+	return key
+}
+
+func violationKey(t time.Time, id string) []byte {
+	return append(timeKey(t), []byte(id)...)
+}
@@ -0,0 +1,77 @@
+package violations_test
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	"github.com/weaveworks/weave-gitops/core/violations"
+)
+
+func TestBoltStore(t *testing.T) {
+	g := NewGomegaWithT(t)
+	ctx := context.Background()
+
+	path := filepath.Join(t.TempDir(), "violations.db")
+
+	store, err := violations.NewBoltStore(path)
+	g.Expect(err).NotTo(HaveOccurred())
+
+	start := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	g.Expect(store.Record(ctx, []violations.Violation{
+		{ID: "c", Type: violations.TypeAudit, ClusterName: "Default", Timestamp: start.Add(2 * time.Hour)},
+		{ID: "a", Type: violations.TypeAdmission, ClusterName: "Default", Timestamp: start},
+		{ID: "b", Type: violations.TypeAudit, ClusterName: "other", Timestamp: start.Add(time.Hour)},
+	})).To(Succeed())
+
+	// The violations recorded already are left out.
+	g.Expect(store.Record(ctx, []violations.Violation{
+		{ID: "a", Type: violations.TypeAdmission, ClusterName: "Default", Timestamp: start.Add(3 * time.Hour)},
+	})).To(Succeed())
+
+	listed, err := store.List(ctx, violations.Query{})
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(ids(listed)).To(Equal([]string{"a", "b", "c"}))
+
+	listed, err = store.List(ctx, violations.Query{Since: start.Add(time.Hour), Until: start.Add(time.Hour)})
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(ids(listed)).To(Equal([]string{"b"}))
+
+	listed, err = store.List(ctx, violations.Query{ClusterName: "Default", Type: violations.TypeAudit})
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(ids(listed)).To(Equal([]string{"c"}))
+
+	g.Expect(store.DeleteBefore(ctx, start.Add(90*time.Minute))).To(Succeed())
+
+	// The violations survive reopening the database.
+	g.Expect(store.Close()).To(Succeed())
+
+	store, err = violations.NewBoltStore(path)
+	g.Expect(err).NotTo(HaveOccurred())
+
+	defer store.Close()
+
+	listed, err = store.List(ctx, violations.Query{})
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(ids(listed)).To(Equal([]string{"c"}))
+	g.Expect(listed[0].Timestamp.Equal(start.Add(2 * time.Hour))).To(BeTrue())
+
+	// A deleted violation can be recorded again.
+	g.Expect(store.Record(ctx, []violations.Violation{{ID: "a", Timestamp: start.Add(4 * time.Hour)}})).To(Succeed())
+
+	listed, err = store.List(ctx, violations.Query{})
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(ids(listed)).To(Equal([]string{"c", "a"}))
+}
+
+func ids(listed []violations.Violation) []string {
+	result := []string{}
+	for _, v := range listed {
+		result = append(result, v.ID)
+	}
+
+	return result
+}
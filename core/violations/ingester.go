@@ -0,0 +1,231 @@
+package violations
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	helmv2 "github.com/fluxcd/helm-controller/api/v2beta2"
+	kustomizev1 "github.com/fluxcd/kustomize-controller/api/v1"
+	"github.com/go-logr/logr"
+	"github.com/weaveworks/weave-gitops/core/clustersmngr"
+	v1 "k8s.io/api/core/v1"
+	k8sFields "k8s.io/apimachinery/pkg/fields"
+	k8sLabels "k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/selection"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	pollFrequency = time.Minute
+
+	typeLabel = "pac.weave.works/type"
+	idLabel   = "pac.weave.works/id"
+)
+
+// The labels Flux sets on the resources it applies.
+const (
+	kustomizationNameLabel      = "kustomize.toolkit.fluxcd.io/name"
+	kustomizationNamespaceLabel = "kustomize.toolkit.fluxcd.io/namespace"
+	helmReleaseNameLabel        = "helm.toolkit.fluxcd.io/name"
+	helmReleaseNamespaceLabel   = "helm.toolkit.fluxcd.io/namespace"
+)
+
+// Ingester records the violations reported by the events of the policy
+// agent in a store before the events expire. It polls the events of all
+// the clusters with the server's permissions, and deletes the violations
+// older than the retention.
+type Ingester struct {
+	store           Store
+	clustersManager clustersmngr.ClustersManager
+	retention       time.Duration
+	log             logr.Logger
+
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+// NewIngester returns an Ingester keeping the violations for the
+// retention, forever if it's zero.
+func NewIngester(log logr.Logger, store Store, clustersManager clustersmngr.ClustersManager, retention time.Duration) *Ingester {
+	return &Ingester{
+		store:           store,
+		clustersManager: clustersManager,
+		retention:       retention,
+		log:             log.WithName("policy-violations-ingester"),
+	}
+}
+
+// Start polls the events in the background until the context is cancelled
+// or the ingester is stopped.
+func (i *Ingester) Start(ctx context.Context) {
+	ctx, i.cancel = context.WithCancel(ctx)
+	i.done = make(chan struct{})
+
+	go func() {
+		defer close(i.done)
+
+		//nolint:staticcheck // deprecated, tracking issue: https://github.com/weaveworks/weave-gitops/issues/3812
+		if err := wait.PollImmediateInfiniteWithContext(ctx, pollFrequency, func(ctx context.Context) (bool, error) {
+			if err := i.Poll(ctx); err != nil {
+				i.log.Error(err, "failed ingesting policy violations")
+			}
+
+			return false, nil
+		}); err != nil && !errors.Is(err, context.Canceled) {
+			i.log.Error(err, "failed ingesting policy violations")
+		}
+	}()
+}
+
+// Stop stops polling and waits for the poll in progress, so the store can be
+// closed.
+func (i *Ingester) Stop() {
+	if i.cancel == nil {
+		return
+	}
+
+	i.cancel()
+	<-i.done
+}
+
+// Poll lists the policy violation events of all clusters, records the
+// violations not recorded yet and deletes the expired ones.
+func (i *Ingester) Poll(ctx context.Context) error {
+	c, err := i.clustersManager.GetServerClient(ctx)
+	if err != nil {
+		return err
+	}
+
+	typeRequirement, err := k8sLabels.NewRequirement(typeLabel, selection.In, []string{TypeAdmission, TypeAudit})
+	if err != nil {
+		return err
+	}
+
+	clist := clustersmngr.NewClusteredList(func() client.ObjectList {
+		return &v1.EventList{}
+	})
+
+	if err := c.ClusteredList(ctx, clist, false, &client.ListOptions{
+		LabelSelector: k8sLabels.NewSelector().Add(*typeRequirement),
+		FieldSelector: k8sFields.OneTermEqualSelector("type", v1.EventTypeWarning),
+	}); err != nil {
+		// An unreachable cluster shouldn't stop the others from being
+		// ingested.
+		i.logListError(err)
+	}
+
+	var violations []Violation
+
+	for clusterName, lists := range clist.Lists() {
+		for _, l := range lists {
+			list, ok := l.(*v1.EventList)
+			if !ok {
+				continue
+			}
+
+			for _, event := range list.Items {
+				if violation, ok := FromEvent(clusterName, event); ok {
+					violations = append(violations, violation)
+				}
+			}
+		}
+	}
+
+	if err := i.store.Record(ctx, violations); err != nil {
+		return err
+	}
+
+	if i.retention > 0 {
+		return i.store.DeleteBefore(ctx, time.Now().Add(-i.retention))
+	}
+
+	return nil
+}
+
+func (i *Ingester) logListError(err error) {
+	var errs clustersmngr.ClusteredListError
+	if !errors.As(err, &errs) {
+		i.log.Error(err, "failed listing policy violation events")
+		return
+	}
+
+	for _, e := range errs.Errors {
+		i.log.Error(e.Err, "failed listing policy violation events", "cluster", e.Cluster)
+	}
+}
+
+// FromEvent returns the violation reported by an event of the policy
+// agent, false if the event isn't one.
+func FromEvent(clusterName string, event v1.Event) (Violation, bool) {
+	labels := event.GetLabels()
+	annotations := event.GetAnnotations()
+
+	if labels[idLabel] == "" || labels[typeLabel] == "" {
+		return Violation{}, false
+	}
+
+	violation := Violation{
+		ID:             labels[idLabel],
+		Type:           labels[typeLabel],
+		ClusterName:    clusterName,
+		EventNamespace: event.Namespace,
+		PolicyID:       annotations["policy_id"],
+		PolicyName:     annotations["policy_name"],
+		Severity:       annotations["severity"],
+		Category:       annotations["category"],
+		EntityKind:     event.InvolvedObject.Kind,
+		Entity:         event.InvolvedObject.Name,
+		Namespace:      event.InvolvedObject.Namespace,
+		Message:        event.Message,
+		Timestamp:      event.CreationTimestamp.UTC(),
+	}
+
+	switch event.InvolvedObject.Kind {
+	case kustomizev1.KustomizationKind, helmv2.HelmReleaseKind:
+		violation.Application = ApplicationRef{
+			Kind:      event.InvolvedObject.Kind,
+			Namespace: event.InvolvedObject.Namespace,
+			Name:      event.InvolvedObject.Name,
+		}
+	default:
+		violation.Application = applicationOf(annotations["entity_manifest"])
+	}
+
+	return violation, true
+}
+
+// applicationOf returns the Flux application which applied a resource, from
+// the labels Flux set on it, if any.
+func applicationOf(manifest string) ApplicationRef {
+	var entity struct {
+		Metadata struct {
+			Labels map[string]string `json:"labels"`
+		} `json:"metadata"`
+	}
+
+	if err := yaml.Unmarshal([]byte(manifest), &entity); err != nil {
+		return ApplicationRef{}
+	}
+
+	labels := entity.Metadata.Labels
+
+	switch {
+	case labels[kustomizationNameLabel] != "":
+		return ApplicationRef{
+			Kind:      kustomizev1.KustomizationKind,
+			Namespace: labels[kustomizationNamespaceLabel],
+			Name:      labels[kustomizationNameLabel],
+		}
+	case labels[helmReleaseNameLabel] != "":
+		return ApplicationRef{
+			Kind:      helmv2.HelmReleaseKind,
+			Namespace: labels[helmReleaseNamespaceLabel],
+			Name:      labels[helmReleaseNameLabel],
+		}
+	}
+
+	return ApplicationRef{}
+}
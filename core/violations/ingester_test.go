@@ -0,0 +1,166 @@
+package violations_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/gomega"
+	"github.com/weaveworks/weave-gitops/core/clustersmngr"
+	"github.com/weaveworks/weave-gitops/core/clustersmngr/cluster/clusterfakes"
+	"github.com/weaveworks/weave-gitops/core/clustersmngr/clustersmngrfakes"
+	"github.com/weaveworks/weave-gitops/core/violations"
+	"github.com/weaveworks/weave-gitops/pkg/kube"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+const podinfoManifest = `{"apiVersion":"apps/v1","kind":"Deployment","metadata":{"name":"podinfo","namespace":"default","labels":{"kustomize.toolkit.fluxcd.io/name":"apps","kustomize.toolkit.fluxcd.io/namespace":"flux-system"}}}`
+
+func TestIngesterPoll(t *testing.T) {
+	g := NewGomegaWithT(t)
+	ctx := context.Background()
+
+	scheme, err := kube.CreateScheme()
+	g.Expect(err).NotTo(HaveOccurred())
+
+	now := time.Now().UTC().Truncate(time.Second)
+
+	k8s := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithIndex(&v1.Event{}, "type", func(obj client.Object) []string {
+			return []string{obj.(*v1.Event).Type}
+		}).
+		WithObjects(
+			violationEvent("a", violations.TypeAudit, now.Add(-time.Hour)),
+			violationEvent("expired", violations.TypeAdmission, now.Add(-48*time.Hour)),
+			&v1.Event{
+				ObjectMeta: metav1.ObjectMeta{Name: "unrelated", Namespace: "default"},
+				Type:       v1.EventTypeWarning,
+			},
+		).Build()
+
+	c := &clusterfakes.FakeCluster{}
+	c.GetNameReturns("Default")
+
+	pool := clustersmngr.NewClustersClientsPool()
+	g.Expect(pool.Add(k8s, c)).To(Succeed())
+
+	clustersManager := &clustersmngrfakes.FakeClustersManager{}
+	clustersManager.GetServerClientReturns(clustersmngr.NewClient(pool, nil, logr.Discard()), nil)
+
+	store := violations.NewMemoryStore()
+	ingester := violations.NewIngester(logr.Discard(), store, clustersManager, 24*time.Hour)
+
+	g.Expect(ingester.Poll(ctx)).To(Succeed())
+
+	// Polling again records nothing new.
+	g.Expect(ingester.Poll(ctx)).To(Succeed())
+
+	listed, err := store.List(ctx, violations.Query{})
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(listed).To(HaveLen(1))
+	g.Expect(listed[0]).To(Equal(violations.Violation{
+		ID:             "a",
+		Type:           violations.TypeAudit,
+		ClusterName:    "Default",
+		EventNamespace: "default",
+		PolicyID:       "weave.policies.containers-minimum-replica-count",
+		PolicyName:     "Containers Minimum Replica Count",
+		Severity:       "medium",
+		Category:       "weave.categories.reliability",
+		EntityKind:     "Deployment",
+		Entity:         "podinfo",
+		Namespace:      "default",
+		Application:    violations.ApplicationRef{Kind: "Kustomization", Namespace: "flux-system", Name: "apps"},
+		Message:        "Containers Minimum Replica Count in deployment podinfo (1 occurrences)",
+		Timestamp:      now.Add(-time.Hour),
+	}))
+}
+
+func TestIngesterStop(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	polling := make(chan struct{})
+	release := make(chan struct{})
+
+	clustersManager := &clustersmngrfakes.FakeClustersManager{}
+	clustersManager.GetServerClientStub = func(ctx context.Context) (clustersmngr.Client, error) {
+		close(polling)
+		<-release
+
+		return clustersmngr.NewClient(clustersmngr.NewClustersClientsPool(), nil, logr.Discard()), nil
+	}
+
+	ingester := violations.NewIngester(logr.Discard(), violations.NewMemoryStore(), clustersManager, 0)
+	ingester.Start(context.Background())
+
+	<-polling
+
+	stopped := make(chan struct{})
+
+	go func() {
+		ingester.Stop()
+		close(stopped)
+	}()
+
+	g.Consistently(stopped, 100*time.Millisecond).ShouldNot(BeClosed(), "the poll in progress is waited for")
+
+	close(release)
+
+	g.Eventually(stopped, time.Second).Should(BeClosed())
+	g.Expect(clustersManager.GetServerClientCallCount()).To(Equal(1))
+}
+
+func TestFromEvent(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	now := time.Now().UTC().Truncate(time.Second)
+
+	event := violationEvent("a", violations.TypeAdmission, now)
+	event.InvolvedObject = v1.ObjectReference{Kind: "HelmRelease", Namespace: "flux-system", Name: "podinfo"}
+
+	violation, ok := violations.FromEvent("Default", *event)
+	g.Expect(ok).To(BeTrue())
+	g.Expect(violation.Application).To(Equal(violations.ApplicationRef{Kind: "HelmRelease", Namespace: "flux-system", Name: "podinfo"}))
+
+	event = violationEvent("b", violations.TypeAdmission, now)
+	event.Annotations["entity_manifest"] = `{"kind":"ConfigMap","metadata":{"name":"unmanaged"}}`
+
+	violation, ok = violations.FromEvent("Default", *event)
+	g.Expect(ok).To(BeTrue())
+	g.Expect(violation.Application.String()).To(BeEmpty())
+
+	event = violationEvent("c", violations.TypeAdmission, now)
+	delete(event.Labels, "pac.weave.works/id")
+
+	_, ok = violations.FromEvent("Default", *event)
+	g.Expect(ok).To(BeFalse())
+}
+
+func violationEvent(id, validationType string, timestamp time.Time) *v1.Event {
+	return &v1.Event{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:              "podinfo." + id,
+			Namespace:         "default",
+			CreationTimestamp: metav1.NewTime(timestamp),
+			Labels: map[string]string{
+				"pac.weave.works/id":   id,
+				"pac.weave.works/type": validationType,
+			},
+			Annotations: map[string]string{
+				"policy_id":       "weave.policies.containers-minimum-replica-count",
+				"policy_name":     "Containers Minimum Replica Count",
+				"severity":        "medium",
+				"category":        "weave.categories.reliability",
+				"entity_manifest": podinfoManifest,
+			},
+		},
+		InvolvedObject: v1.ObjectReference{Kind: "Deployment", Namespace: "default", Name: "podinfo"},
+		Type:           v1.EventTypeWarning,
+		Message:        "Containers Minimum Replica Count in deployment podinfo (1 occurrences)",
+	}
+}
@@ -0,0 +1,79 @@
+package violations
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+)
+
+type memoryStore struct {
+	mu         sync.RWMutex
+	violations []Violation
+	ids        map[string]bool
+}
+
+// NewMemoryStore returns a Store keeping the violations in memory, they're
+// lost when the server restarts.
+func NewMemoryStore() Store {
+	return &memoryStore{ids: map[string]bool{}}
+}
+
+func (s *memoryStore) Record(ctx context.Context, violations []Violation) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, v := range violations {
+		if s.ids[v.ID] {
+			continue
+		}
+
+		s.ids[v.ID] = true
+		s.violations = append(s.violations, v)
+	}
+
+	sort.SliceStable(s.violations, func(i, j int) bool {
+		return s.violations[i].Timestamp.Before(s.violations[j].Timestamp)
+	})
+
+	return nil
+}
+
+func (s *memoryStore) List(ctx context.Context, query Query) ([]Violation, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	violations := []Violation{}
+
+	for _, v := range s.violations {
+		if query.matches(v) {
+			violations = append(violations, v)
+		}
+	}
+
+	return violations, nil
+}
+
+func (s *memoryStore) DeleteBefore(ctx context.Context, before time.Time) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	kept := []Violation{}
+
+	for _, v := range s.violations {
+		if v.Timestamp.Before(before) {
+			delete(s.ids, v.ID)
+			continue
+		}
+
+		kept = append(kept, v)
+	}
+
+	s.violations = kept
+
+	return nil
+}
+
+func (s *memoryStore) Close() error {
+	return nil
+}
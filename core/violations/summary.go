@@ -0,0 +1,335 @@
+package violations
+
+import (
+	"fmt"
+	"sort"
+	"time"
+)
+
+// The dimensions the violations are grouped by.
+const (
+	ByPolicy      = "policy"
+	BySeverity    = "severity"
+	ByCategory    = "category"
+	ByNamespace   = "namespace"
+	ByCluster     = "cluster"
+	ByApplication = "application"
+)
+
+// Dimensions are all the dimensions, in the order they're summarised.
+var Dimensions = []string{ByPolicy, BySeverity, ByCategory, ByNamespace, ByCluster, ByApplication}
+
+// maxWindows bounds the number of windows of a summary.
+const maxWindows = 1000
+
+// severityWeights are the weights of the policies in the compliance scores,
+// by severity. The unknown severities weigh as low.
+var severityWeights = map[string]float64{
+	"high":   3,
+	"medium": 2,
+	"low":    1,
+}
+
+// Policy is a policy of a cluster, which its applications may comply with.
+type Policy struct {
+	ID       string
+	Severity string
+}
+
+// SummaryOptions configure a summary of violations.
+type SummaryOptions struct {
+	// Since and Until bound the violations summarised.
+	Since time.Time
+	Until time.Time
+	// Window is the duration of the windows the violations are counted in.
+	Window time.Duration
+	// GroupBy are the dimensions the violations are grouped by, all of them
+	// if empty.
+	GroupBy []string
+	// Policies are the policies of each cluster, by cluster name, which the
+	// compliance scores are computed against.
+	Policies map[string][]Policy
+}
+
+// Window counts the violations of a time window, from Start included to
+// End excluded.
+type Window struct {
+	Start time.Time
+	End   time.Time
+	Count int
+}
+
+// Group counts the violations with the same value of a dimension.
+type Group struct {
+	Value string
+	// Name is the name of the policy, when grouped by policy.
+	Name    string
+	Total   int
+	Windows []Window
+}
+
+// Grouping is the violations grouped by a dimension.
+type Grouping struct {
+	Dimension string
+	// Groups are sorted by total, highest first.
+	Groups []Group
+}
+
+// Compliance tells how much an application complies with the policies of
+// its cluster.
+type Compliance struct {
+	ClusterName string
+	Application ApplicationRef
+	Violations  int
+	// ViolatedPolicies are the sorted IDs of the policies violated.
+	ViolatedPolicies []string
+	// Score is the weight of the policies not violated, out of the weight
+	// of all the policies of the cluster, from 0 to 100.
+	Score float64
+}
+
+// Summary summarises violations.
+type Summary struct {
+	Total     int
+	Windows   []Window
+	Groupings []Grouping
+	// Compliance is that of the applications with violations, the others
+	// comply with all the policies.
+	Compliance []Compliance
+}
+
+// ValidateDimensions returns an error for unknown dimensions.
+func ValidateDimensions(dimensions []string) error {
+	for _, d := range dimensions {
+		if !contains(Dimensions, d) {
+			return fmt.Errorf("unknown dimension %q, valid dimensions are %v", d, Dimensions)
+		}
+	}
+
+	return nil
+}
+
+// Summarise groups the violations by the dimensions of the options and
+// counts them in the windows between Since and Until, and computes the
+// compliance scores of the applications.
+func Summarise(violations []Violation, opts SummaryOptions) (Summary, error) {
+	if opts.Window <= 0 {
+		return Summary{}, fmt.Errorf("the window must be positive, got %s", opts.Window)
+	}
+
+	if !opts.Until.After(opts.Since) {
+		return Summary{}, fmt.Errorf("until must be after since")
+	}
+
+	if opts.Until.Sub(opts.Since)/opts.Window >= maxWindows {
+		return Summary{}, fmt.Errorf("more than %d windows of %s between since and until", maxWindows, opts.Window)
+	}
+
+	if err := ValidateDimensions(opts.GroupBy); err != nil {
+		return Summary{}, err
+	}
+
+	dimensions := opts.GroupBy
+	if len(dimensions) == 0 {
+		dimensions = Dimensions
+	}
+
+	var selected []Violation
+
+	for _, v := range violations {
+		if !v.Timestamp.Before(opts.Since) && v.Timestamp.Before(opts.Until) {
+			selected = append(selected, v)
+		}
+	}
+
+	summary := Summary{
+		Total:   len(selected),
+		Windows: countWindows(selected, opts),
+	}
+
+	for _, d := range dimensions {
+		summary.Groupings = append(summary.Groupings, group(d, selected, opts))
+	}
+
+	summary.Compliance = compliance(selected, opts.Policies)
+
+	return summary, nil
+}
+
+func group(dimension string, violations []Violation, opts SummaryOptions) Grouping {
+	byValue := map[string][]Violation{}
+	names := map[string]string{}
+
+	for _, v := range violations {
+		value := valueOf(dimension, v)
+		byValue[value] = append(byValue[value], v)
+
+		if dimension == ByPolicy {
+			names[value] = v.PolicyName
+		}
+	}
+
+	grouping := Grouping{Dimension: dimension, Groups: []Group{}}
+
+	for value, grouped := range byValue {
+		grouping.Groups = append(grouping.Groups, Group{
+			Value:   value,
+			Name:    names[value],
+			Total:   len(grouped),
+			Windows: countWindows(grouped, opts),
+		})
+	}
+
+	sort.Slice(grouping.Groups, func(i, j int) bool {
+		a, b := grouping.Groups[i], grouping.Groups[j]
+		if a.Total != b.Total {
+			return a.Total > b.Total
+		}
+
+		return a.Value < b.Value
+	})
+
+	return grouping
+}
+
+func valueOf(dimension string, v Violation) string {
+	switch dimension {
+	case ByPolicy:
+		return v.PolicyID
+	case BySeverity:
+		return v.Severity
+	case ByCategory:
+		return v.Category
+	case ByNamespace:
+		return v.Namespace
+	case ByCluster:
+		return v.ClusterName
+	case ByApplication:
+		return v.Application.String()
+	}
+
+	return ""
+}
+
+// countWindows counts the violations in the windows from Since, the last
+// window ending at Until.
+func countWindows(violations []Violation, opts SummaryOptions) []Window {
+	windows := []Window{}
+
+	for start := opts.Since; start.Before(opts.Until); start = start.Add(opts.Window) {
+		end := start.Add(opts.Window)
+		if end.After(opts.Until) {
+			end = opts.Until
+		}
+
+		windows = append(windows, Window{Start: start, End: end})
+	}
+
+	for _, v := range violations {
+		i := int(v.Timestamp.Sub(opts.Since) / opts.Window)
+		if i >= 0 && i < len(windows) {
+			windows[i].Count++
+		}
+	}
+
+	return windows
+}
+
+func compliance(violations []Violation, policies map[string][]Policy) []Compliance {
+	type appKey struct {
+		cluster string
+		app     ApplicationRef
+	}
+
+	violated := map[appKey]map[string]string{}
+	counts := map[appKey]int{}
+
+	for _, v := range violations {
+		if v.Application.Kind == "" {
+			continue
+		}
+
+		key := appKey{cluster: v.ClusterName, app: v.Application}
+
+		if violated[key] == nil {
+			violated[key] = map[string]string{}
+		}
+
+		violated[key][v.PolicyID] = v.Severity
+		counts[key]++
+	}
+
+	result := []Compliance{}
+
+	for key, policySeverities := range violated {
+		// The policies deleted since they were violated still count.
+		total := map[string]string{}
+		for _, p := range policies[key.cluster] {
+			total[p.ID] = p.Severity
+		}
+
+		for id, severity := range policySeverities {
+			if _, ok := total[id]; !ok {
+				total[id] = severity
+			}
+		}
+
+		var totalWeight, violatedWeight float64
+
+		for id, severity := range total {
+			totalWeight += weightOf(severity)
+
+			if _, ok := policySeverities[id]; ok {
+				violatedWeight += weightOf(severity)
+			}
+		}
+
+		ids := []string{}
+		for id := range policySeverities {
+			ids = append(ids, id)
+		}
+
+		sort.Strings(ids)
+
+		result = append(result, Compliance{
+			ClusterName:      key.cluster,
+			Application:      key.app,
+			Violations:       counts[key],
+			ViolatedPolicies: ids,
+			Score:            100 * (totalWeight - violatedWeight) / totalWeight,
+		})
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		a, b := result[i], result[j]
+		if a.Score != b.Score {
+			return a.Score < b.Score
+		}
+
+		if a.ClusterName != b.ClusterName {
+			return a.ClusterName < b.ClusterName
+		}
+
+		return a.Application.String() < b.Application.String()
+	})
+
+	return result
+}
+
+func weightOf(severity string) float64 {
+	if weight, ok := severityWeights[severity]; ok {
+		return weight
+	}
+
+	return severityWeights["low"]
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+
+	return false
+}
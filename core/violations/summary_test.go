@@ -0,0 +1,123 @@
+package violations_test
+
+import (
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	"github.com/weaveworks/weave-gitops/core/violations"
+)
+
+func TestSummarise(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	start := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	apps := violations.ApplicationRef{Kind: "Kustomization", Namespace: "flux-system", Name: "apps"}
+	infra := violations.ApplicationRef{Kind: "Kustomization", Namespace: "flux-system", Name: "infra"}
+
+	summary, err := violations.Summarise([]violations.Violation{
+		{ID: "a", ClusterName: "Default", PolicyID: "replicas", PolicyName: "Replicas", Severity: "medium", Application: apps, Timestamp: start},
+		{ID: "b", ClusterName: "Default", PolicyID: "replicas", PolicyName: "Replicas", Severity: "medium", Application: apps, Timestamp: start.Add(25 * time.Hour)},
+		{ID: "c", ClusterName: "Default", PolicyID: "privileged", PolicyName: "Privileged", Severity: "high", Application: infra, Timestamp: start.Add(26 * time.Hour)},
+		{ID: "d", ClusterName: "Default", PolicyID: "privileged", PolicyName: "Privileged", Severity: "high", Timestamp: start.Add(47 * time.Hour)},
+		// Out of the summarised period.
+		{ID: "e", ClusterName: "Default", PolicyID: "replicas", Severity: "medium", Application: apps, Timestamp: start.Add(48 * time.Hour)},
+	}, violations.SummaryOptions{
+		Since:   start,
+		Until:   start.Add(48 * time.Hour),
+		Window:  24 * time.Hour,
+		GroupBy: []string{violations.ByPolicy, violations.ByApplication},
+		Policies: map[string][]violations.Policy{
+			"Default": {
+				{ID: "replicas", Severity: "medium"},
+				{ID: "privileged", Severity: "high"},
+				{ID: "labels", Severity: "low"},
+			},
+		},
+	})
+	g.Expect(err).NotTo(HaveOccurred())
+
+	g.Expect(summary.Total).To(Equal(4))
+	g.Expect(counts(summary.Windows)).To(Equal([]int{1, 3}))
+	g.Expect(summary.Windows[1].Start).To(Equal(start.Add(24 * time.Hour)))
+
+	g.Expect(summary.Groupings).To(HaveLen(2))
+	g.Expect(summary.Groupings[0].Dimension).To(Equal(violations.ByPolicy))
+	g.Expect(summary.Groupings[0].Groups).To(HaveLen(2))
+	g.Expect(summary.Groupings[0].Groups[0].Value).To(Equal("privileged"))
+	g.Expect(summary.Groupings[0].Groups[0].Name).To(Equal("Privileged"))
+	g.Expect(counts(summary.Groupings[0].Groups[0].Windows)).To(Equal([]int{0, 2}))
+	g.Expect(summary.Groupings[0].Groups[1].Value).To(Equal("replicas"))
+	g.Expect(counts(summary.Groupings[0].Groups[1].Windows)).To(Equal([]int{1, 1}))
+
+	g.Expect(summary.Groupings[1].Dimension).To(Equal(violations.ByApplication))
+	g.Expect(groupValues(summary.Groupings[1])).To(Equal([]string{
+		"Kustomization/flux-system/apps",
+		"",
+		"Kustomization/flux-system/infra",
+	}))
+
+	// Out of a weight of 6, infra violates 3 and apps 2.
+	g.Expect(summary.Compliance).To(Equal([]violations.Compliance{
+		{ClusterName: "Default", Application: infra, Violations: 1, ViolatedPolicies: []string{"privileged"}, Score: 50},
+		{ClusterName: "Default", Application: apps, Violations: 2, ViolatedPolicies: []string{"replicas"}, Score: 100 * 4.0 / 6},
+	}))
+}
+
+func TestSummariseErrors(t *testing.T) {
+	start := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name string
+		opts violations.SummaryOptions
+		err  string
+	}{
+		{
+			name: "no window",
+			opts: violations.SummaryOptions{Since: start, Until: start.Add(time.Hour)},
+			err:  "the window must be positive",
+		},
+		{
+			name: "until before since",
+			opts: violations.SummaryOptions{Since: start, Until: start, Window: time.Hour},
+			err:  "until must be after since",
+		},
+		{
+			name: "too many windows",
+			opts: violations.SummaryOptions{Since: start, Until: start.Add(24 * time.Hour), Window: time.Minute},
+			err:  "more than 1000 windows",
+		},
+		{
+			name: "unknown dimension",
+			opts: violations.SummaryOptions{Since: start, Until: start.Add(time.Hour), Window: time.Hour, GroupBy: []string{"owner"}},
+			err:  `unknown dimension "owner"`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewGomegaWithT(t)
+
+			_, err := violations.Summarise(nil, tt.opts)
+			g.Expect(err).To(MatchError(ContainSubstring(tt.err)))
+		})
+	}
+}
+
+func counts(windows []violations.Window) []int {
+	result := []int{}
+	for _, w := range windows {
+		result = append(result, w.Count)
+	}
+
+	return result
+}
+
+func groupValues(grouping violations.Grouping) []string {
+	result := []string{}
+	for _, g := range grouping.Groups {
+		result = append(result, g.Value)
+	}
+
+	return result
+}
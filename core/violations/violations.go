@@ -0,0 +1,94 @@
+// Package violations keeps the policy violations reported by the events of
+// the policy agent once the events expire, and summarises them over time.
+package violations
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+const (
+	TypeAdmission = "Admission"
+	TypeAudit     = "Audit"
+)
+
+// ApplicationRef identifies the Flux Kustomization or HelmRelease which
+// applied a violating resource.
+type ApplicationRef struct {
+	Kind      string `json:"kind"`
+	Namespace string `json:"namespace"`
+	Name      string `json:"name"`
+}
+
+// String returns kind/namespace/name, empty for no application.
+func (r ApplicationRef) String() string {
+	if r.Kind == "" {
+		return ""
+	}
+
+	return fmt.Sprintf("%s/%s/%s", r.Kind, r.Namespace, r.Name)
+}
+
+// Violation is a violation of a policy by a resource, as reported by an
+// event of the policy agent.
+type Violation struct {
+	// ID is the id the policy agent gave the validation.
+	ID string `json:"id"`
+	// Type is TypeAdmission or TypeAudit.
+	Type        string `json:"type"`
+	ClusterName string `json:"clusterName"`
+	// EventNamespace is the namespace of the event, the users who can read
+	// the events of the namespace can read the violation.
+	EventNamespace string         `json:"eventNamespace"`
+	PolicyID       string         `json:"policyId"`
+	PolicyName     string         `json:"policyName"`
+	Severity       string         `json:"severity"`
+	Category       string         `json:"category"`
+	EntityKind     string         `json:"entityKind"`
+	Entity         string         `json:"entity"`
+	Namespace      string         `json:"namespace"`
+	Application    ApplicationRef `json:"application"`
+	Message        string         `json:"message"`
+	Timestamp      time.Time      `json:"timestamp"`
+}
+
+// Query selects violations. Zero values are ignored.
+type Query struct {
+	ClusterName string
+	Type        string
+	Since       time.Time
+	Until       time.Time
+}
+
+func (q Query) matches(v Violation) bool {
+	if q.ClusterName != "" && v.ClusterName != q.ClusterName {
+		return false
+	}
+
+	if q.Type != "" && v.Type != q.Type {
+		return false
+	}
+
+	if !q.Since.IsZero() && v.Timestamp.Before(q.Since) {
+		return false
+	}
+
+	if !q.Until.IsZero() && v.Timestamp.After(q.Until) {
+		return false
+	}
+
+	return true
+}
+
+// Store persists the violations.
+type Store interface {
+	// Record adds violations, leaving out those recorded already, by ID.
+	Record(ctx context.Context, violations []Violation) error
+	// List returns the violations matching the query, oldest first.
+	List(ctx context.Context, query Query) ([]Violation, error)
+	// DeleteBefore deletes the violations older than a time.
+	DeleteBefore(ctx context.Context, before time.Time) error
+	// Close releases the resources of the store.
+	Close() error
+}
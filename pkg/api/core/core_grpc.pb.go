@@ -22,6 +22,9 @@ type CoreClient interface {
 	GetObject(ctx context.Context, in *GetObjectRequest, opts ...grpc.CallOption) (*GetObjectResponse, error)
 	// ListObjects gets data about primary objects.
 	ListObjects(ctx context.Context, in *ListObjectsRequest, opts ...grpc.CallOption) (*ListObjectsResponse, error)
+	// WatchObjects streams changes to primary objects across all the clusters
+	// the user has access to.
+	WatchObjects(ctx context.Context, in *WatchObjectsRequest, opts ...grpc.CallOption) (Core_WatchObjectsClient, error)
 	// ListFluxRuntimeObjects lists the flux runtime deployments from a cluster.
 	ListFluxRuntimeObjects(ctx context.Context, in *ListFluxRuntimeObjectsRequest, opts ...grpc.CallOption) (*ListFluxRuntimeObjectsResponse, error)
 	ListFluxCrds(ctx context.Context, in *ListFluxCrdsRequest, opts ...grpc.CallOption) (*ListFluxCrdsResponse, error)
@@ -47,15 +50,27 @@ type CoreClient interface {
 	GetFluxNamespace(ctx context.Context, in *GetFluxNamespaceRequest, opts ...grpc.CallOption) (*GetFluxNamespaceResponse, error)
 	// ListNamespaces returns with the list of available namespaces.
 	ListNamespaces(ctx context.Context, in *ListNamespacesRequest, opts ...grpc.CallOption) (*ListNamespacesResponse, error)
+	// ListClusters returns the clusters with the outcome of their last
+	// probe, their status, the versions of Kubernetes and Flux they run and
+	// when they were last seen.
+	ListClusters(ctx context.Context, in *ListClustersRequest, opts ...grpc.CallOption) (*ListClustersResponse, error)
+	// GetUserPermissions returns what the user can do with each Flux kind in
+	// the namespaces they can access, so that the actions they can't perform
+	// can be hidden.
+	GetUserPermissions(ctx context.Context, in *GetUserPermissionsRequest, opts ...grpc.CallOption) (*GetUserPermissionsResponse, error)
 	// ListEvents returns with a list of events
 	ListEvents(ctx context.Context, in *ListEventsRequest, opts ...grpc.CallOption) (*ListEventsResponse, error)
 	// SyncResource forces a reconciliation of a Flux resource
 	SyncFluxObject(ctx context.Context, in *SyncFluxObjectRequest, opts ...grpc.CallOption) (*SyncFluxObjectResponse, error)
+	// SyncFluxObjectStream forces a reconciliation of Flux resources, and
+	// streams the stages each object goes through until it's ready.
+	SyncFluxObjectStream(ctx context.Context, in *SyncFluxObjectRequest, opts ...grpc.CallOption) (Core_SyncFluxObjectStreamClient, error)
 	// GetVersion returns version information about the server
 	GetVersion(ctx context.Context, in *GetVersionRequest, opts ...grpc.CallOption) (*GetVersionResponse, error)
 	// GetFeatureFlags returns configuration information about the server
 	GetFeatureFlags(ctx context.Context, in *GetFeatureFlagsRequest, opts ...grpc.CallOption) (*GetFeatureFlagsResponse, error)
-	// ToggleSuspendResource suspends or resumes a flux object.
+	// ToggleSuspendResource suspends or resumes flux objects, and reports
+	// the result for each of them.
 	ToggleSuspendResource(ctx context.Context, in *ToggleSuspendResourceRequest, opts ...grpc.CallOption) (*ToggleSuspendResourceResponse, error)
 	// GetSessionLogs returns the logs for a given session
 	GetSessionLogs(ctx context.Context, in *GetSessionLogsRequest, opts ...grpc.CallOption) (*GetSessionLogsResponse, error)
@@ -64,6 +79,50 @@ type CoreClient interface {
 	// installed or not on that cluster.
 	IsCRDAvailable(ctx context.Context, in *IsCRDAvailableRequest, opts ...grpc.CallOption) (*IsCRDAvailableResponse, error)
 	GetInventory(ctx context.Context, in *GetInventoryRequest, opts ...grpc.CallOption) (*GetInventoryResponse, error)
+	// GetDrift compares the manifests a Kustomization or HelmRelease would
+	// apply with the live objects on the cluster, and returns the fields that
+	// drifted. The manifests of a Kustomization are built from the artifact
+	// of its source. Those of a HelmRelease are the manifest of its latest
+	// release: changes to the chart or values that haven't been released yet
+	// aren't compared.
+	GetDrift(ctx context.Context, in *GetDriftRequest, opts ...grpc.CallOption) (*GetDriftResponse, error)
+	// GetDependencyGraph returns the graph of Kustomizations, HelmReleases
+	// and their sources, with the dependencies between them, for a cluster
+	// or a namespace.
+	GetDependencyGraph(ctx context.Context, in *GetDependencyGraphRequest, opts ...grpc.CallOption) (*GetDependencyGraphResponse, error)
+	// GetObjectHistory returns the timeline of a Flux object: revision
+	// changes, Ready condition changes, suspensions and sync requests.
+	GetObjectHistory(ctx context.Context, in *GetObjectHistoryRequest, opts ...grpc.CallOption) (*GetObjectHistoryResponse, error)
+	// CreateFreeze creates a change freeze, suspending a selection of Flux
+	// objects during a one-off window or the recurring windows of a cron
+	// schedule. The objects are suspended and resumed as the user who
+	// created the freeze.
+	CreateFreeze(ctx context.Context, in *CreateFreezeRequest, opts ...grpc.CallOption) (*CreateFreezeResponse, error)
+	// ListFreezes returns the change freezes.
+	ListFreezes(ctx context.Context, in *ListFreezesRequest, opts ...grpc.CallOption) (*ListFreezesResponse, error)
+	// DeleteFreeze deletes a change freeze created by the user, resuming the
+	// objects it suspended.
+	DeleteFreeze(ctx context.Context, in *DeleteFreezeRequest, opts ...grpc.CallOption) (*DeleteFreezeResponse, error)
+	// ListAuditEvents returns the audit events: the mutating calls and the
+	// logins and logouts, newest first. Only admins can list them. Those
+	// kept in the audit database are listed if the server has one, otherwise
+	// only the recent events kept in memory are. Either way, each replica of
+	// the server lists the events it handled.
+	ListAuditEvents(ctx context.Context, in *ListAuditEventsRequest, opts ...grpc.CallOption) (*ListAuditEventsResponse, error)
+	// CreateAccessToken creates a personal access token acting as the user,
+	// optionally restricted to read-only access or to some clusters. The
+	// token is only returned once.
+	CreateAccessToken(ctx context.Context, in *CreateAccessTokenRequest, opts ...grpc.CallOption) (*CreateAccessTokenResponse, error)
+	// ListAccessTokens returns the personal access tokens of the user.
+	ListAccessTokens(ctx context.Context, in *ListAccessTokensRequest, opts ...grpc.CallOption) (*ListAccessTokensResponse, error)
+	// DeleteAccessToken revokes a personal access token of the user.
+	DeleteAccessToken(ctx context.Context, in *DeleteAccessTokenRequest, opts ...grpc.CallOption) (*DeleteAccessTokenResponse, error)
+	// ListSessions returns the sessions the users are logged in with. Users
+	// see their own sessions, admins can see those of any user.
+	ListSessions(ctx context.Context, in *ListSessionsRequest, opts ...grpc.CallOption) (*ListSessionsResponse, error)
+	// RevokeSessions logs a user out of all their sessions. Users can log
+	// themselves out, admins can log out any user.
+	RevokeSessions(ctx context.Context, in *RevokeSessionsRequest, opts ...grpc.CallOption) (*RevokeSessionsResponse, error)
 	// ListPolicies list policies available on the cluster
 	ListPolicies(ctx context.Context, in *ListPoliciesRequest, opts ...grpc.CallOption) (*ListPoliciesResponse, error)
 	// GetPolicy gets a policy by name
@@ -72,6 +131,11 @@ type CoreClient interface {
 	ListPolicyValidations(ctx context.Context, in *ListPolicyValidationsRequest, opts ...grpc.CallOption) (*ListPolicyValidationsResponse, error)
 	// GetPolicyValidation gets a policy validation by id
 	GetPolicyValidation(ctx context.Context, in *GetPolicyValidationRequest, opts ...grpc.CallOption) (*GetPolicyValidationResponse, error)
+	// GetPolicyValidationsSummary summarises the policy validations recorded
+	// by the server, which outlive their events: their counts by policy,
+	// severity, category, namespace, cluster and application over time
+	// windows, and the compliance score of the applications.
+	GetPolicyValidationsSummary(ctx context.Context, in *GetPolicyValidationsSummaryRequest, opts ...grpc.CallOption) (*GetPolicyValidationsSummaryResponse, error)
 }
 
 type coreClient struct {
@@ -100,6 +164,38 @@ func (c *coreClient) ListObjects(ctx context.Context, in *ListObjectsRequest, op
 	return out, nil
 }
 
+func (c *coreClient) WatchObjects(ctx context.Context, in *WatchObjectsRequest, opts ...grpc.CallOption) (Core_WatchObjectsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Core_ServiceDesc.Streams[0], "/gitops_core.v1.Core/WatchObjects", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &coreWatchObjectsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Core_WatchObjectsClient interface {
+	Recv() (*WatchObjectsResponse, error)
+	grpc.ClientStream
+}
+
+type coreWatchObjectsClient struct {
+	grpc.ClientStream
+}
+
+func (x *coreWatchObjectsClient) Recv() (*WatchObjectsResponse, error) {
+	m := new(WatchObjectsResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *coreClient) ListFluxRuntimeObjects(ctx context.Context, in *ListFluxRuntimeObjectsRequest, opts ...grpc.CallOption) (*ListFluxRuntimeObjectsResponse, error) {
 	out := new(ListFluxRuntimeObjectsResponse)
 	err := c.cc.Invoke(ctx, "/gitops_core.v1.Core/ListFluxRuntimeObjects", in, out, opts...)
@@ -172,6 +268,24 @@ func (c *coreClient) ListNamespaces(ctx context.Context, in *ListNamespacesReque
 	return out, nil
 }
 
+func (c *coreClient) ListClusters(ctx context.Context, in *ListClustersRequest, opts ...grpc.CallOption) (*ListClustersResponse, error) {
+	out := new(ListClustersResponse)
+	err := c.cc.Invoke(ctx, "/gitops_core.v1.Core/ListClusters", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *coreClient) GetUserPermissions(ctx context.Context, in *GetUserPermissionsRequest, opts ...grpc.CallOption) (*GetUserPermissionsResponse, error) {
+	out := new(GetUserPermissionsResponse)
+	err := c.cc.Invoke(ctx, "/gitops_core.v1.Core/GetUserPermissions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *coreClient) ListEvents(ctx context.Context, in *ListEventsRequest, opts ...grpc.CallOption) (*ListEventsResponse, error) {
 	out := new(ListEventsResponse)
 	err := c.cc.Invoke(ctx, "/gitops_core.v1.Core/ListEvents", in, out, opts...)
@@ -190,6 +304,38 @@ func (c *coreClient) SyncFluxObject(ctx context.Context, in *SyncFluxObjectReque
 	return out, nil
 }
 
+func (c *coreClient) SyncFluxObjectStream(ctx context.Context, in *SyncFluxObjectRequest, opts ...grpc.CallOption) (Core_SyncFluxObjectStreamClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Core_ServiceDesc.Streams[1], "/gitops_core.v1.Core/SyncFluxObjectStream", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &coreSyncFluxObjectStreamClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Core_SyncFluxObjectStreamClient interface {
+	Recv() (*SyncFluxObjectProgress, error)
+	grpc.ClientStream
+}
+
+type coreSyncFluxObjectStreamClient struct {
+	grpc.ClientStream
+}
+
+func (x *coreSyncFluxObjectStreamClient) Recv() (*SyncFluxObjectProgress, error) {
+	m := new(SyncFluxObjectProgress)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *coreClient) GetVersion(ctx context.Context, in *GetVersionRequest, opts ...grpc.CallOption) (*GetVersionResponse, error) {
 	out := new(GetVersionResponse)
 	err := c.cc.Invoke(ctx, "/gitops_core.v1.Core/GetVersion", in, out, opts...)
@@ -244,6 +390,114 @@ func (c *coreClient) GetInventory(ctx context.Context, in *GetInventoryRequest,
 	return out, nil
 }
 
+func (c *coreClient) GetDrift(ctx context.Context, in *GetDriftRequest, opts ...grpc.CallOption) (*GetDriftResponse, error) {
+	out := new(GetDriftResponse)
+	err := c.cc.Invoke(ctx, "/gitops_core.v1.Core/GetDrift", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *coreClient) GetDependencyGraph(ctx context.Context, in *GetDependencyGraphRequest, opts ...grpc.CallOption) (*GetDependencyGraphResponse, error) {
+	out := new(GetDependencyGraphResponse)
+	err := c.cc.Invoke(ctx, "/gitops_core.v1.Core/GetDependencyGraph", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *coreClient) GetObjectHistory(ctx context.Context, in *GetObjectHistoryRequest, opts ...grpc.CallOption) (*GetObjectHistoryResponse, error) {
+	out := new(GetObjectHistoryResponse)
+	err := c.cc.Invoke(ctx, "/gitops_core.v1.Core/GetObjectHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *coreClient) CreateFreeze(ctx context.Context, in *CreateFreezeRequest, opts ...grpc.CallOption) (*CreateFreezeResponse, error) {
+	out := new(CreateFreezeResponse)
+	err := c.cc.Invoke(ctx, "/gitops_core.v1.Core/CreateFreeze", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *coreClient) ListFreezes(ctx context.Context, in *ListFreezesRequest, opts ...grpc.CallOption) (*ListFreezesResponse, error) {
+	out := new(ListFreezesResponse)
+	err := c.cc.Invoke(ctx, "/gitops_core.v1.Core/ListFreezes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *coreClient) DeleteFreeze(ctx context.Context, in *DeleteFreezeRequest, opts ...grpc.CallOption) (*DeleteFreezeResponse, error) {
+	out := new(DeleteFreezeResponse)
+	err := c.cc.Invoke(ctx, "/gitops_core.v1.Core/DeleteFreeze", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *coreClient) ListAuditEvents(ctx context.Context, in *ListAuditEventsRequest, opts ...grpc.CallOption) (*ListAuditEventsResponse, error) {
+	out := new(ListAuditEventsResponse)
+	err := c.cc.Invoke(ctx, "/gitops_core.v1.Core/ListAuditEvents", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *coreClient) CreateAccessToken(ctx context.Context, in *CreateAccessTokenRequest, opts ...grpc.CallOption) (*CreateAccessTokenResponse, error) {
+	out := new(CreateAccessTokenResponse)
+	err := c.cc.Invoke(ctx, "/gitops_core.v1.Core/CreateAccessToken", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *coreClient) ListAccessTokens(ctx context.Context, in *ListAccessTokensRequest, opts ...grpc.CallOption) (*ListAccessTokensResponse, error) {
+	out := new(ListAccessTokensResponse)
+	err := c.cc.Invoke(ctx, "/gitops_core.v1.Core/ListAccessTokens", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *coreClient) DeleteAccessToken(ctx context.Context, in *DeleteAccessTokenRequest, opts ...grpc.CallOption) (*DeleteAccessTokenResponse, error) {
+	out := new(DeleteAccessTokenResponse)
+	err := c.cc.Invoke(ctx, "/gitops_core.v1.Core/DeleteAccessToken", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *coreClient) ListSessions(ctx context.Context, in *ListSessionsRequest, opts ...grpc.CallOption) (*ListSessionsResponse, error) {
+	out := new(ListSessionsResponse)
+	err := c.cc.Invoke(ctx, "/gitops_core.v1.Core/ListSessions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *coreClient) RevokeSessions(ctx context.Context, in *RevokeSessionsRequest, opts ...grpc.CallOption) (*RevokeSessionsResponse, error) {
+	out := new(RevokeSessionsResponse)
+	err := c.cc.Invoke(ctx, "/gitops_core.v1.Core/RevokeSessions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *coreClient) ListPolicies(ctx context.Context, in *ListPoliciesRequest, opts ...grpc.CallOption) (*ListPoliciesResponse, error) {
 	out := new(ListPoliciesResponse)
 	err := c.cc.Invoke(ctx, "/gitops_core.v1.Core/ListPolicies", in, out, opts...)
@@ -280,6 +534,15 @@ func (c *coreClient) GetPolicyValidation(ctx context.Context, in *GetPolicyValid
 	return out, nil
 }
 
+func (c *coreClient) GetPolicyValidationsSummary(ctx context.Context, in *GetPolicyValidationsSummaryRequest, opts ...grpc.CallOption) (*GetPolicyValidationsSummaryResponse, error) {
+	out := new(GetPolicyValidationsSummaryResponse)
+	err := c.cc.Invoke(ctx, "/gitops_core.v1.Core/GetPolicyValidationsSummary", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // CoreServer is the server API for Core service.
 // All implementations must embed UnimplementedCoreServer
 // for forward compatibility
@@ -288,6 +551,9 @@ type CoreServer interface {
 	GetObject(context.Context, *GetObjectRequest) (*GetObjectResponse, error)
 	// ListObjects gets data about primary objects.
 	ListObjects(context.Context, *ListObjectsRequest) (*ListObjectsResponse, error)
+	// WatchObjects streams changes to primary objects across all the clusters
+	// the user has access to.
+	WatchObjects(*WatchObjectsRequest, Core_WatchObjectsServer) error
 	// ListFluxRuntimeObjects lists the flux runtime deployments from a cluster.
 	ListFluxRuntimeObjects(context.Context, *ListFluxRuntimeObjectsRequest) (*ListFluxRuntimeObjectsResponse, error)
 	ListFluxCrds(context.Context, *ListFluxCrdsRequest) (*ListFluxCrdsResponse, error)
@@ -313,15 +579,27 @@ type CoreServer interface {
 	GetFluxNamespace(context.Context, *GetFluxNamespaceRequest) (*GetFluxNamespaceResponse, error)
 	// ListNamespaces returns with the list of available namespaces.
 	ListNamespaces(context.Context, *ListNamespacesRequest) (*ListNamespacesResponse, error)
+	// ListClusters returns the clusters with the outcome of their last
+	// probe, their status, the versions of Kubernetes and Flux they run and
+	// when they were last seen.
+	ListClusters(context.Context, *ListClustersRequest) (*ListClustersResponse, error)
+	// GetUserPermissions returns what the user can do with each Flux kind in
+	// the namespaces they can access, so that the actions they can't perform
+	// can be hidden.
+	GetUserPermissions(context.Context, *GetUserPermissionsRequest) (*GetUserPermissionsResponse, error)
 	// ListEvents returns with a list of events
 	ListEvents(context.Context, *ListEventsRequest) (*ListEventsResponse, error)
 	// SyncResource forces a reconciliation of a Flux resource
 	SyncFluxObject(context.Context, *SyncFluxObjectRequest) (*SyncFluxObjectResponse, error)
+	// SyncFluxObjectStream forces a reconciliation of Flux resources, and
+	// streams the stages each object goes through until it's ready.
+	SyncFluxObjectStream(*SyncFluxObjectRequest, Core_SyncFluxObjectStreamServer) error
 	// GetVersion returns version information about the server
 	GetVersion(context.Context, *GetVersionRequest) (*GetVersionResponse, error)
 	// GetFeatureFlags returns configuration information about the server
 	GetFeatureFlags(context.Context, *GetFeatureFlagsRequest) (*GetFeatureFlagsResponse, error)
-	// ToggleSuspendResource suspends or resumes a flux object.
+	// ToggleSuspendResource suspends or resumes flux objects, and reports
+	// the result for each of them.
 	ToggleSuspendResource(context.Context, *ToggleSuspendResourceRequest) (*ToggleSuspendResourceResponse, error)
 	// GetSessionLogs returns the logs for a given session
 	GetSessionLogs(context.Context, *GetSessionLogsRequest) (*GetSessionLogsResponse, error)
@@ -330,6 +608,50 @@ type CoreServer interface {
 	// installed or not on that cluster.
 	IsCRDAvailable(context.Context, *IsCRDAvailableRequest) (*IsCRDAvailableResponse, error)
 	GetInventory(context.Context, *GetInventoryRequest) (*GetInventoryResponse, error)
+	// GetDrift compares the manifests a Kustomization or HelmRelease would
+	// apply with the live objects on the cluster, and returns the fields that
+	// drifted. The manifests of a Kustomization are built from the artifact
+	// of its source. Those of a HelmRelease are the manifest of its latest
+	// release: changes to the chart or values that haven't been released yet
+	// aren't compared.
+	GetDrift(context.Context, *GetDriftRequest) (*GetDriftResponse, error)
+	// GetDependencyGraph returns the graph of Kustomizations, HelmReleases
+	// and their sources, with the dependencies between them, for a cluster
+	// or a namespace.
+	GetDependencyGraph(context.Context, *GetDependencyGraphRequest) (*GetDependencyGraphResponse, error)
+	// GetObjectHistory returns the timeline of a Flux object: revision
+	// changes, Ready condition changes, suspensions and sync requests.
+	GetObjectHistory(context.Context, *GetObjectHistoryRequest) (*GetObjectHistoryResponse, error)
+	// CreateFreeze creates a change freeze, suspending a selection of Flux
+	// objects during a one-off window or the recurring windows of a cron
+	// schedule. The objects are suspended and resumed as the user who
+	// created the freeze.
+	CreateFreeze(context.Context, *CreateFreezeRequest) (*CreateFreezeResponse, error)
+	// ListFreezes returns the change freezes.
+	ListFreezes(context.Context, *ListFreezesRequest) (*ListFreezesResponse, error)
+	// DeleteFreeze deletes a change freeze created by the user, resuming the
+	// objects it suspended.
+	DeleteFreeze(context.Context, *DeleteFreezeRequest) (*DeleteFreezeResponse, error)
+	// ListAuditEvents returns the audit events: the mutating calls and the
+	// logins and logouts, newest first. Only admins can list them. Those
+	// kept in the audit database are listed if the server has one, otherwise
+	// only the recent events kept in memory are. Either way, each replica of
+	// the server lists the events it handled.
+	ListAuditEvents(context.Context, *ListAuditEventsRequest) (*ListAuditEventsResponse, error)
+	// CreateAccessToken creates a personal access token acting as the user,
+	// optionally restricted to read-only access or to some clusters. The
+	// token is only returned once.
+	CreateAccessToken(context.Context, *CreateAccessTokenRequest) (*CreateAccessTokenResponse, error)
+	// ListAccessTokens returns the personal access tokens of the user.
+	ListAccessTokens(context.Context, *ListAccessTokensRequest) (*ListAccessTokensResponse, error)
+	// DeleteAccessToken revokes a personal access token of the user.
+	DeleteAccessToken(context.Context, *DeleteAccessTokenRequest) (*DeleteAccessTokenResponse, error)
+	// ListSessions returns the sessions the users are logged in with. Users
+	// see their own sessions, admins can see those of any user.
+	ListSessions(context.Context, *ListSessionsRequest) (*ListSessionsResponse, error)
+	// RevokeSessions logs a user out of all their sessions. Users can log
+	// themselves out, admins can log out any user.
+	RevokeSessions(context.Context, *RevokeSessionsRequest) (*RevokeSessionsResponse, error)
 	// ListPolicies list policies available on the cluster
 	ListPolicies(context.Context, *ListPoliciesRequest) (*ListPoliciesResponse, error)
 	// GetPolicy gets a policy by name
@@ -338,6 +660,11 @@ type CoreServer interface {
 	ListPolicyValidations(context.Context, *ListPolicyValidationsRequest) (*ListPolicyValidationsResponse, error)
 	// GetPolicyValidation gets a policy validation by id
 	GetPolicyValidation(context.Context, *GetPolicyValidationRequest) (*GetPolicyValidationResponse, error)
+	// GetPolicyValidationsSummary summarises the policy validations recorded
+	// by the server, which outlive their events: their counts by policy,
+	// severity, category, namespace, cluster and application over time
+	// windows, and the compliance score of the applications.
+	GetPolicyValidationsSummary(context.Context, *GetPolicyValidationsSummaryRequest) (*GetPolicyValidationsSummaryResponse, error)
 	mustEmbedUnimplementedCoreServer()
 }
 
@@ -351,6 +678,9 @@ func (UnimplementedCoreServer) GetObject(context.Context, *GetObjectRequest) (*G
 func (UnimplementedCoreServer) ListObjects(context.Context, *ListObjectsRequest) (*ListObjectsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListObjects not implemented")
 }
+func (UnimplementedCoreServer) WatchObjects(*WatchObjectsRequest, Core_WatchObjectsServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchObjects not implemented")
+}
 func (UnimplementedCoreServer) ListFluxRuntimeObjects(context.Context, *ListFluxRuntimeObjectsRequest) (*ListFluxRuntimeObjectsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListFluxRuntimeObjects not implemented")
 }
@@ -375,12 +705,21 @@ func (UnimplementedCoreServer) GetFluxNamespace(context.Context, *GetFluxNamespa
 func (UnimplementedCoreServer) ListNamespaces(context.Context, *ListNamespacesRequest) (*ListNamespacesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListNamespaces not implemented")
 }
+func (UnimplementedCoreServer) ListClusters(context.Context, *ListClustersRequest) (*ListClustersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListClusters not implemented")
+}
+func (UnimplementedCoreServer) GetUserPermissions(context.Context, *GetUserPermissionsRequest) (*GetUserPermissionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUserPermissions not implemented")
+}
 func (UnimplementedCoreServer) ListEvents(context.Context, *ListEventsRequest) (*ListEventsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListEvents not implemented")
 }
 func (UnimplementedCoreServer) SyncFluxObject(context.Context, *SyncFluxObjectRequest) (*SyncFluxObjectResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SyncFluxObject not implemented")
 }
+func (UnimplementedCoreServer) SyncFluxObjectStream(*SyncFluxObjectRequest, Core_SyncFluxObjectStreamServer) error {
+	return status.Errorf(codes.Unimplemented, "method SyncFluxObjectStream not implemented")
+}
 func (UnimplementedCoreServer) GetVersion(context.Context, *GetVersionRequest) (*GetVersionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetVersion not implemented")
 }
@@ -399,6 +738,42 @@ func (UnimplementedCoreServer) IsCRDAvailable(context.Context, *IsCRDAvailableRe
 func (UnimplementedCoreServer) GetInventory(context.Context, *GetInventoryRequest) (*GetInventoryResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetInventory not implemented")
 }
+func (UnimplementedCoreServer) GetDrift(context.Context, *GetDriftRequest) (*GetDriftResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDrift not implemented")
+}
+func (UnimplementedCoreServer) GetDependencyGraph(context.Context, *GetDependencyGraphRequest) (*GetDependencyGraphResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDependencyGraph not implemented")
+}
+func (UnimplementedCoreServer) GetObjectHistory(context.Context, *GetObjectHistoryRequest) (*GetObjectHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetObjectHistory not implemented")
+}
+func (UnimplementedCoreServer) CreateFreeze(context.Context, *CreateFreezeRequest) (*CreateFreezeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateFreeze not implemented")
+}
+func (UnimplementedCoreServer) ListFreezes(context.Context, *ListFreezesRequest) (*ListFreezesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListFreezes not implemented")
+}
+func (UnimplementedCoreServer) DeleteFreeze(context.Context, *DeleteFreezeRequest) (*DeleteFreezeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteFreeze not implemented")
+}
+func (UnimplementedCoreServer) ListAuditEvents(context.Context, *ListAuditEventsRequest) (*ListAuditEventsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAuditEvents not implemented")
+}
+func (UnimplementedCoreServer) CreateAccessToken(context.Context, *CreateAccessTokenRequest) (*CreateAccessTokenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateAccessToken not implemented")
+}
+func (UnimplementedCoreServer) ListAccessTokens(context.Context, *ListAccessTokensRequest) (*ListAccessTokensResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAccessTokens not implemented")
+}
+func (UnimplementedCoreServer) DeleteAccessToken(context.Context, *DeleteAccessTokenRequest) (*DeleteAccessTokenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteAccessToken not implemented")
+}
+func (UnimplementedCoreServer) ListSessions(context.Context, *ListSessionsRequest) (*ListSessionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSessions not implemented")
+}
+func (UnimplementedCoreServer) RevokeSessions(context.Context, *RevokeSessionsRequest) (*RevokeSessionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeSessions not implemented")
+}
 func (UnimplementedCoreServer) ListPolicies(context.Context, *ListPoliciesRequest) (*ListPoliciesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListPolicies not implemented")
 }
@@ -411,6 +786,9 @@ func (UnimplementedCoreServer) ListPolicyValidations(context.Context, *ListPolic
 func (UnimplementedCoreServer) GetPolicyValidation(context.Context, *GetPolicyValidationRequest) (*GetPolicyValidationResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetPolicyValidation not implemented")
 }
+func (UnimplementedCoreServer) GetPolicyValidationsSummary(context.Context, *GetPolicyValidationsSummaryRequest) (*GetPolicyValidationsSummaryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPolicyValidationsSummary not implemented")
+}
 func (UnimplementedCoreServer) mustEmbedUnimplementedCoreServer() {}
 
 // UnsafeCoreServer may be embedded to opt out of forward compatibility for this service.
@@ -460,6 +838,27 @@ func _Core_ListObjects_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Core_WatchObjects_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchObjectsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(CoreServer).WatchObjects(m, &coreWatchObjectsServer{stream})
+}
+
+type Core_WatchObjectsServer interface {
+	Send(*WatchObjectsResponse) error
+	grpc.ServerStream
+}
+
+type coreWatchObjectsServer struct {
+	grpc.ServerStream
+}
+
+func (x *coreWatchObjectsServer) Send(m *WatchObjectsResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 func _Core_ListFluxRuntimeObjects_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ListFluxRuntimeObjectsRequest)
 	if err := dec(in); err != nil {
@@ -604,6 +1003,42 @@ func _Core_ListNamespaces_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Core_ListClusters_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListClustersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoreServer).ListClusters(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gitops_core.v1.Core/ListClusters",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoreServer).ListClusters(ctx, req.(*ListClustersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Core_GetUserPermissions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserPermissionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoreServer).GetUserPermissions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gitops_core.v1.Core/GetUserPermissions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoreServer).GetUserPermissions(ctx, req.(*GetUserPermissionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Core_ListEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ListEventsRequest)
 	if err := dec(in); err != nil {
@@ -640,6 +1075,27 @@ func _Core_SyncFluxObject_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Core_SyncFluxObjectStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SyncFluxObjectRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(CoreServer).SyncFluxObjectStream(m, &coreSyncFluxObjectStreamServer{stream})
+}
+
+type Core_SyncFluxObjectStreamServer interface {
+	Send(*SyncFluxObjectProgress) error
+	grpc.ServerStream
+}
+
+type coreSyncFluxObjectStreamServer struct {
+	grpc.ServerStream
+}
+
+func (x *coreSyncFluxObjectStreamServer) Send(m *SyncFluxObjectProgress) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 func _Core_GetVersion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetVersionRequest)
 	if err := dec(in); err != nil {
@@ -748,6 +1204,222 @@ func _Core_GetInventory_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Core_GetDrift_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDriftRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoreServer).GetDrift(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gitops_core.v1.Core/GetDrift",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoreServer).GetDrift(ctx, req.(*GetDriftRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Core_GetDependencyGraph_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDependencyGraphRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoreServer).GetDependencyGraph(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gitops_core.v1.Core/GetDependencyGraph",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoreServer).GetDependencyGraph(ctx, req.(*GetDependencyGraphRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Core_GetObjectHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetObjectHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoreServer).GetObjectHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gitops_core.v1.Core/GetObjectHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoreServer).GetObjectHistory(ctx, req.(*GetObjectHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Core_CreateFreeze_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateFreezeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoreServer).CreateFreeze(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gitops_core.v1.Core/CreateFreeze",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoreServer).CreateFreeze(ctx, req.(*CreateFreezeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Core_ListFreezes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListFreezesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoreServer).ListFreezes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gitops_core.v1.Core/ListFreezes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoreServer).ListFreezes(ctx, req.(*ListFreezesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Core_DeleteFreeze_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteFreezeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoreServer).DeleteFreeze(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gitops_core.v1.Core/DeleteFreeze",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoreServer).DeleteFreeze(ctx, req.(*DeleteFreezeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Core_ListAuditEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAuditEventsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoreServer).ListAuditEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gitops_core.v1.Core/ListAuditEvents",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoreServer).ListAuditEvents(ctx, req.(*ListAuditEventsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Core_CreateAccessToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateAccessTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoreServer).CreateAccessToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gitops_core.v1.Core/CreateAccessToken",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoreServer).CreateAccessToken(ctx, req.(*CreateAccessTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Core_ListAccessTokens_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAccessTokensRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoreServer).ListAccessTokens(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gitops_core.v1.Core/ListAccessTokens",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoreServer).ListAccessTokens(ctx, req.(*ListAccessTokensRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Core_DeleteAccessToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteAccessTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoreServer).DeleteAccessToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gitops_core.v1.Core/DeleteAccessToken",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoreServer).DeleteAccessToken(ctx, req.(*DeleteAccessTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Core_ListSessions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSessionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoreServer).ListSessions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gitops_core.v1.Core/ListSessions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoreServer).ListSessions(ctx, req.(*ListSessionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Core_RevokeSessions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeSessionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoreServer).RevokeSessions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gitops_core.v1.Core/RevokeSessions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoreServer).RevokeSessions(ctx, req.(*RevokeSessionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Core_ListPolicies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ListPoliciesRequest)
 	if err := dec(in); err != nil {
@@ -820,6 +1492,24 @@ func _Core_GetPolicyValidation_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Core_GetPolicyValidationsSummary_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPolicyValidationsSummaryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoreServer).GetPolicyValidationsSummary(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gitops_core.v1.Core/GetPolicyValidationsSummary",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoreServer).GetPolicyValidationsSummary(ctx, req.(*GetPolicyValidationsSummaryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Core_ServiceDesc is the grpc.ServiceDesc for Core service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -867,6 +1557,14 @@ var Core_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListNamespaces",
 			Handler:    _Core_ListNamespaces_Handler,
 		},
+		{
+			MethodName: "ListClusters",
+			Handler:    _Core_ListClusters_Handler,
+		},
+		{
+			MethodName: "GetUserPermissions",
+			Handler:    _Core_GetUserPermissions_Handler,
+		},
 		{
 			MethodName: "ListEvents",
 			Handler:    _Core_ListEvents_Handler,
@@ -899,6 +1597,54 @@ var Core_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetInventory",
 			Handler:    _Core_GetInventory_Handler,
 		},
+		{
+			MethodName: "GetDrift",
+			Handler:    _Core_GetDrift_Handler,
+		},
+		{
+			MethodName: "GetDependencyGraph",
+			Handler:    _Core_GetDependencyGraph_Handler,
+		},
+		{
+			MethodName: "GetObjectHistory",
+			Handler:    _Core_GetObjectHistory_Handler,
+		},
+		{
+			MethodName: "CreateFreeze",
+			Handler:    _Core_CreateFreeze_Handler,
+		},
+		{
+			MethodName: "ListFreezes",
+			Handler:    _Core_ListFreezes_Handler,
+		},
+		{
+			MethodName: "DeleteFreeze",
+			Handler:    _Core_DeleteFreeze_Handler,
+		},
+		{
+			MethodName: "ListAuditEvents",
+			Handler:    _Core_ListAuditEvents_Handler,
+		},
+		{
+			MethodName: "CreateAccessToken",
+			Handler:    _Core_CreateAccessToken_Handler,
+		},
+		{
+			MethodName: "ListAccessTokens",
+			Handler:    _Core_ListAccessTokens_Handler,
+		},
+		{
+			MethodName: "DeleteAccessToken",
+			Handler:    _Core_DeleteAccessToken_Handler,
+		},
+		{
+			MethodName: "ListSessions",
+			Handler:    _Core_ListSessions_Handler,
+		},
+		{
+			MethodName: "RevokeSessions",
+			Handler:    _Core_RevokeSessions_Handler,
+		},
 		{
 			MethodName: "ListPolicies",
 			Handler:    _Core_ListPolicies_Handler,
@@ -915,7 +1661,22 @@ var Core_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetPolicyValidation",
 			Handler:    _Core_GetPolicyValidation_Handler,
 		},
+		{
+			MethodName: "GetPolicyValidationsSummary",
+			Handler:    _Core_GetPolicyValidationsSummary_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchObjects",
+			Handler:       _Core_WatchObjects_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "SyncFluxObjectStream",
+			Handler:       _Core_SyncFluxObjectStream_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "api/core/core.proto",
 }
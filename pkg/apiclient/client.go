@@ -0,0 +1,297 @@
+// Package apiclient is a client for the Weave GitOps HTTP API, used by the
+// CLI to act on the objects of the clusters the server manages.
+package apiclient
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/cookiejar"
+	"net/url"
+	"strings"
+
+	pb "github.com/weaveworks/weave-gitops/pkg/api/core"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+// Options configure the client.
+type Options struct {
+	// Endpoint is the URL of the server, including any route prefix.
+	Endpoint string
+	// Username and Password sign in with the cluster user account, the
+	// requests are anonymous without them.
+	Username string
+	Password string
+	// Token is a personal access token authenticating the requests instead
+	// of the username and password.
+	Token string
+
+	InsecureSkipTLSVerify bool
+}
+
+// Client sends requests to the API, signing in on the first one.
+type Client struct {
+	endpoint *url.URL
+	http     *http.Client
+	username string
+	password string
+	token    string
+	signedIn bool
+}
+
+// Error is returned when the API responds with an error.
+type Error struct {
+	StatusCode int
+	Message    string
+}
+
+func (e *Error) Error() string {
+	if e.Message == "" {
+		return http.StatusText(e.StatusCode)
+	}
+
+	return e.Message
+}
+
+func New(opts Options) (*Client, error) {
+	if opts.Endpoint == "" {
+		return nil, errors.New("no endpoint, set one with --endpoint or WEAVE_GITOPS_ENTERPRISE_API_URL")
+	}
+
+	endpoint, err := url.Parse(strings.TrimSuffix(opts.Endpoint, "/"))
+	if err != nil {
+		return nil, fmt.Errorf("invalid endpoint: %w", err)
+	}
+
+	if endpoint.Scheme != "http" && endpoint.Scheme != "https" {
+		return nil, fmt.Errorf("invalid endpoint %q: the scheme must be http or https", opts.Endpoint)
+	}
+
+	jar, err := cookiejar.New(nil)
+	if err != nil {
+		return nil, err
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	if opts.InsecureSkipTLSVerify {
+		transport.TLSClientConfig = &tls.Config{InsecureSkipVerify: true} //nolint:gosec // the user asked for it
+	}
+
+	return &Client{
+		endpoint: endpoint,
+		http:     &http.Client{Jar: jar, Transport: transport},
+		username: opts.Username,
+		password: opts.Password,
+		token:    opts.Token,
+	}, nil
+}
+
+// Do sends a request to an API path, e.g. /v1/suspend, and decodes the
+// response into res. The request message is sent as the body, except for GET
+// and DELETE requests which pass their parameters in the path and query.
+func (c *Client) Do(ctx context.Context, method, path string, query url.Values, req, res proto.Message) error {
+	if err := c.signIn(ctx); err != nil {
+		return err
+	}
+
+	u := c.url(path)
+	u.RawQuery = query.Encode()
+
+	var body io.Reader
+
+	if req != nil && method != http.MethodGet && method != http.MethodDelete {
+		data, err := protojson.Marshal(req)
+		if err != nil {
+			return fmt.Errorf("encoding request: %w", err)
+		}
+
+		body = bytes.NewReader(data)
+	}
+
+	httpReq, err := http.NewRequestWithContext(ctx, method, u.String(), body)
+	if err != nil {
+		return err
+	}
+
+	httpReq.Header.Set("Accept", "application/json")
+
+	if c.token != "" {
+		httpReq.Header.Set("Authorization", "Bearer "+c.token)
+	}
+
+	if body != nil {
+		httpReq.Header.Set("Content-Type", "application/json")
+	}
+
+	data, err := c.send(httpReq)
+	if err != nil {
+		return err
+	}
+
+	if res == nil {
+		return nil
+	}
+
+	if err := (protojson.UnmarshalOptions{DiscardUnknown: true}).Unmarshal(data, res); err != nil {
+		return fmt.Errorf("decoding response: %w", err)
+	}
+
+	return nil
+}
+
+func (c *Client) GetObject(ctx context.Context, req *pb.GetObjectRequest) (*pb.GetObjectResponse, error) {
+	res := &pb.GetObjectResponse{}
+
+	query := url.Values{
+		"namespace":   {req.Namespace},
+		"kind":        {req.Kind},
+		"clusterName": {req.ClusterName},
+	}
+
+	return res, c.Do(ctx, http.MethodGet, "/v1/object/"+req.Name, query, req, res)
+}
+
+func (c *Client) ListObjects(ctx context.Context, req *pb.ListObjectsRequest) (*pb.ListObjectsResponse, error) {
+	res := &pb.ListObjectsResponse{}
+
+	return res, c.Do(ctx, http.MethodPost, "/v1/objects", nil, req, res)
+}
+
+func (c *Client) SyncFluxObject(ctx context.Context, req *pb.SyncFluxObjectRequest) (*pb.SyncFluxObjectResponse, error) {
+	res := &pb.SyncFluxObjectResponse{}
+
+	return res, c.Do(ctx, http.MethodPost, "/v1/sync", nil, req, res)
+}
+
+func (c *Client) ToggleSuspendResource(ctx context.Context, req *pb.ToggleSuspendResourceRequest) (*pb.ToggleSuspendResourceResponse, error) {
+	res := &pb.ToggleSuspendResourceResponse{}
+
+	return res, c.Do(ctx, http.MethodPost, "/v1/suspend", nil, req, res)
+}
+
+func (c *Client) CreateFreeze(ctx context.Context, req *pb.CreateFreezeRequest) (*pb.CreateFreezeResponse, error) {
+	res := &pb.CreateFreezeResponse{}
+
+	return res, c.Do(ctx, http.MethodPost, "/v1/freezes", nil, req, res)
+}
+
+func (c *Client) ListFreezes(ctx context.Context, req *pb.ListFreezesRequest) (*pb.ListFreezesResponse, error) {
+	res := &pb.ListFreezesResponse{}
+
+	return res, c.Do(ctx, http.MethodGet, "/v1/freezes", nil, req, res)
+}
+
+func (c *Client) DeleteFreeze(ctx context.Context, req *pb.DeleteFreezeRequest) (*pb.DeleteFreezeResponse, error) {
+	res := &pb.DeleteFreezeResponse{}
+
+	return res, c.Do(ctx, http.MethodDelete, "/v1/freezes/"+req.Name, nil, req, res)
+}
+
+func (c *Client) CreateAccessToken(ctx context.Context, req *pb.CreateAccessTokenRequest) (*pb.CreateAccessTokenResponse, error) {
+	res := &pb.CreateAccessTokenResponse{}
+
+	return res, c.Do(ctx, http.MethodPost, "/v1/access_tokens", nil, req, res)
+}
+
+func (c *Client) ListAccessTokens(ctx context.Context, req *pb.ListAccessTokensRequest) (*pb.ListAccessTokensResponse, error) {
+	res := &pb.ListAccessTokensResponse{}
+
+	return res, c.Do(ctx, http.MethodGet, "/v1/access_tokens", nil, req, res)
+}
+
+func (c *Client) DeleteAccessToken(ctx context.Context, req *pb.DeleteAccessTokenRequest) (*pb.DeleteAccessTokenResponse, error) {
+	res := &pb.DeleteAccessTokenResponse{}
+
+	return res, c.Do(ctx, http.MethodDelete, "/v1/access_tokens/"+url.PathEscape(req.Id), nil, req, res)
+}
+
+func (c *Client) ListSessions(ctx context.Context, req *pb.ListSessionsRequest) (*pb.ListSessionsResponse, error) {
+	res := &pb.ListSessionsResponse{}
+
+	return res, c.Do(ctx, http.MethodGet, "/v1/sessions", url.Values{"userId": {req.UserId}}, req, res)
+}
+
+func (c *Client) RevokeSessions(ctx context.Context, req *pb.RevokeSessionsRequest) (*pb.RevokeSessionsResponse, error) {
+	res := &pb.RevokeSessionsResponse{}
+
+	return res, c.Do(ctx, http.MethodDelete, "/v1/sessions", url.Values{"userId": {req.UserId}}, req, res)
+}
+
+// signIn signs in with the username and password once, the session cookie
+// authenticates the following requests. There's no need to sign in with an
+// access token.
+func (c *Client) signIn(ctx context.Context) error {
+	if c.signedIn || c.username == "" || c.token != "" {
+		return nil
+	}
+
+	data, err := json.Marshal(map[string]string{
+		"username": c.username,
+		"password": c.password,
+	})
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.url("/oauth2/sign_in").String(), bytes.NewReader(data))
+	if err != nil {
+		return err
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+
+	if _, err := c.send(req); err != nil {
+		return fmt.Errorf("signing in as %s: %w", c.username, err)
+	}
+
+	c.signedIn = true
+
+	return nil
+}
+
+// send sends a request and returns the body of a successful response.
+func (c *Client) send(req *http.Request) ([]byte, error) {
+	res, err := c.http.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	data, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, fmt.Errorf("reading response: %w", err)
+	}
+
+	if res.StatusCode < 200 || res.StatusCode > 299 {
+		apiErr := &Error{StatusCode: res.StatusCode}
+
+		// The API errors are grpc-gateway statuses, other errors are plain
+		// text.
+		status := struct {
+			Message string `json:"message"`
+		}{}
+
+		if json.Unmarshal(data, &status) == nil && status.Message != "" {
+			apiErr.Message = status.Message
+		} else {
+			apiErr.Message = strings.TrimSpace(string(data))
+		}
+
+		return nil, apiErr
+	}
+
+	return data, nil
+}
+
+func (c *Client) url(path string) *url.URL {
+	u := *c.endpoint
+	u.Path += path
+
+	return &u
+}
@@ -0,0 +1,133 @@
+package apiclient_test
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	pb "github.com/weaveworks/weave-gitops/pkg/api/core"
+	"github.com/weaveworks/weave-gitops/pkg/apiclient"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+func TestClient(t *testing.T) {
+	g := NewGomegaWithT(t)
+	ctx := context.Background()
+
+	signIns := 0
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/prefix/oauth2/sign_in", func(w http.ResponseWriter, r *http.Request) {
+		login := map[string]string{}
+		_ = json.NewDecoder(r.Body).Decode(&login)
+
+		if login["username"] != "admin" || login["password"] != "secret" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+
+		signIns++
+
+		http.SetCookie(w, &http.Cookie{Name: "session", Value: "token", Path: "/"})
+	})
+	mux.HandleFunc("/prefix/v1/suspend", func(w http.ResponseWriter, r *http.Request) {
+		if _, err := r.Cookie("session"); err != nil {
+			w.WriteHeader(http.StatusUnauthorized)
+			_, _ = w.Write([]byte(`{"code":16,"message":"unauthenticated"}`))
+
+			return
+		}
+
+		data, _ := io.ReadAll(r.Body)
+
+		req := &pb.ToggleSuspendResourceRequest{}
+		g.Expect(protojson.Unmarshal(data, req)).To(Succeed())
+
+		res, _ := protojson.Marshal(&pb.ToggleSuspendResourceResponse{
+			Results: []*pb.ObjectResult{{Object: req.Objects[0], Status: "Success"}},
+		})
+		_, _ = w.Write(res)
+	})
+	mux.HandleFunc("/prefix/v1/object/podinfo", func(w http.ResponseWriter, r *http.Request) {
+		g.Expect(r.Method).To(Equal(http.MethodGet))
+		g.Expect(r.URL.Query().Get("namespace")).To(Equal("flux-system"))
+		g.Expect(r.URL.Query().Get("kind")).To(Equal("Kustomization"))
+		g.Expect(r.URL.Query().Get("clusterName")).To(Equal("Default"))
+
+		_, _ = w.Write([]byte(`{"object":{"payload":"{}","clusterName":"Default","unknown":true}}`))
+	})
+	mux.HandleFunc("/prefix/v1/freezes/weekends", func(w http.ResponseWriter, r *http.Request) {
+		g.Expect(r.Method).To(Equal(http.MethodDelete))
+
+		w.WriteHeader(http.StatusForbidden)
+		_, _ = w.Write([]byte(`{"code":7,"message":"freeze \"weekends\" was created by anne"}`))
+	})
+	mux.HandleFunc("/prefix/v1/access_tokens", func(w http.ResponseWriter, r *http.Request) {
+		g.Expect(r.Method).To(Equal(http.MethodGet))
+
+		if r.Header.Get("Authorization") != "Bearer wgpat_abc_secret" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+
+		_, _ = w.Write([]byte(`{"accessTokens":[{"id":"abc","name":"ci"}]}`))
+	})
+
+	srv := httptest.NewServer(mux)
+	defer srv.Close()
+
+	c, err := apiclient.New(apiclient.Options{Endpoint: srv.URL + "/prefix/", Username: "admin", Password: "secret"})
+	g.Expect(err).NotTo(HaveOccurred())
+
+	ref := &pb.ObjectRef{Kind: "Kustomization", Name: "podinfo", Namespace: "flux-system", ClusterName: "Default"}
+
+	for i := 0; i < 2; i++ {
+		res, err := c.ToggleSuspendResource(ctx, &pb.ToggleSuspendResourceRequest{Objects: []*pb.ObjectRef{ref}, Suspend: true})
+		g.Expect(err).NotTo(HaveOccurred())
+		g.Expect(res.Results).To(HaveLen(1))
+		g.Expect(res.Results[0].Object.Name).To(Equal("podinfo"))
+	}
+
+	g.Expect(signIns).To(Equal(1))
+
+	obj, err := c.GetObject(ctx, &pb.GetObjectRequest{Name: "podinfo", Namespace: "flux-system", Kind: "Kustomization", ClusterName: "Default"})
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(obj.Object.ClusterName).To(Equal("Default"))
+
+	_, err = c.DeleteFreeze(ctx, &pb.DeleteFreezeRequest{Name: "weekends"})
+	g.Expect(err).To(MatchError(`freeze "weekends" was created by anne`))
+	g.Expect(err).To(BeAssignableToTypeOf(&apiclient.Error{}))
+	g.Expect(err.(*apiclient.Error).StatusCode).To(Equal(http.StatusForbidden))
+
+	// Anonymous requests aren't signed in.
+	c, err = apiclient.New(apiclient.Options{Endpoint: srv.URL + "/prefix"})
+	g.Expect(err).NotTo(HaveOccurred())
+
+	_, err = c.ToggleSuspendResource(ctx, &pb.ToggleSuspendResourceRequest{Objects: []*pb.ObjectRef{ref}})
+	g.Expect(err).To(MatchError("unauthenticated"))
+
+	c, err = apiclient.New(apiclient.Options{Endpoint: srv.URL, Username: "admin", Password: "wrong"})
+	g.Expect(err).NotTo(HaveOccurred())
+
+	_, err = c.ListFreezes(ctx, &pb.ListFreezesRequest{})
+	g.Expect(err).To(MatchError(ContainSubstring("signing in as admin")))
+
+	// Access tokens authenticate every request, without signing in.
+	signIns = 0
+
+	c, err = apiclient.New(apiclient.Options{Endpoint: srv.URL + "/prefix", Username: "admin", Password: "secret", Token: "wgpat_abc_secret"})
+	g.Expect(err).NotTo(HaveOccurred())
+
+	tokens, err := c.ListAccessTokens(ctx, &pb.ListAccessTokensRequest{})
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(tokens.AccessTokens).To(HaveLen(1))
+	g.Expect(tokens.AccessTokens[0].Name).To(Equal("ci"))
+	g.Expect(signIns).To(Equal(0))
+
+	_, err = apiclient.New(apiclient.Options{Endpoint: "localhost:9001"})
+	g.Expect(err).To(HaveOccurred())
+}
@@ -0,0 +1,270 @@
+// Package fluxbuild builds the manifests of Flux Kustomizations from their
+// source like kustomize-controller does, to tell what they apply without
+// applying them.
+package fluxbuild
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+
+	kustomizev1 "github.com/fluxcd/kustomize-controller/api/v1"
+	"github.com/fluxcd/pkg/ssa"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/kustomize/api/konfig"
+	"sigs.k8s.io/kustomize/api/krusty"
+	kustypes "sigs.k8s.io/kustomize/api/types"
+	"sigs.k8s.io/kustomize/kyaml/filesys"
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	substituteAnnotation = "kustomize.toolkit.fluxcd.io/substitute"
+	substituteDisabled   = "disabled"
+)
+
+// varPattern matches ${var}, ${var:=default} and ${var:-default}.
+var varPattern = regexp.MustCompile(`\$\{([a-zA-Z_][a-zA-Z0-9_]*)(?::[=-]([^}]*))?\}`)
+
+// Build builds the path of a Kustomization in its source with kustomize,
+// applying the overrides of the Kustomization spec. A kustomization.yaml
+// is generated in the path if it has none, so the source must be a copy.
+func Build(sourceDir string, spec *kustomizev1.KustomizationSpec) ([]*unstructured.Unstructured, error) {
+	// filepath.Join with a rooted path can't escape the source directory.
+	path := filepath.Join(sourceDir, filepath.Clean("/"+spec.Path))
+
+	if _, err := os.Stat(path); err != nil {
+		return nil, fmt.Errorf("kustomization path not found: %w", err)
+	}
+
+	if err := EnsureKustomizationFile(path); err != nil {
+		return nil, err
+	}
+
+	// The overrides are applied by an overlay outside of the source, rather
+	// than inside it, so it never is an ancestor of the path.
+	overlayDir, err := os.MkdirTemp("", "fluxbuild-")
+	if err != nil {
+		return nil, err
+	}
+	defer os.RemoveAll(overlayDir)
+
+	resource, err := filepath.Rel(overlayDir, path)
+	if err != nil {
+		return nil, err
+	}
+
+	overlay := map[string]interface{}{
+		"apiVersion": kustypes.KustomizationVersion,
+		"kind":       kustypes.KustomizationKind,
+		"resources":  []string{resource},
+	}
+
+	if spec.TargetNamespace != "" {
+		overlay["namespace"] = spec.TargetNamespace
+	}
+
+	if len(spec.Patches) > 0 {
+		overlay["patches"] = spec.Patches
+	}
+
+	if len(spec.Images) > 0 {
+		overlay["images"] = spec.Images
+	}
+
+	if len(spec.Components) > 0 {
+		components := []string{}
+
+		for _, c := range spec.Components {
+			component, err := filepath.Rel(overlayDir, filepath.Join(path, c))
+			if err != nil {
+				return nil, err
+			}
+
+			components = append(components, component)
+		}
+
+		overlay["components"] = components
+	}
+
+	data, err := yaml.Marshal(overlay)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := os.WriteFile(filepath.Join(overlayDir, konfig.DefaultKustomizationFileName()), data, 0o600); err != nil {
+		return nil, err
+	}
+
+	return BuildDir(overlayDir)
+}
+
+// BuildDir builds a directory with a kustomization.yaml with kustomize.
+func BuildDir(dir string) ([]*unstructured.Unstructured, error) {
+	resMap, err := krusty.MakeKustomizer(krusty.MakeDefaultOptions()).Run(filesys.MakeFsOnDisk(), dir)
+	if err != nil {
+		return nil, fmt.Errorf("kustomize build failed: %w", err)
+	}
+
+	manifests, err := resMap.AsYaml()
+	if err != nil {
+		return nil, fmt.Errorf("kustomize build failed: %w", err)
+	}
+
+	return ssa.ReadObjects(bytes.NewReader(manifests))
+}
+
+// EnsureKustomizationFile generates a kustomization.yaml listing the
+// manifests of a directory that doesn't have one, like kustomize-controller.
+func EnsureKustomizationFile(path string) error {
+	if HasKustomizationFile(path) {
+		return nil
+	}
+
+	resources := []string{}
+
+	err := filepath.WalkDir(path, func(p string, d os.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if d.IsDir() {
+			if p != path && HasKustomizationFile(p) {
+				rel, err := filepath.Rel(path, p)
+				if err != nil {
+					return err
+				}
+
+				resources = append(resources, rel)
+
+				return filepath.SkipDir
+			}
+
+			return nil
+		}
+
+		if ext := filepath.Ext(p); ext != ".yaml" && ext != ".yml" {
+			return nil
+		}
+
+		data, err := os.ReadFile(p)
+		if err != nil {
+			return err
+		}
+
+		// Skip the files that aren't Kubernetes manifests, e.g. config
+		// files of other tools.
+		if objects, err := ssa.ReadObjects(bytes.NewReader(data)); err != nil || len(objects) == 0 {
+			return nil
+		}
+
+		rel, err := filepath.Rel(path, p)
+		if err != nil {
+			return err
+		}
+
+		resources = append(resources, rel)
+
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("generating kustomization: %w", err)
+	}
+
+	data, err := yaml.Marshal(map[string]interface{}{
+		"apiVersion": kustypes.KustomizationVersion,
+		"kind":       kustypes.KustomizationKind,
+		"resources":  resources,
+	})
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(filepath.Join(path, konfig.DefaultKustomizationFileName()), data, 0o600)
+}
+
+// HasKustomizationFile tells whether a directory has a kustomization file.
+func HasKustomizationFile(dir string) bool {
+	for _, name := range konfig.RecognizedKustomizationFileNames() {
+		if _, err := os.Stat(filepath.Join(dir, name)); err == nil {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Substitute replaces the variables in an object, unless it opted out with
+// the substitute annotation. The variables without a value are replaced by
+// their default, if any. Nil variables, those of Kustomizations without post
+// build substitutions, leave the object alone.
+func Substitute(obj *unstructured.Unstructured, vars map[string]string) (*unstructured.Unstructured, error) {
+	if vars == nil || substitutionDisabled(obj) {
+		return obj, nil
+	}
+
+	data, err := yaml.Marshal(obj.Object)
+	if err != nil {
+		return nil, err
+	}
+
+	substituted := varPattern.ReplaceAllStringFunc(string(data), func(match string) string {
+		groups := varPattern.FindStringSubmatch(match)
+		if value, ok := vars[groups[1]]; ok {
+			return value
+		}
+
+		return groups[2]
+	})
+
+	result, err := ssa.ReadObject(strings.NewReader(substituted))
+	if err != nil {
+		return nil, fmt.Errorf("substituting variables in %s: %w", ssa.FmtUnstructured(obj), err)
+	}
+
+	return result, nil
+}
+
+// MissingVariables returns the sorted variables an object refers to which
+// have neither a value nor a default, unless it opted out of substitutions.
+func MissingVariables(obj *unstructured.Unstructured, vars map[string]string) ([]string, error) {
+	if substitutionDisabled(obj) {
+		return nil, nil
+	}
+
+	data, err := yaml.Marshal(obj.Object)
+	if err != nil {
+		return nil, err
+	}
+
+	seen := map[string]bool{}
+
+	var missing []string
+
+	for _, groups := range varPattern.FindAllStringSubmatch(string(data), -1) {
+		name := groups[1]
+
+		// the pattern only tells an empty default from no default by the
+		// operator following the name
+		hasDefault := strings.HasPrefix(groups[0], "${"+name+":")
+
+		if _, ok := vars[name]; ok || hasDefault || seen[name] {
+			continue
+		}
+
+		seen[name] = true
+		missing = append(missing, name)
+	}
+
+	sort.Strings(missing)
+
+	return missing, nil
+}
+
+func substitutionDisabled(obj *unstructured.Unstructured) bool {
+	return obj.GetAnnotations()[substituteAnnotation] == substituteDisabled
+}
@@ -0,0 +1,105 @@
+package fluxbuild_test
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	kustomizev1 "github.com/fluxcd/kustomize-controller/api/v1"
+	"github.com/fluxcd/pkg/apis/kustomize"
+	"github.com/fluxcd/pkg/ssa"
+	. "github.com/onsi/gomega"
+	"github.com/weaveworks/weave-gitops/pkg/fluxbuild"
+)
+
+const (
+	testDeployment = `apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: podinfo
+spec:
+  replicas: ${replicas:=1}
+  template:
+    spec:
+      containers:
+      - name: podinfo
+        image: ghcr.io/stefanprodan/podinfo:${version}
+        env:
+        - name: REGION
+          value: ${region:-}
+        - name: ZONE
+          value: ${zone}
+`
+	testConfigMap = `apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: podinfo-config
+  annotations:
+    kustomize.toolkit.fluxcd.io/substitute: disabled
+data:
+  greeting: ${greeting}
+`
+)
+
+func TestBuild(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	source := t.TempDir()
+	g.Expect(os.MkdirAll(filepath.Join(source, "apps", "podinfo"), 0o755)).To(Succeed())
+	g.Expect(os.WriteFile(filepath.Join(source, "apps", "podinfo", "configmap.yaml"), []byte(testConfigMap), 0o644)).To(Succeed())
+	g.Expect(os.WriteFile(filepath.Join(source, "apps", "podinfo", "values.txt"), []byte("not a manifest"), 0o644)).To(Succeed())
+
+	objects, err := fluxbuild.Build(source, &kustomizev1.KustomizationSpec{
+		Path:            "./apps/podinfo",
+		TargetNamespace: "podinfo",
+		Images:          []kustomize.Image{{Name: "podinfo", NewTag: "6.1.0"}},
+	})
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(objects).To(HaveLen(1))
+	g.Expect(objects[0].GetNamespace()).To(Equal("podinfo"))
+	g.Expect(fluxbuild.HasKustomizationFile(filepath.Join(source, "apps", "podinfo"))).To(BeTrue())
+
+	_, err = fluxbuild.Build(source, &kustomizev1.KustomizationSpec{Path: "./apps/missing"})
+	g.Expect(err).To(MatchError(ContainSubstring("kustomization path not found")))
+}
+
+func TestSubstitute(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	deployment, err := ssa.ReadObject(strings.NewReader(testDeployment))
+	g.Expect(err).NotTo(HaveOccurred())
+
+	substituted, err := fluxbuild.Substitute(deployment, map[string]string{"replicas": "3", "version": "6.0.0"})
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(substituted.Object["spec"]).To(HaveKeyWithValue("replicas", int64(3)))
+
+	substituted, err = fluxbuild.Substitute(deployment, map[string]string{"version": "6.0.0"})
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(substituted.Object["spec"]).To(HaveKeyWithValue("replicas", int64(1)))
+
+	configMap, err := ssa.ReadObject(strings.NewReader(testConfigMap))
+	g.Expect(err).NotTo(HaveOccurred())
+
+	substituted, err = fluxbuild.Substitute(configMap, map[string]string{"greeting": "hello"})
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(substituted.Object["data"]).To(HaveKeyWithValue("greeting", "${greeting}"))
+}
+
+func TestMissingVariables(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	deployment, err := ssa.ReadObject(strings.NewReader(testDeployment))
+	g.Expect(err).NotTo(HaveOccurred())
+
+	missing, err := fluxbuild.MissingVariables(deployment, map[string]string{"version": "6.0.0"})
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(missing).To(Equal([]string{"zone"}), "the variables with a default aren't missing")
+
+	configMap, err := ssa.ReadObject(strings.NewReader(testConfigMap))
+	g.Expect(err).NotTo(HaveOccurred())
+
+	missing, err = fluxbuild.MissingVariables(configMap, nil)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(missing).To(BeEmpty())
+}
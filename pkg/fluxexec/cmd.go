@@ -40,11 +40,41 @@ func (flux *Flux) buildFluxCmd(ctx context.Context, mergeEnv map[string]string,
 
 	cmd.Dir = flux.workingDir
 
-	flux.logger.V(logger.LogLevelInfo).Info(fmt.Sprintf("Running Flux command: %s", cmd.String()))
+	flux.logger.V(logger.LogLevelInfo).Info(fmt.Sprintf("Running Flux command: %s", strings.Join(redactArgs(cmd.Args), " ")))
 
 	return cmd
 }
 
+// secretFlags are the flags whose values are redacted from the logs.
+var secretFlags = map[string]bool{
+	"--password":       true,
+	"--token":          true,
+	"--gpg-passphrase": true,
+}
+
+// redactArgs returns the args with the values of the secret flags redacted.
+func redactArgs(args []string) []string {
+	redacted := make([]string, len(args))
+	copy(redacted, args)
+
+	for i := 0; i < len(redacted); i++ {
+		if flag, _, found := strings.Cut(redacted[i], "="); found {
+			if secretFlags[flag] {
+				redacted[i] = flag + "=*****"
+			}
+
+			continue
+		}
+
+		if secretFlags[redacted[i]] && i+1 < len(redacted) {
+			redacted[i+1] = "*****"
+			i++
+		}
+	}
+
+	return redacted
+}
+
 func writeOutput(ctx context.Context, r io.ReadCloser, log logr.Logger) error {
 	// ReadBytes will block until bytes are read, which can cause a delay in
 	// returning even if the command's context has been canceled. Use a separate
@@ -0,0 +1,37 @@
+package fluxexec
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("redactArgs", func() {
+	It("should redact the values of the secret flags", func() {
+		args := []string{"/path/to/flux", "bootstrap", "git", "--token", "t", "--password", "p", "--url", "https://example.com/fleet.git"}
+
+		Expect(redactArgs(args)).To(Equal([]string{
+			"/path/to/flux",
+			"bootstrap",
+			"git",
+			"--token", "*****",
+			"--password", "*****",
+			"--url", "https://example.com/fleet.git",
+		}))
+		Expect(args[4]).To(Equal("t"))
+	})
+
+	It("should redact the values of the secret flags given with an equals sign", func() {
+		args := []string{"/path/to/flux", "bootstrap", "git", "--password=p", "--gpg-passphrase=s=cret", "--token=", "--url=https://example.com/fleet.git"}
+
+		Expect(redactArgs(args)).To(Equal([]string{
+			"/path/to/flux",
+			"bootstrap",
+			"git",
+			"--password=*****",
+			"--gpg-passphrase=*****",
+			"--token=*****",
+			"--url=https://example.com/fleet.git",
+		}))
+		Expect(args[3]).To(Equal("--password=p"))
+	})
+})
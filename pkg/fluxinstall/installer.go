@@ -29,21 +29,30 @@ func (i *Installer) Ensure(ctx context.Context, sources ...src.Source) (string,
 			i.removableSources = append(i.removableSources, s)
 		}
 
-		switch s := source.(type) {
-		case src.Findable:
-			execPath, err := s.Find(ctx)
-			if err != nil {
-				if errors.IsErrorSkippable(err) {
-					errs = multierror.Append(errs, err)
-					continue
-				}
+		findable, isFindable := source.(src.Findable)
+		installable, isInstallable := source.(src.Installable)
+
+		if !isFindable && !isInstallable {
+			return "", fmt.Errorf("unknown source: %T", source)
+		}
+
+		if isFindable {
+			execPath, err := findable.Find(ctx)
+			if err == nil {
+				return execPath, nil
+			}
 
+			if !errors.IsErrorSkippable(err) {
 				return "", err
 			}
 
-			return execPath, nil
-		case src.Installable:
-			execPath, err := s.Install(ctx)
+			errs = multierror.Append(errs, err)
+		}
+
+		// A source which can be found and installed is installed when it
+		// can't be found.
+		if isInstallable {
+			execPath, err := installable.Install(ctx)
 			if err != nil {
 				if errors.IsErrorSkippable(err) {
 					errs = multierror.Append(errs, err)
@@ -54,8 +63,6 @@ func (i *Installer) Ensure(ctx context.Context, sources ...src.Source) (string,
 			}
 
 			return execPath, nil
-		default:
-			return "", fmt.Errorf("unknown source: %T", s)
 		}
 	}
 
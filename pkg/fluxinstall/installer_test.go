@@ -45,6 +45,24 @@ var _ = Describe("Install Flux CLI", func() {
 			err = installer.Remove(context.TODO())
 			Expect(err).To(BeNil())
 		})
+
+		By("ensure that a version not installed yet is installed", func() {
+			ctx := context.TODO()
+
+			product := &Product{
+				Version: "0.32.0",
+				cli:     &MockProductHTTPClient{},
+			}
+
+			installer := NewInstaller()
+			execPath, err := installer.Ensure(ctx, product)
+			Expect(err).To(BeNil())
+			Expect(execPath).To(Equal(filepath.Join(gitopsCacheFluxDir, "flux")))
+			Expect(execPath).To(BeAnExistingFile())
+
+			err = installer.Remove(context.TODO())
+			Expect(err).To(BeNil())
+		})
 	})
 
 })
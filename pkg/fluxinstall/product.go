@@ -14,6 +14,7 @@ import (
 	"runtime"
 	"strings"
 
+	"github.com/weaveworks/weave-gitops/pkg/fluxinstall/errors"
 	"github.com/weaveworks/weave-gitops/pkg/fluxinstall/internal/httpclient"
 	isrc "github.com/weaveworks/weave-gitops/pkg/fluxinstall/internal/src"
 )
@@ -113,7 +114,8 @@ func (p *Product) Find(ctx context.Context) (string, error) {
 
 	fluxPath := filepath.Join(dir, "flux")
 	if _, err := os.Stat(fluxPath); os.IsNotExist(err) {
-		return "", err
+		// not installed yet, it can still be installed
+		return "", errors.SkippableErr(err)
 	}
 
 	return fluxPath, nil
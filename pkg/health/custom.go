@@ -0,0 +1,188 @@
+package health
+
+import (
+	"fmt"
+
+	"github.com/google/cel-go/cel"
+	"github.com/google/cel-go/common/types"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/yaml"
+)
+
+// CustomHealthChecksKey is the ConfigMap key the custom health checks are
+// read from.
+const CustomHealthChecksKey = "healthChecks"
+
+// CustomHealthCheck describes how to work out the health of a kind of object
+// with CEL expressions. Each expression has access to the whole object as
+// `obj` and must evaluate to a bool, except Message which must evaluate to a
+// string. The expressions are evaluated in the order Unhealthy, Progressing,
+// Healthy, and the first one to be true decides the status. If none of them
+// are, the status is Unknown.
+//
+// For example:
+//
+//	group: example.com
+//	kind: Database
+//	healthy: "has(obj.status.phase) && obj.status.phase == 'Running'"
+//	unhealthy: "has(obj.status.phase) && obj.status.phase == 'Failed'"
+//	message: "has(obj.status.message) ? obj.status.message : ''"
+type CustomHealthCheck struct {
+	Group       string `json:"group"`
+	Kind        string `json:"kind"`
+	Healthy     string `json:"healthy,omitempty"`
+	Unhealthy   string `json:"unhealthy,omitempty"`
+	Progressing string `json:"progressing,omitempty"`
+	Message     string `json:"message,omitempty"`
+}
+
+// ParseCustomHealthChecks parses a YAML list of custom health checks.
+func ParseCustomHealthChecks(data []byte) ([]CustomHealthCheck, error) {
+	var checks []CustomHealthCheck
+
+	if err := yaml.Unmarshal(data, &checks); err != nil {
+		return nil, fmt.Errorf("parsing custom health checks: %w", err)
+	}
+
+	return checks, nil
+}
+
+// NewHealthCheckerWithCustomChecks returns a HealthChecker that uses the
+// custom checks for the kinds they're registered for, falling back to the
+// built-in checks for everything else.
+func NewHealthCheckerWithCustomChecks(checks []CustomHealthCheck) (HealthChecker, error) {
+	env, err := cel.NewEnv(cel.Variable("obj", cel.DynType))
+	if err != nil {
+		return nil, fmt.Errorf("creating CEL environment: %w", err)
+	}
+
+	hc := &healthChecker{
+		customChecks: map[schema.GroupKind]*compiledHealthCheck{},
+	}
+
+	for _, check := range checks {
+		if check.Kind == "" {
+			return nil, fmt.Errorf("custom health check for group %q has no kind", check.Group)
+		}
+
+		gk := schema.GroupKind{Group: check.Group, Kind: check.Kind}
+
+		if _, ok := hc.customChecks[gk]; ok {
+			return nil, fmt.Errorf("duplicate custom health check for %s", gk)
+		}
+
+		compiled, err := compileHealthCheck(env, check)
+		if err != nil {
+			return nil, fmt.Errorf("compiling custom health check for %s: %w", gk, err)
+		}
+
+		hc.customChecks[gk] = compiled
+	}
+
+	return hc, nil
+}
+
+type compiledHealthCheck struct {
+	healthy     cel.Program
+	unhealthy   cel.Program
+	progressing cel.Program
+	message     cel.Program
+}
+
+func compileHealthCheck(env *cel.Env, check CustomHealthCheck) (*compiledHealthCheck, error) {
+	if check.Healthy == "" && check.Unhealthy == "" && check.Progressing == "" {
+		return nil, fmt.Errorf("at least one of healthy, unhealthy or progressing must be set")
+	}
+
+	compiled := &compiledHealthCheck{}
+
+	for _, expr := range []struct {
+		name    string
+		source  string
+		program *cel.Program
+	}{
+		{"healthy", check.Healthy, &compiled.healthy},
+		{"unhealthy", check.Unhealthy, &compiled.unhealthy},
+		{"progressing", check.Progressing, &compiled.progressing},
+		{"message", check.Message, &compiled.message},
+	} {
+		if expr.source == "" {
+			continue
+		}
+
+		ast, issues := env.Compile(expr.source)
+		if issues != nil && issues.Err() != nil {
+			return nil, fmt.Errorf("%s: %w", expr.name, issues.Err())
+		}
+
+		program, err := env.Program(ast)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", expr.name, err)
+		}
+
+		*expr.program = program
+	}
+
+	return compiled, nil
+}
+
+// check evaluates the expressions against the object. An expression that
+// fails to evaluate, e.g. because of a missing field, is treated as false and
+// only reported if no other expression matches, so a single misbehaving object
+// doesn't break whole inventories.
+func (c *compiledHealthCheck) check(obj unstructured.Unstructured) HealthStatus {
+	vars := map[string]interface{}{"obj": obj.UnstructuredContent()}
+
+	var evalErr error
+
+	for _, rule := range []struct {
+		program cel.Program
+		status  HealthStatusCode
+	}{
+		{c.unhealthy, HealthStatusUnhealthy},
+		{c.progressing, HealthStatusProgressing},
+		{c.healthy, HealthStatusHealthy},
+	} {
+		if rule.program == nil {
+			continue
+		}
+
+		out, _, err := rule.program.Eval(vars)
+		if err != nil {
+			if evalErr == nil {
+				evalErr = fmt.Errorf("evaluating %s expression: %w", rule.status, err)
+			}
+
+			continue
+		}
+
+		if out == types.True {
+			return HealthStatus{Status: rule.status, Message: c.evalMessage(vars)}
+		}
+	}
+
+	if evalErr != nil {
+		return HealthStatus{Status: HealthStatusUnknown, Message: evalErr.Error()}
+	}
+
+	return HealthStatus{Status: HealthStatusUnknown, Message: c.evalMessage(vars)}
+}
+
+func (c *compiledHealthCheck) evalMessage(vars map[string]interface{}) string {
+	if c.message == nil {
+		return ""
+	}
+
+	out, _, err := c.message.Eval(vars)
+	if err != nil {
+		return ""
+	}
+
+	msg, ok := out.Value().(string)
+	if !ok {
+		return ""
+	}
+
+	return msg
+}
@@ -11,6 +11,7 @@ import (
 	networkingv1 "k8s.io/api/networking/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 )
 
 // Represents resource health status
@@ -36,11 +37,17 @@ func NewHealthChecker() HealthChecker {
 	return &healthChecker{}
 }
 
-type healthChecker struct{}
+type healthChecker struct {
+	customChecks map[schema.GroupKind]*compiledHealthCheck
+}
 
 func (hc *healthChecker) Check(obj unstructured.Unstructured) (HealthStatus, error) {
 	gvk := obj.GroupVersionKind()
 
+	if custom, ok := hc.customChecks[gvk.GroupKind()]; ok {
+		return custom.check(obj), nil
+	}
+
 	switch gvk.Kind {
 	case "Deployment":
 		return checkDeployment(obj)
@@ -64,9 +71,55 @@ func (hc *healthChecker) Check(obj unstructured.Unstructured) (HealthStatus, err
 		return checkService(obj)
 	}
 
-	return HealthStatus{
-		Status: HealthStatusUnknown,
-	}, nil
+	return checkConditions(obj), nil
+}
+
+// checkConditions is the fallback for kinds without a dedicated check, it
+// follows the kstatus conventions most controllers, including Flux, use.
+func checkConditions(obj unstructured.Unstructured) HealthStatus {
+	conditions, _, _ := unstructured.NestedSlice(obj.Object, "status", "conditions")
+
+	byType := map[string]map[string]interface{}{}
+
+	for _, c := range conditions {
+		if cond, ok := c.(map[string]interface{}); ok {
+			if condType, ok := cond["type"].(string); ok {
+				byType[condType] = cond
+			}
+		}
+	}
+
+	message := func(cond map[string]interface{}) string {
+		msg, _ := cond["message"].(string)
+		return msg
+	}
+
+	if cond, ok := byType["Stalled"]; ok && cond["status"] == string(corev1.ConditionTrue) {
+		return HealthStatus{Status: HealthStatusUnhealthy, Message: message(cond)}
+	}
+
+	if cond, ok := byType["Reconciling"]; ok && cond["status"] == string(corev1.ConditionTrue) {
+		return HealthStatus{Status: HealthStatusProgressing, Message: message(cond)}
+	}
+
+	observedGeneration, found, _ := unstructured.NestedInt64(obj.Object, "status", "observedGeneration")
+	if found && observedGeneration < obj.GetGeneration() {
+		return HealthStatus{Status: HealthStatusProgressing, Message: "waiting spec to be observed"}
+	}
+
+	cond, ok := byType["Ready"]
+	if !ok {
+		return HealthStatus{Status: HealthStatusUnknown}
+	}
+
+	switch cond["status"] {
+	case string(corev1.ConditionTrue):
+		return HealthStatus{Status: HealthStatusHealthy, Message: message(cond)}
+	case string(corev1.ConditionFalse):
+		return HealthStatus{Status: HealthStatusUnhealthy, Message: message(cond)}
+	}
+
+	return HealthStatus{Status: HealthStatusProgressing, Message: message(cond)}
 }
 
 func checkDeployment(obj unstructured.Unstructured) (HealthStatus, error) {
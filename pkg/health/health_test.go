@@ -121,6 +121,88 @@ func TestHealthCheck(t *testing.T) {
 			data:         "testdata/svc-progressing.yaml",
 			healthStatus: HealthStatusProgressing,
 		},
+		{
+			data:         "testdata/kustomization-healthy.yaml",
+			healthStatus: HealthStatusHealthy,
+		},
+		{
+			data:         "testdata/kustomization-unhealthy.yaml",
+			healthStatus: HealthStatusUnhealthy,
+		},
+		{
+			data:         "testdata/kustomization-progressing.yaml",
+			healthStatus: HealthStatusProgressing,
+		},
+		{
+			data:         "testdata/kustomization-stalled.yaml",
+			healthStatus: HealthStatusUnhealthy,
+		},
+		{
+			data:         "testdata/database-running.yaml",
+			healthStatus: HealthStatusUnknown,
+		},
+	} {
+		t.Run(fmt.Sprintf("%s is %s", scenario.data, scenario.healthStatus), func(t *testing.T) {
+			yamlBytes, err := os.ReadFile(scenario.data)
+			g.Expect(err).ToNot(HaveOccurred())
+			var obj unstructured.Unstructured
+			err = yaml.Unmarshal(yamlBytes, &obj)
+			g.Expect(err).ToNot(HaveOccurred())
+
+			healthStatus, err := hc.Check(obj)
+			g.Expect(err).ToNot(HaveOccurred())
+
+			g.Expect(healthStatus.Status).To(Equal(scenario.healthStatus))
+		})
+	}
+}
+
+func TestCustomHealthCheck(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	checks, err := ParseCustomHealthChecks([]byte(`
+- group: example.com
+  kind: Database
+  healthy: "has(obj.status.phase) && obj.status.phase == 'Running'"
+  unhealthy: "has(obj.status.phase) && obj.status.phase == 'Failed'"
+  progressing: "!has(obj.status) || !has(obj.status.phase) || obj.status.phase == 'Pending'"
+  message: "has(obj.status) && has(obj.status.message) ? obj.status.message : ''"
+`))
+	g.Expect(err).ToNot(HaveOccurred())
+
+	hc, err := NewHealthCheckerWithCustomChecks(checks)
+	g.Expect(err).ToNot(HaveOccurred())
+
+	type scenarios struct {
+		data         string
+		healthStatus HealthStatusCode
+		message      string
+	}
+
+	for _, scenario := range []scenarios{
+		{
+			data:         "testdata/database-running.yaml",
+			healthStatus: HealthStatusHealthy,
+			message:      "3/3 replicas available",
+		},
+		{
+			data:         "testdata/database-failed.yaml",
+			healthStatus: HealthStatusUnhealthy,
+			message:      "volume could not be provisioned",
+		},
+		{
+			data:         "testdata/database-pending.yaml",
+			healthStatus: HealthStatusProgressing,
+		},
+		{
+			data:         "testdata/deployment-healthy.yaml",
+			healthStatus: HealthStatusHealthy,
+		},
+		{
+			data:         "testdata/kustomization-unhealthy.yaml",
+			healthStatus: HealthStatusUnhealthy,
+			message:      "kustomization path not found",
+		},
 	} {
 		t.Run(fmt.Sprintf("%s is %s", scenario.data, scenario.healthStatus), func(t *testing.T) {
 			yamlBytes, err := os.ReadFile(scenario.data)
@@ -133,6 +215,23 @@ func TestHealthCheck(t *testing.T) {
 			g.Expect(err).ToNot(HaveOccurred())
 
 			g.Expect(healthStatus.Status).To(Equal(scenario.healthStatus))
+			g.Expect(healthStatus.Message).To(Equal(scenario.message))
+		})
+	}
+}
+
+func TestCustomHealthCheckInvalid(t *testing.T) {
+	for name, check := range map[string]CustomHealthCheck{
+		"missing kind":        {Group: "example.com", Healthy: "true"},
+		"no expressions":      {Group: "example.com", Kind: "Database"},
+		"invalid expression":  {Group: "example.com", Kind: "Database", Healthy: "obj.status.phase =="},
+		"undeclared variable": {Group: "example.com", Kind: "Database", Healthy: "object.status.ready"},
+	} {
+		t.Run(name, func(t *testing.T) {
+			g := NewGomegaWithT(t)
+
+			_, err := NewHealthCheckerWithCustomChecks([]CustomHealthCheck{check})
+			g.Expect(err).To(HaveOccurred())
 		})
 	}
 }
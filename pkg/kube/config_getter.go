@@ -57,10 +57,18 @@ func (r *ImpersonatingConfigGetter) Config(ctx context.Context) *rest.Config {
 }
 
 // ConfigWithPrincipal returns a new config with the principal set as the
-// impersonated user or bearer token.
+// impersonated user or bearer token. The prefixes of the principal, if it
+// has any, are used rather than userPrefixes.
 func ConfigWithPrincipal(user *auth.UserPrincipal, config *rest.Config, userPrefixes UserPrefixes) *rest.Config {
 	cfg := rest.CopyConfig(config)
 
+	if user.Prefixes != nil {
+		userPrefixes = UserPrefixes{
+			UsernamePrefix: user.Prefixes.Username,
+			GroupsPrefix:   user.Prefixes.Groups,
+		}
+	}
+
 	if tok := user.Token(); tok != "" {
 		cfg.BearerToken = tok
 		// Clear the token file as it takes precedence over the token.
@@ -112,3 +112,28 @@ func TestConfigWithPrincipal(t *testing.T) {
 		t.Fatalf("cfg groups didn't match expected:\n%s", diff)
 	}
 }
+
+func TestConfigWithPrincipalPrefixes(t *testing.T) {
+	user := &auth.UserPrincipal{
+		ID:       "user-id",
+		Groups:   []string{"group1"},
+		Prefixes: &auth.ImpersonationPrefixes{Username: "corp:", Groups: "corp:"},
+	}
+	config := &rest.Config{
+		Host: "https://example.com",
+	}
+
+	// The prefixes of the OIDC provider of the user take precedence over
+	// those of the cluster.
+	cfg := kube.ConfigWithPrincipal(user, config, kube.UserPrefixes{UsernamePrefix: "prefix-", GroupsPrefix: "prefix-"})
+
+	expectedUser := "corp:user-id"
+	if cfg.Impersonate.UserName != expectedUser {
+		t.Fatalf("cfg username didn't match expected: %s", cfg.Impersonate.UserName)
+	}
+
+	expectedGroups := []string{"corp:group1"}
+	if diff := cmp.Diff(expectedGroups, cfg.Impersonate.Groups); diff != "" {
+		t.Fatalf("cfg groups didn't match expected:\n%s", diff)
+	}
+}
@@ -0,0 +1,80 @@
+package policies
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	pacv2beta2 "github.com/weaveworks/policy-agent/api/v2beta2"
+	pb "github.com/weaveworks/weave-gitops/pkg/api/core"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+// ParameterValue returns the value of a policy parameter as its type says,
+// a string, an int, a bool or a []string, nil if it has no value. The
+// quotes of the string values are removed when they can be.
+func ParameterValue(param pacv2beta2.PolicyParameters, policyID string) (interface{}, error) {
+	if param.Value == nil {
+		return nil, nil
+	}
+
+	var value interface{}
+
+	var err error
+
+	switch param.Type {
+	case "string":
+		// attempt to clean up extra quotes if not successful show as is
+		unquoted, unquoteErr := strconv.Unquote(string(param.Value.Raw))
+		if unquoteErr != nil {
+			value = string(param.Value.Raw)
+		} else {
+			value = unquoted
+		}
+	case "integer":
+		value, err = strconv.Atoi(string(param.Value.Raw))
+	case "boolean":
+		value, err = strconv.ParseBool(string(param.Value.Raw))
+	case "array":
+		var values []string
+		err = json.Unmarshal(param.Value.Raw, &values)
+		value = values
+	default:
+		return nil, fmt.Errorf("found unsupported policy parameter type %s in policy %s", param.Type, policyID)
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to serialize parameter value %s in policy %s: %w", param.Name, policyID, err)
+	}
+
+	return value, nil
+}
+
+// ParameterAny returns the value of a policy parameter like ParameterValue,
+// as the API serves it.
+func ParameterAny(param pacv2beta2.PolicyParameters, policyID string) (*anypb.Any, error) {
+	value, err := ParameterValue(param, policyID)
+	if err != nil || value == nil {
+		return nil, err
+	}
+
+	var msg *anypb.Any
+
+	switch v := value.(type) {
+	case string:
+		msg, err = anypb.New(wrapperspb.String(v))
+	case int:
+		msg, err = anypb.New(wrapperspb.Int32(int32(v)))
+	case bool:
+		msg, err = anypb.New(wrapperspb.Bool(v))
+	case []string:
+		msg, err = anypb.New(&pb.PolicyParamRepeatedString{Value: v})
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to serialize parameter value %s in policy %s: %w", param.Name, policyID, err)
+	}
+
+	return msg, nil
+}
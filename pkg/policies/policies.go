@@ -0,0 +1,95 @@
+// Package policies evaluates the policies of the Weave policy agent against
+// resources locally, to find their violations before the resources are
+// applied to a cluster.
+package policies
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/fluxcd/pkg/ssa"
+	pacv2beta2 "github.com/weaveworks/policy-agent/api/v2beta2"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// ReadFiles reads the policies of YAML files, and of the YAML files of
+// directories. The other resources of the files are left out.
+func ReadFiles(paths ...string) ([]pacv2beta2.Policy, error) {
+	var policies []pacv2beta2.Policy
+
+	for _, path := range paths {
+		err := filepath.Walk(path, func(file string, info os.FileInfo, err error) error {
+			if err != nil {
+				return err
+			}
+
+			if info.IsDir() || (filepath.Ext(file) != ".yaml" && filepath.Ext(file) != ".yml") {
+				return nil
+			}
+
+			read, err := readFile(file)
+			if err != nil {
+				return fmt.Errorf("reading policies from %s: %w", file, err)
+			}
+
+			policies = append(policies, read...)
+
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return policies, nil
+}
+
+// List lists the policies of a cluster.
+func List(ctx context.Context, c client.Client) ([]pacv2beta2.Policy, error) {
+	list := &pacv2beta2.PolicyList{}
+
+	if err := c.List(ctx, list); err != nil {
+		return nil, fmt.Errorf("listing policies: %w", err)
+	}
+
+	return list.Items, nil
+}
+
+func readFile(file string) ([]pacv2beta2.Policy, error) {
+	data, err := os.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+
+	objects, err := ssa.ReadObjects(bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+
+	var policies []pacv2beta2.Policy
+
+	for _, obj := range objects {
+		gvk := obj.GroupVersionKind()
+		if gvk.Group != pacv2beta2.GroupVersion.Group || gvk.Kind != pacv2beta2.PolicyKind {
+			continue
+		}
+
+		if gvk.Version != pacv2beta2.GroupVersion.Version {
+			return nil, fmt.Errorf("policy %s has the unsupported version %s, only %s is supported", obj.GetName(), obj.GetAPIVersion(), pacv2beta2.GroupVersion)
+		}
+
+		var policy pacv2beta2.Policy
+
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(obj.Object, &policy); err != nil {
+			return nil, fmt.Errorf("reading policy %s: %w", obj.GetName(), err)
+		}
+
+		policies = append(policies, policy)
+	}
+
+	return policies, nil
+}
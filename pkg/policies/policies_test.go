@@ -0,0 +1,247 @@
+package policies_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/fluxcd/pkg/ssa"
+	. "github.com/onsi/gomega"
+	pacv2beta2 "github.com/weaveworks/policy-agent/api/v2beta2"
+	pb "github.com/weaveworks/weave-gitops/pkg/api/core"
+	"github.com/weaveworks/weave-gitops/pkg/policies"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+const (
+	replicasPolicy = `apiVersion: pac.weave.works/v2beta2
+kind: Policy
+metadata:
+  name: weave.policies.containers-minimum-replica-count
+spec:
+  id: weave.policies.containers-minimum-replica-count
+  name: Containers Minimum Replica Count
+  code: |
+    package weave.advisor.pods.replica_count
+
+    import future.keywords.in
+
+    min_replica_count := input.parameters.replica_count
+    exclude_namespaces := input.parameters.exclude_namespaces
+
+    violation[result] {
+      not input.review.object.metadata.namespace in exclude_namespaces
+      replicas := input.review.object.spec.replicas
+      replicas < min_replica_count
+      result = {
+        "issue detected": true,
+        "msg": sprintf("Replica count must be greater than or equal to '%v'; found '%v'.", [min_replica_count, replicas]),
+      }
+    }
+  parameters:
+  - name: replica_count
+    type: integer
+    required: true
+    value: 2
+  - name: exclude_namespaces
+    type: array
+    required: false
+    value:
+    - kube-system
+  targets:
+    kinds:
+    - Deployment
+    labels:
+    - app: "*"
+  category: weave.categories.reliability
+  severity: medium
+  description: Use at least two replicas
+  how_to_solve: Set spec.replicas
+`
+	terraformPolicy = `apiVersion: pac.weave.works/v2beta2
+kind: Policy
+metadata:
+  name: weave.policies.terraform
+spec:
+  id: weave.policies.terraform
+  name: Terraform
+  provider: terraform
+  code: |
+    package weave.terraform
+
+    violation[result] {
+      result = {"msg": "always"}
+    }
+`
+)
+
+func deployment(namespace, name string, replicas int) *unstructured.Unstructured {
+	obj, err := ssa.ReadObject(strings.NewReader(`apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: ` + name + `
+  namespace: ` + namespace + `
+  labels:
+    app: ` + name + `
+spec:
+  replicas: ` + strconv.Itoa(replicas) + `
+`))
+	if err != nil {
+		panic(err)
+	}
+
+	return obj
+}
+
+func TestReadFiles(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	dir := t.TempDir()
+	g.Expect(os.WriteFile(filepath.Join(dir, "policies.yaml"), []byte(replicasPolicy+"---\n"+terraformPolicy), 0o644)).To(Succeed())
+	g.Expect(os.WriteFile(filepath.Join(dir, "configmap.yaml"), []byte("apiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: settings\n"), 0o644)).To(Succeed())
+
+	read, err := policies.ReadFiles(dir)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(read).To(HaveLen(2))
+	g.Expect(read[0].Spec.ID).To(Equal("weave.policies.containers-minimum-replica-count"))
+	g.Expect(read[0].Spec.Parameters[0].Value.Raw).To(MatchJSON("2"))
+
+	g.Expect(os.WriteFile(filepath.Join(dir, "old.yaml"), []byte("apiVersion: pac.weave.works/v2beta1\nkind: Policy\nmetadata:\n  name: old\n"), 0o644)).To(Succeed())
+
+	_, err = policies.ReadFiles(dir)
+	g.Expect(err).To(MatchError(ContainSubstring("unsupported version pac.weave.works/v2beta1")))
+}
+
+func TestMatches(t *testing.T) {
+	policy := pacv2beta2.Policy{
+		Spec: pacv2beta2.PolicySpec{
+			Targets: pacv2beta2.PolicyTargets{
+				Kinds:      []string{"Deployment"},
+				Namespaces: []string{"apps"},
+				Labels:     []map[string]string{{"tier": "frontend"}, {"app": "*"}},
+			},
+		},
+	}
+
+	tests := []struct {
+		name    string
+		obj     func() *unstructured.Unstructured
+		matches bool
+	}{
+		{
+			name:    "any value of a label",
+			obj:     func() *unstructured.Unstructured { return deployment("apps", "podinfo", 1) },
+			matches: true,
+		},
+		{
+			name: "a label value",
+			obj: func() *unstructured.Unstructured {
+				obj := deployment("apps", "podinfo", 1)
+				obj.SetLabels(map[string]string{"tier": "frontend"})
+
+				return obj
+			},
+			matches: true,
+		},
+		{
+			name: "another label value",
+			obj: func() *unstructured.Unstructured {
+				obj := deployment("apps", "podinfo", 1)
+				obj.SetLabels(map[string]string{"tier": "backend"})
+
+				return obj
+			},
+			matches: false,
+		},
+		{
+			name:    "another namespace",
+			obj:     func() *unstructured.Unstructured { return deployment("default", "podinfo", 1) },
+			matches: false,
+		},
+		{
+			name: "another kind",
+			obj: func() *unstructured.Unstructured {
+				obj := deployment("apps", "podinfo", 1)
+				obj.SetKind("StatefulSet")
+
+				return obj
+			},
+			matches: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewGomegaWithT(t)
+			g.Expect(policies.Matches(policy, tt.obj())).To(Equal(tt.matches))
+		})
+	}
+}
+
+func TestTest(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	dir := t.TempDir()
+	g.Expect(os.WriteFile(filepath.Join(dir, "policies.yaml"), []byte(replicasPolicy+"---\n"+terraformPolicy), 0o644)).To(Succeed())
+
+	read, err := policies.ReadFiles(dir)
+	g.Expect(err).NotTo(HaveOccurred())
+
+	now := time.Date(2023, 10, 1, 12, 0, 0, 0, time.UTC)
+
+	unlabelled := deployment("apps", "unlabelled", 1)
+	unlabelled.SetLabels(nil)
+
+	res, err := policies.Test(context.Background(), read, []*unstructured.Unstructured{
+		deployment("apps", "podinfo", 1),
+		deployment("apps", "podinfo", 1),
+		deployment("apps", "redis", 3),
+		deployment("kube-system", "coredns", 1),
+		unlabelled,
+	}, policies.Options{ClusterName: "prod", Now: func() time.Time { return now }})
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(res.Total).To(BeEquivalentTo(1), "the identical resources are evaluated once")
+
+	violation := res.Violations[0]
+	g.Expect(violation.PolicyId).To(Equal("weave.policies.containers-minimum-replica-count"))
+	g.Expect(violation.Name).To(Equal("Containers Minimum Replica Count"))
+	g.Expect(violation.Message).To(Equal("Containers Minimum Replica Count in Deployment podinfo"))
+	g.Expect(violation.ClusterName).To(Equal("prod"))
+	g.Expect(violation.Severity).To(Equal("medium"))
+	g.Expect(violation.Category).To(Equal("weave.categories.reliability"))
+	g.Expect(violation.CreatedAt).To(Equal("2023-10-01T12:00:00Z"))
+	g.Expect(violation.EntityKind).To(Equal("Deployment"))
+	g.Expect(violation.Namespace).To(Equal("apps"))
+	g.Expect(violation.Entity).To(Equal("podinfo"))
+	g.Expect(violation.ViolatingEntity).To(ContainSubstring(`"replicas":1`))
+	g.Expect(violation.Occurrences).To(HaveLen(1))
+	g.Expect(violation.Occurrences[0].Message).To(Equal("Replica count must be greater than or equal to '2'; found '1'."))
+
+	g.Expect(violation.Parameters).To(HaveLen(2))
+	g.Expect(violation.Parameters[0].Name).To(Equal("replica_count"))
+
+	count := &wrapperspb.Int32Value{}
+	g.Expect(violation.Parameters[0].Value.UnmarshalTo(count)).To(Succeed())
+	g.Expect(count.Value).To(BeEquivalentTo(2))
+
+	excluded := &pb.PolicyParamRepeatedString{}
+	g.Expect(violation.Parameters[1].Value.UnmarshalTo(excluded)).To(Succeed())
+	g.Expect(excluded.Value).To(Equal([]string{"kube-system"}))
+
+	// the required parameters must have a value
+	read[0].Spec.Parameters[0].Value = nil
+
+	_, err = policies.Test(context.Background(), read, nil, policies.Options{})
+	g.Expect(err).To(MatchError(ContainSubstring("the required parameter replica_count has no value")))
+
+	read[0].Spec.Parameters[0].Value = &apiextensionsv1.JSON{Raw: []byte(`"two"`)}
+
+	_, err = policies.Test(context.Background(), read, nil, policies.Options{})
+	g.Expect(err).To(MatchError(ContainSubstring("failed to serialize parameter value replica_count")))
+}
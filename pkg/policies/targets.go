@@ -0,0 +1,50 @@
+package policies
+
+import (
+	pacv2beta2 "github.com/weaveworks/policy-agent/api/v2beta2"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+// anyValue is the value of the target labels matching any value.
+const anyValue = "*"
+
+// Matches tells whether a resource is targeted by a policy, like the policy
+// agent tells it: its kind and namespace must be among those of the
+// targets, if any, and one of the target labels must be among its labels.
+func Matches(policy pacv2beta2.Policy, obj *unstructured.Unstructured) bool {
+	targets := policy.Spec.Targets
+
+	if len(targets.Kinds) > 0 && !contains(targets.Kinds, obj.GetKind()) {
+		return false
+	}
+
+	if len(targets.Namespaces) > 0 && !contains(targets.Namespaces, obj.GetNamespace()) {
+		return false
+	}
+
+	if len(targets.Labels) == 0 {
+		return true
+	}
+
+	labels := obj.GetLabels()
+
+	for _, target := range targets.Labels {
+		for key, value := range target {
+			if actual, ok := labels[key]; ok && (value == anyValue || value == actual) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+
+	return false
+}
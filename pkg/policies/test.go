@@ -0,0 +1,259 @@
+package policies
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/open-policy-agent/opa/ast"
+	"github.com/open-policy-agent/opa/rego"
+	pacv2beta2 "github.com/weaveworks/policy-agent/api/v2beta2"
+	pb "github.com/weaveworks/weave-gitops/pkg/api/core"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+// violationRule is the rule of the policies whose results are their
+// violations, like the policy agent queries it.
+const violationRule = "violation"
+
+// Options configure a test of policies.
+type Options struct {
+	// ClusterName is the name of the cluster the violations are reported
+	// for, if any.
+	ClusterName string
+	// Now returns the time the violations are created at, time.Now if nil.
+	Now func() time.Time
+}
+
+// Test evaluates the policies against the resources they target, like the
+// policy agent does on admission, and returns the violations as the API
+// lists them. The identical resources are only evaluated once, and the
+// policies of other providers than Kubernetes are left out. The Rego code
+// gets the resource as input.review.object and the values of the parameters
+// as input.parameters, by name.
+func Test(ctx context.Context, policies []pacv2beta2.Policy, objects []*unstructured.Unstructured, opts Options) (*pb.ListPolicyValidationsResponse, error) {
+	if opts.Now == nil {
+		opts.Now = time.Now
+	}
+
+	objects, manifests, err := uniqueObjects(objects)
+	if err != nil {
+		return nil, err
+	}
+
+	createdAt := opts.Now().Format(time.RFC3339)
+
+	violations := []*pb.PolicyValidation{}
+
+	for i := range policies {
+		policy := policies[i]
+
+		if policy.Spec.Provider != "" && policy.Spec.Provider != pacv2beta2.PolicyKubernetesProvider {
+			continue
+		}
+
+		e, err := newEvaluator(ctx, policy)
+		if err != nil {
+			return nil, fmt.Errorf("policy %s: %w", policy.Spec.ID, err)
+		}
+
+		for j, obj := range objects {
+			if !Matches(policy, obj) {
+				continue
+			}
+
+			occurrences, err := e.evaluate(ctx, obj)
+			if err != nil {
+				return nil, fmt.Errorf("evaluating policy %s against %s: %w", policy.Spec.ID, describe(obj), err)
+			}
+
+			if len(occurrences) == 0 {
+				continue
+			}
+
+			violations = append(violations, &pb.PolicyValidation{
+				PolicyId:        policy.Spec.ID,
+				Name:            policy.Spec.Name,
+				Message:         fmt.Sprintf("%s in %s %s", policy.Spec.Name, obj.GetKind(), obj.GetName()),
+				ClusterName:     opts.ClusterName,
+				Category:        policy.Spec.Category,
+				Severity:        policy.Spec.Severity,
+				CreatedAt:       createdAt,
+				Entity:          obj.GetName(),
+				EntityKind:      obj.GetKind(),
+				Namespace:       obj.GetNamespace(),
+				ViolatingEntity: manifests[j],
+				Description:     policy.Spec.Description,
+				HowToSolve:      policy.Spec.HowToSolve,
+				Occurrences:     occurrences,
+				Parameters:      e.params,
+			})
+		}
+	}
+
+	sort.SliceStable(violations, func(i, j int) bool {
+		a, b := violations[i], violations[j]
+
+		if a.PolicyId != b.PolicyId {
+			return a.PolicyId < b.PolicyId
+		}
+
+		if a.EntityKind != b.EntityKind {
+			return a.EntityKind < b.EntityKind
+		}
+
+		if a.Namespace != b.Namespace {
+			return a.Namespace < b.Namespace
+		}
+
+		return a.Entity < b.Entity
+	})
+
+	return &pb.ListPolicyValidationsResponse{
+		Violations: violations,
+		Total:      int32(len(violations)),
+	}, nil
+}
+
+// evaluator evaluates the Rego code of a policy with its parameters.
+type evaluator struct {
+	query  rego.PreparedEvalQuery
+	values map[string]interface{}
+	params []*pb.PolicyValidationParam
+}
+
+func newEvaluator(ctx context.Context, policy pacv2beta2.Policy) (*evaluator, error) {
+	e := &evaluator{values: map[string]interface{}{}}
+
+	for _, param := range policy.Spec.Parameters {
+		value, err := ParameterValue(param, policy.Spec.ID)
+		if err != nil {
+			return nil, err
+		}
+
+		if value == nil {
+			if param.Required {
+				return nil, fmt.Errorf("the required parameter %s has no value", param.Name)
+			}
+
+			continue
+		}
+
+		msg, err := ParameterAny(param, policy.Spec.ID)
+		if err != nil {
+			return nil, err
+		}
+
+		e.values[param.Name] = value
+		e.params = append(e.params, &pb.PolicyValidationParam{
+			Name:     param.Name,
+			Type:     param.Type,
+			Value:    msg,
+			Required: param.Required,
+		})
+	}
+
+	module, err := ast.ParseModule(policy.Spec.ID+".rego", policy.Spec.Code)
+	if err != nil {
+		return nil, fmt.Errorf("parsing the policy code: %w", err)
+	}
+
+	query := fmt.Sprintf("%s.%s", module.Package.Path, violationRule)
+
+	e.query, err = rego.New(
+		rego.Query(query),
+		rego.ParsedModule(module),
+	).PrepareForEval(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("compiling the policy code: %w", err)
+	}
+
+	return e, nil
+}
+
+// evaluate returns the occurrences of the violations of the policy by a
+// resource, none if it complies.
+func (e *evaluator) evaluate(ctx context.Context, obj *unstructured.Unstructured) ([]*pb.PolicyValidationOccurrence, error) {
+	input := map[string]interface{}{
+		"review": map[string]interface{}{
+			"object": obj.Object,
+		},
+		"parameters": e.values,
+	}
+
+	results, err := e.query.Eval(ctx, rego.EvalInput(input))
+	if err != nil {
+		return nil, err
+	}
+
+	var occurrences []*pb.PolicyValidationOccurrence
+
+	for _, result := range results {
+		for _, expr := range result.Expressions {
+			values, ok := expr.Value.([]interface{})
+			if !ok {
+				continue
+			}
+
+			for _, value := range values {
+				occurrences = append(occurrences, &pb.PolicyValidationOccurrence{Message: occurrenceMessage(value)})
+			}
+		}
+	}
+
+	return occurrences, nil
+}
+
+// occurrenceMessage returns the message of a violation, its msg field like
+// the policy agent reports it, or the violation itself.
+func occurrenceMessage(violation interface{}) string {
+	if fields, ok := violation.(map[string]interface{}); ok {
+		if msg, ok := fields["msg"].(string); ok {
+			return msg
+		}
+	}
+
+	data, err := json.Marshal(violation)
+	if err != nil {
+		return fmt.Sprint(violation)
+	}
+
+	return string(data)
+}
+
+// uniqueObjects returns the resources without those identical to others,
+// and their JSON manifests.
+func uniqueObjects(objects []*unstructured.Unstructured) ([]*unstructured.Unstructured, []string, error) {
+	seen := map[string]bool{}
+
+	var unique []*unstructured.Unstructured
+
+	var manifests []string
+
+	for _, obj := range objects {
+		data, err := obj.MarshalJSON()
+		if err != nil {
+			return nil, nil, fmt.Errorf("encoding %s: %w", describe(obj), err)
+		}
+
+		if seen[string(data)] {
+			continue
+		}
+
+		seen[string(data)] = true
+		unique = append(unique, obj)
+		manifests = append(manifests, string(data))
+	}
+
+	return unique, manifests, nil
+}
+
+func describe(obj *unstructured.Unstructured) string {
+	if obj.GetNamespace() == "" {
+		return fmt.Sprintf("%s %s", obj.GetKind(), obj.GetName())
+	}
+
+	return fmt.Sprintf("%s %s/%s", obj.GetKind(), obj.GetNamespace(), obj.GetName())
+}
@@ -0,0 +1,317 @@
+package auth
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/weaveworks/weave-gitops/core/logger"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	utilrand "k8s.io/apimachinery/pkg/util/rand"
+	"k8s.io/client-go/util/retry"
+	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// DefaultAccessTokensSecretName is the Secret access tokens are kept in
+	// by default.
+	DefaultAccessTokensSecretName = "weave-gitops-access-tokens"
+	// AccessTokenPrefix starts every access token, telling them apart from
+	// the other bearer tokens.
+	AccessTokenPrefix = "wgpat_"
+)
+
+// ErrAccessTokenNotFound is returned when an access token doesn't exist.
+var ErrAccessTokenNotFound = errors.New("access token not found")
+
+// AccessToken is a personal access token, acting as the user who created it
+// with the groups they had then. Only the hash of the secret part of the
+// token is kept.
+type AccessToken struct {
+	ID        string    `json:"id"`
+	Name      string    `json:"name"`
+	UserID    string    `json:"userID"`
+	Groups    []string  `json:"groups,omitempty"`
+	CreatedAt time.Time `json:"createdAt"`
+	ExpiresAt time.Time `json:"expiresAt"`
+	// ReadOnly tokens can only be used for requests that don't change
+	// anything.
+	ReadOnly bool `json:"readOnly,omitempty"`
+	// Clusters are the only clusters the token can be used with, all the
+	// clusters when empty.
+	Clusters []string `json:"clusters,omitempty"`
+	// Prefixes are those of the OIDC provider of the user, if it has its
+	// own.
+	Prefixes *ImpersonationPrefixes `json:"prefixes,omitempty"`
+	Hash     string                 `json:"hash"`
+}
+
+// Expired tells whether the token can no longer be used.
+func (t AccessToken) Expired(now time.Time) bool {
+	return !now.Before(t.ExpiresAt)
+}
+
+// Principal returns the user the token acts as, with its restrictions.
+func (t AccessToken) Principal() *UserPrincipal {
+	return &UserPrincipal{
+		ID:            t.UserID,
+		Groups:        t.Groups,
+		ReadOnly:      t.ReadOnly,
+		Clusters:      t.Clusters,
+		AccessTokenID: t.ID,
+		Prefixes:      t.Prefixes,
+	}
+}
+
+// AccessTokenStore keeps the access tokens.
+type AccessTokenStore interface {
+	// List returns the tokens of a user, given by the identity of their
+	// principal, sorted by creation time.
+	List(ctx context.Context, identity string) ([]AccessToken, error)
+	Get(ctx context.Context, id string) (AccessToken, error)
+	Save(ctx context.Context, t AccessToken) error
+	Delete(ctx context.Context, id string) error
+}
+
+// NewAccessToken returns a token acting as the user until it expires, and
+// the secret value to authenticate with, which isn't kept.
+func NewAccessToken(user *UserPrincipal, name string, expiresAt time.Time, readOnly bool, clusters []string) (AccessToken, string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return AccessToken{}, "", fmt.Errorf("generating access token: %w", err)
+	}
+
+	secret := base64.RawURLEncoding.EncodeToString(b)
+
+	t := AccessToken{
+		ID:        utilrand.String(12),
+		Name:      name,
+		UserID:    user.ID,
+		Groups:    user.Groups,
+		CreatedAt: time.Now().UTC(),
+		ExpiresAt: expiresAt.UTC(),
+		ReadOnly:  readOnly,
+		Clusters:  clusters,
+		Prefixes:  user.Prefixes,
+		Hash:      hashAccessTokenSecret(secret),
+	}
+
+	return t, AccessTokenPrefix + t.ID + "_" + secret, nil
+}
+
+// parseAccessToken splits a token into its ID and secret part, returning
+// false if it isn't an access token.
+func parseAccessToken(token string) (string, string, bool) {
+	if !strings.HasPrefix(token, AccessTokenPrefix) {
+		return "", "", false
+	}
+
+	id, secret, ok := strings.Cut(strings.TrimPrefix(token, AccessTokenPrefix), "_")
+	if !ok || id == "" || secret == "" {
+		return "", "", false
+	}
+
+	return id, secret, true
+}
+
+func hashAccessTokenSecret(secret string) string {
+	hash := sha256.Sum256([]byte(secret))
+	return hex.EncodeToString(hash[:])
+}
+
+// AccessTokenPrincipalGetter authenticates the requests with access tokens
+// in the Authorization header.
+type AccessTokenPrincipalGetter struct {
+	log   logr.Logger
+	store AccessTokenStore
+}
+
+func NewAccessTokenPrincipalGetter(log logr.Logger, store AccessTokenStore) PrincipalGetter {
+	return &AccessTokenPrincipalGetter{
+		log:   log,
+		store: store,
+	}
+}
+
+// Principal returns the user of the access token in the request, or nothing
+// if the request doesn't have one. Unknown, revoked and expired tokens are
+// errors rather than left to the other methods.
+func (pg *AccessTokenPrincipalGetter) Principal(r *http.Request) (*UserPrincipal, error) {
+	id, secret, ok := parseAccessToken(extractToken(r.Header.Get(AuthorizationTokenHeaderName)))
+	if !ok {
+		return nil, nil
+	}
+
+	t, err := pg.store.Get(r.Context(), id)
+	if err != nil {
+		if errors.Is(err, ErrAccessTokenNotFound) {
+			return nil, errors.New("invalid access token")
+		}
+
+		return nil, err
+	}
+
+	if subtle.ConstantTimeCompare([]byte(hashAccessTokenSecret(secret)), []byte(t.Hash)) != 1 {
+		return nil, errors.New("invalid access token")
+	}
+
+	if t.Expired(time.Now()) {
+		return nil, errors.New("access token expired")
+	}
+
+	pg.log.V(logger.LogLevelDebug).Info("authenticated with access token", "id", t.ID, "user", t.UserID)
+
+	return t.Principal(), nil
+}
+
+// SecretAccessTokenStore keeps the access tokens in a Secret, each of them as
+// JSON under its ID.
+type SecretAccessTokenStore struct {
+	client ctrlclient.Client
+	key    ctrlclient.ObjectKey
+}
+
+// NewSecretAccessTokenStore returns a store keeping the access tokens in the
+// named Secret, which is created on the first save.
+func NewSecretAccessTokenStore(c ctrlclient.Client, namespace, name string) *SecretAccessTokenStore {
+	return &SecretAccessTokenStore{
+		client: c,
+		key:    ctrlclient.ObjectKey{Namespace: namespace, Name: name},
+	}
+}
+
+func (s *SecretAccessTokenStore) List(ctx context.Context, identity string) ([]AccessToken, error) {
+	secret, err := s.get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	tokens := []AccessToken{}
+
+	for id, data := range secret.Data {
+		t := AccessToken{}
+		if err := json.Unmarshal(data, &t); err != nil {
+			return nil, fmt.Errorf("decoding access token %q: %w", id, err)
+		}
+
+		if t.Principal().Identity() == identity {
+			tokens = append(tokens, t)
+		}
+	}
+
+	sort.Slice(tokens, func(i, j int) bool {
+		return tokens[i].CreatedAt.Before(tokens[j].CreatedAt)
+	})
+
+	return tokens, nil
+}
+
+func (s *SecretAccessTokenStore) Get(ctx context.Context, id string) (AccessToken, error) {
+	secret, err := s.get(ctx)
+	if err != nil {
+		return AccessToken{}, err
+	}
+
+	data, ok := secret.Data[id]
+	if !ok {
+		return AccessToken{}, ErrAccessTokenNotFound
+	}
+
+	t := AccessToken{}
+	if err := json.Unmarshal(data, &t); err != nil {
+		return AccessToken{}, fmt.Errorf("decoding access token %q: %w", id, err)
+	}
+
+	return t, nil
+}
+
+func (s *SecretAccessTokenStore) Save(ctx context.Context, t AccessToken) error {
+	data, err := json.Marshal(t)
+	if err != nil {
+		return fmt.Errorf("encoding access token %q: %w", t.ID, err)
+	}
+
+	return s.update(ctx, func(secret *corev1.Secret) error {
+		if secret.Data == nil {
+			secret.Data = map[string][]byte{}
+		}
+
+		secret.Data[t.ID] = data
+
+		return nil
+	})
+}
+
+func (s *SecretAccessTokenStore) Delete(ctx context.Context, id string) error {
+	return s.update(ctx, func(secret *corev1.Secret) error {
+		if _, ok := secret.Data[id]; !ok {
+			return ErrAccessTokenNotFound
+		}
+
+		delete(secret.Data, id)
+
+		return nil
+	})
+}
+
+// get returns the Secret, or an empty one if it doesn't exist yet.
+func (s *SecretAccessTokenStore) get(ctx context.Context) (*corev1.Secret, error) {
+	secret := &corev1.Secret{}
+
+	if err := s.client.Get(ctx, s.key, secret); err != nil {
+		if apierrors.IsNotFound(err) {
+			return &corev1.Secret{}, nil
+		}
+
+		return nil, fmt.Errorf("getting access tokens: %w", err)
+	}
+
+	return secret, nil
+}
+
+// update applies change to the Secret, creating it if needed, and retries on
+// conflicts with other writers.
+func (s *SecretAccessTokenStore) update(ctx context.Context, change func(*corev1.Secret) error) error {
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		secret := &corev1.Secret{}
+
+		err := s.client.Get(ctx, s.key, secret)
+		if err != nil && !apierrors.IsNotFound(err) {
+			return fmt.Errorf("getting access tokens: %w", err)
+		}
+
+		if apierrors.IsNotFound(err) {
+			secret = &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      s.key.Name,
+					Namespace: s.key.Namespace,
+				},
+			}
+
+			if err := change(secret); err != nil {
+				return err
+			}
+
+			return s.client.Create(ctx, secret)
+		}
+
+		if err := change(secret); err != nil {
+			return err
+		}
+
+		return s.client.Update(ctx, secret)
+	})
+}
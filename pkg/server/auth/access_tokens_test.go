@@ -0,0 +1,179 @@
+package auth_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/gomega"
+	"github.com/weaveworks/weave-gitops/pkg/server/auth"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestSecretAccessTokenStore(t *testing.T) {
+	g := NewGomegaWithT(t)
+	ctx := context.Background()
+
+	store := auth.NewSecretAccessTokenStore(ctrlclient.NewClientBuilder().Build(), testNamespace, auth.DefaultAccessTokensSecretName)
+
+	tokens, err := store.List(ctx, "anne")
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(tokens).To(BeEmpty(), "the Secret doesn't exist yet")
+
+	anne := &auth.UserPrincipal{ID: "anne", Groups: []string{"developers"}}
+
+	ci, secret, err := auth.NewAccessToken(anne, "ci", time.Now().Add(time.Hour), true, []string{"Default"})
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(secret).To(HavePrefix(auth.AccessTokenPrefix + ci.ID + "_"))
+	g.Expect(ci.Hash).NotTo(ContainSubstring(strings.TrimPrefix(secret, auth.AccessTokenPrefix+ci.ID+"_")))
+
+	other, _, err := auth.NewAccessToken(&auth.UserPrincipal{ID: "bob"}, "bob", time.Now().Add(time.Hour), false, nil)
+	g.Expect(err).NotTo(HaveOccurred())
+
+	// anne of another OIDC provider, with its own prefixes
+	gitlabAnne := &auth.UserPrincipal{ID: "anne", Prefixes: &auth.ImpersonationPrefixes{Username: "gitlab:", Groups: "gitlab:"}}
+
+	gitlab, _, err := auth.NewAccessToken(gitlabAnne, "gitlab", time.Now().Add(time.Hour), false, nil)
+	g.Expect(err).NotTo(HaveOccurred())
+
+	g.Expect(store.Save(ctx, ci)).To(Succeed())
+	g.Expect(store.Save(ctx, other)).To(Succeed())
+	g.Expect(store.Save(ctx, gitlab)).To(Succeed())
+
+	got, err := store.Get(ctx, ci.ID)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(got.Name).To(Equal("ci"))
+	g.Expect(got.Groups).To(Equal([]string{"developers"}))
+	g.Expect(got.ReadOnly).To(BeTrue())
+
+	tokens, err = store.List(ctx, "anne")
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(tokens).To(HaveLen(1))
+	g.Expect(tokens[0].ID).To(Equal(ci.ID))
+
+	tokens, err = store.List(ctx, gitlabAnne.Identity())
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(tokens).To(HaveLen(1))
+	g.Expect(tokens[0].ID).To(Equal(gitlab.ID))
+	g.Expect(tokens[0].Principal().Prefixes).To(Equal(gitlabAnne.Prefixes))
+
+	g.Expect(store.Delete(ctx, ci.ID)).To(Succeed())
+	g.Expect(store.Delete(ctx, ci.ID)).To(MatchError(auth.ErrAccessTokenNotFound))
+
+	_, err = store.Get(ctx, ci.ID)
+	g.Expect(err).To(MatchError(auth.ErrAccessTokenNotFound))
+}
+
+func TestAccessTokenPrincipalGetter(t *testing.T) {
+	g := NewGomegaWithT(t)
+	ctx := context.Background()
+
+	store := auth.NewSecretAccessTokenStore(ctrlclient.NewClientBuilder().Build(), testNamespace, auth.DefaultAccessTokensSecretName)
+	anne := &auth.UserPrincipal{ID: "anne", Groups: []string{"developers"}}
+
+	valid, validSecret, err := auth.NewAccessToken(anne, "ci", time.Now().Add(time.Hour), true, []string{"Default"})
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(store.Save(ctx, valid)).To(Succeed())
+
+	expired, expiredSecret, err := auth.NewAccessToken(anne, "old", time.Now().Add(-time.Minute), false, nil)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(store.Save(ctx, expired)).To(Succeed())
+
+	revoked, revokedSecret, err := auth.NewAccessToken(anne, "revoked", time.Now().Add(time.Hour), false, nil)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(store.Save(ctx, revoked)).To(Succeed())
+	g.Expect(store.Delete(ctx, revoked.ID)).To(Succeed())
+
+	getter := auth.NewAccessTokenPrincipalGetter(logr.Discard(), store)
+
+	principal := func(header string) (*auth.UserPrincipal, error) {
+		req := httptest.NewRequest(http.MethodGet, "/v1/objects", nil)
+		if header != "" {
+			req.Header.Set("Authorization", header)
+		}
+
+		return getter.Principal(req)
+	}
+
+	p, err := principal("Bearer " + validSecret)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(p).To(Equal(&auth.UserPrincipal{
+		ID:            "anne",
+		Groups:        []string{"developers"},
+		ReadOnly:      true,
+		Clusters:      []string{"Default"},
+		AccessTokenID: valid.ID,
+	}))
+	g.Expect(p.AllowsCluster("Default")).To(BeTrue())
+	g.Expect(p.AllowsCluster("prod")).To(BeFalse())
+
+	_, err = principal("Bearer " + validSecret + "x")
+	g.Expect(err).To(MatchError("invalid access token"))
+
+	_, err = principal("Bearer " + expiredSecret)
+	g.Expect(err).To(MatchError("access token expired"))
+
+	_, err = principal("Bearer " + revokedSecret)
+	g.Expect(err).To(MatchError("invalid access token"))
+
+	// Other bearer tokens are left to the other methods.
+	p, err = principal("Bearer some-kubernetes-token")
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(p).To(BeNil())
+
+	p, err = principal("")
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(p).To(BeNil())
+}
+
+func TestWithAPIAuthAcceptsAccessTokens(t *testing.T) {
+	g := NewGomegaWithT(t)
+	ctx := context.Background()
+
+	store := auth.NewSecretAccessTokenStore(ctrlclient.NewClientBuilder().Build(), testNamespace, auth.DefaultAccessTokensSecretName)
+
+	token, secret, err := auth.NewAccessToken(&auth.UserPrincipal{ID: "anne"}, "ci", time.Now().Add(time.Hour), true, nil)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(store.Save(ctx, token)).To(Succeed())
+
+	userSecret := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: auth.ClusterUserAuthSecretName, Namespace: testNamespace}}
+	k8s := ctrlclient.NewClientBuilder().WithObjects(userSecret).Build()
+
+	authMethods := map[auth.AuthMethod]bool{auth.UserAccount: true}
+	authCfg, err := auth.NewAuthServerConfig(logr.Discard(), auth.OIDCConfig{}, k8s, nil, testNamespace, authMethods, "", nil)
+	g.Expect(err).NotTo(HaveOccurred())
+
+	authCfg.AccessTokens = store
+
+	srv, err := auth.NewAuthServer(ctx, authCfg)
+	g.Expect(err).NotTo(HaveOccurred())
+
+	var principal *auth.UserPrincipal
+
+	handler := auth.WithAPIAuth(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		principal = auth.Principal(r.Context())
+	}), srv, nil, nil)
+
+	res := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodPost, "/v1/objects", nil)
+	req.Header.Set("Authorization", "Bearer "+secret)
+	handler.ServeHTTP(res, req)
+
+	g.Expect(res).To(HaveHTTPStatus(http.StatusOK))
+	g.Expect(principal.ID).To(Equal("anne"))
+	g.Expect(principal.ReadOnly).To(BeTrue())
+	g.Expect(principal.Token()).To(BeEmpty(), "the access token isn't passed on to the clusters")
+
+	res = httptest.NewRecorder()
+	req = httptest.NewRequest(http.MethodPost, "/v1/objects", nil)
+	req.Header.Set("Authorization", "Bearer "+auth.AccessTokenPrefix+token.ID+"_wrong")
+	handler.ServeHTTP(res, req)
+
+	g.Expect(res).To(HaveHTTPStatus(http.StatusUnauthorized))
+}
@@ -61,6 +61,9 @@ func RegisterAuthServer(mux *http.ServeMux, prefix string, srv *AuthServer, logi
 	mux.HandleFunc(prefix+"/userinfo", srv.UserInfo)
 	mux.HandleFunc(prefix+"/refresh", srv.RefreshHandler)
 	mux.HandleFunc(prefix+"/logout", srv.Logout)
+	mux.HandleFunc(prefix+"/backchannel_logout", srv.BackChannelLogout)
+	mux.HandleFunc(prefix+"/providers", srv.Providers)
+	mux.HandleFunc(prefix+"/identity", srv.Identity)
 
 	return nil
 }
@@ -82,6 +85,23 @@ type UserPrincipal struct {
 	ID     string   `json:"id"`
 	Groups []string `json:"groups"`
 	token  *string  `json:"-"`
+	// ReadOnly principals can't change anything in the clusters.
+	ReadOnly bool `json:"-"`
+	// Clusters restricts the principal to these clusters, when not empty.
+	Clusters []string `json:"-"`
+	// AccessTokenID is the ID of the access token the principal
+	// authenticated with, if any.
+	AccessTokenID string `json:"-"`
+	// Prefixes are added to the user and groups when impersonating the
+	// principal, rather than those of the clusters, when not nil.
+	Prefixes *ImpersonationPrefixes `json:"-"`
+}
+
+// ImpersonationPrefixes are added to the name and groups of a user when
+// impersonating them.
+type ImpersonationPrefixes struct {
+	Username string `json:"username"`
+	Groups   string `json:"groups"`
 }
 
 // Token returns the private access token for this principal.
@@ -103,12 +123,64 @@ func (p *UserPrincipal) String() string {
 	return fmt.Sprintf("id=%q groups=%v", p.ID, p.Groups)
 }
 
-// Hash returns a unique string using user id,token and groups.
+// Hash returns a unique string using user id,token and groups, and the
+// restrictions of the principal if it has any.
 func (p *UserPrincipal) Hash() string {
-	hash := md5.Sum([]byte(fmt.Sprintf("%s/%s/%v", p.ID, p.Token(), p.Groups)))
+	key := fmt.Sprintf("%s/%s/%v", p.ID, p.Token(), p.Groups)
+	if p.ReadOnly || len(p.Clusters) > 0 {
+		key = fmt.Sprintf("%s/%t/%v", key, p.ReadOnly, p.Clusters)
+	}
+
+	if p.Prefixes != nil {
+		key = fmt.Sprintf("%s/%s/%s", key, p.Prefixes.Username, p.Prefixes.Groups)
+	}
+
+	hash := md5.Sum([]byte(key))
+
 	return hex.EncodeToString(hash[:])
 }
 
+// Identity returns the ID of the principal, with the username prefix of its
+// provider if it has its own, as users of different OIDC providers can have
+// the same ID.
+func (p *UserPrincipal) Identity() string {
+	if p.Prefixes != nil {
+		return p.Prefixes.Username + p.ID
+	}
+
+	return p.ID
+}
+
+// IdentityGroups returns the groups of the principal, with the groups prefix
+// of its provider if it has its own.
+func (p *UserPrincipal) IdentityGroups() []string {
+	if p.Prefixes == nil || p.Prefixes.Groups == "" {
+		return p.Groups
+	}
+
+	groups := make([]string, 0, len(p.Groups))
+	for _, g := range p.Groups {
+		groups = append(groups, p.Prefixes.Groups+g)
+	}
+
+	return groups
+}
+
+// AllowsCluster tells whether the principal can use the cluster.
+func (p *UserPrincipal) AllowsCluster(name string) bool {
+	if len(p.Clusters) == 0 {
+		return true
+	}
+
+	for _, c := range p.Clusters {
+		if c == name {
+			return true
+		}
+	}
+
+	return false
+}
+
 func (p *UserPrincipal) Valid() bool {
 	if p.ID == "" && p.Token() == "" {
 		return false
@@ -153,12 +225,49 @@ func WithPrincipal(ctx context.Context, p *UserPrincipal) context.Context {
 	return context.WithValue(ctx, principalCtxKey{}, p)
 }
 
+// oidcPrincipalGetters return the getters of the principals of the OIDC
+// tokens, passed by token or cookie.
+func oidcPrincipalGetters(srv *AuthServer, sm SessionManager) []PrincipalGetter {
+	if srv.oidcPassthroughEnabled() {
+		srv.Log.V(logger.LogLevelDebug).Info("JWT Token Passthrough Enabled")
+	}
+
+	// With the provider of the OIDCConfig alone, the principals are those of
+	// its claims.
+	if len(srv.providers) == 1 && srv.providers[0].prefixes == nil {
+		provider := srv.providers[0]
+		getters := []PrincipalGetter{NewJWTAuthorizationHeaderPrincipalGetter(srv.Log, provider.verifier(), provider.config.ClaimsConfig)}
+
+		if srv.oidcPassthroughEnabled() {
+			return append(getters, NewJWTPassthroughCookiePrincipalGetter(srv.Log, provider.verifier(), IDTokenCookieName, sm))
+		}
+
+		return append(getters, NewJWTCookiePrincipalGetter(srv.Log, provider.verifier(), provider.config.ClaimsConfig, IDTokenCookieName, sm))
+	}
+
+	// Otherwise the claims and prefixes are those of the provider of the
+	// token.
+	getters := []PrincipalGetter{&oidcProvidersPrincipalGetter{log: srv.Log, providers: srv.providers}}
+
+	if srv.oidcPassthroughEnabled() {
+		return append(getters, NewJWTPassthroughCookiePrincipalGetter(srv.Log, srv.providers, IDTokenCookieName, sm))
+	}
+
+	return append(getters, &oidcProvidersPrincipalGetter{log: srv.Log, providers: srv.providers, cookieName: IDTokenCookieName, sm: sm})
+}
+
 // WithAPIAuth middleware adds auth validation to API handlers.
 //
 // Unauthorized requests will be denied with a 401 status code.
 func WithAPIAuth(next http.Handler, srv *AuthServer, publicRoutes []string, sm SessionManager) http.Handler {
 	multi := MultiAuthPrincipal{Log: srv.Log, Getters: []PrincipalGetter{}}
 
+	// Access tokens come first, so the other bearer token methods don't try
+	// to use them.
+	if srv.AccessTokens != nil {
+		multi.Getters = append(multi.Getters, NewAccessTokenPrincipalGetter(srv.Log, srv.AccessTokens))
+	}
+
 	// FIXME: currently the order must be OIDC last, or it'll "shadow" the other
 	// methods so they don't work.
 	methods := []AuthMethod{UserAccount, TokenPassthrough, OIDC, Anonymous}
@@ -176,15 +285,7 @@ func WithAPIAuth(next http.Handler, srv *AuthServer, publicRoutes []string, sm S
 		switch method {
 		case OIDC:
 			if srv.oidcEnabled() {
-				// OIDC tokens may be passed by token or cookie
-				multi.Getters = append(multi.Getters, NewJWTAuthorizationHeaderPrincipalGetter(srv.Log, srv.verifier(), srv.OIDCConfig.ClaimsConfig))
-
-				if srv.oidcPassthroughEnabled() {
-					srv.Log.V(logger.LogLevelDebug).Info("JWT Token Passthrough Enabled")
-					multi.Getters = append(multi.Getters, NewJWTPassthroughCookiePrincipalGetter(srv.Log, srv.verifier(), IDTokenCookieName, sm))
-				} else {
-					multi.Getters = append(multi.Getters, NewJWTCookiePrincipalGetter(srv.Log, srv.verifier(), srv.OIDCConfig.ClaimsConfig, IDTokenCookieName, sm))
-				}
+				multi.Getters = append(multi.Getters, oidcPrincipalGetters(srv, sm)...)
 			}
 
 		case UserAccount:
@@ -226,6 +327,8 @@ func (a *authenticatedMiddleware) ServeHTTP(rw http.ResponseWriter, r *http.Requ
 		return
 	}
 
+	a.srv.refreshExpiringSession(rw, r)
+
 	principal, err := a.principalGetter.Principal(r)
 
 	if err != nil || principal == nil {
@@ -233,7 +336,7 @@ func (a *authenticatedMiddleware) ServeHTTP(rw http.ResponseWriter, r *http.Requ
 		return
 	}
 
-	a.next.ServeHTTP(rw, r.Clone(WithPrincipal(r.Context(), principal)))
+	a.next.ServeHTTP(rw, r.Clone(WithPrincipal(r.Context(), a.srv.mapPrincipal(principal))))
 }
 
 func generateNonce() (string, error) {
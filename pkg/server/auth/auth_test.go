@@ -417,6 +417,20 @@ func TestUserPrincipal_String(t *testing.T) {
 	}
 }
 
+func TestUserPrincipalIdentity(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	anne := auth.NewUserPrincipal(auth.ID("anne"), auth.Groups([]string{"admins"}))
+	g.Expect(anne.Identity()).To(Equal("anne"))
+	g.Expect(anne.IdentityGroups()).To(Equal([]string{"admins"}))
+
+	// anne of an OIDC provider with its own prefixes
+	anne.Prefixes = &auth.ImpersonationPrefixes{Username: "gitlab:", Groups: "gitlab-"}
+	g.Expect(anne.Identity()).To(Equal("gitlab:anne"))
+	g.Expect(anne.IdentityGroups()).To(Equal([]string{"gitlab-admins"}))
+	g.Expect(anne.Groups).To(Equal([]string{"admins"}))
+}
+
 type sessionsCtxKey struct{}
 
 // Use the fakeSessionManager for cases where you want to pass in an
@@ -0,0 +1,177 @@
+package auth
+
+import (
+	"fmt"
+	"regexp"
+
+	"sigs.k8s.io/yaml"
+)
+
+// GroupMappingKey is the ConfigMap key the group mapping is read from.
+const GroupMappingKey = "groupMapping"
+
+// GroupMappingConfig describes how the groups of the users are mapped
+// before they are impersonated. The groups are renamed with Names first,
+// then those matching none of Include, if any, and those matching any of
+// Exclude are dropped, and last the groups users are bound to by Bindings
+// are added.
+//
+// For example:
+//
+//	names:
+//	  8f2c6a4e-2b1d-4f0e-9c3a-0d6f5e7b8a91: platform-team
+//	include:
+//	  - "^platform-"
+//	  - "^dev-"
+//	exclude:
+//	  - "-contractors$"
+//	bindings:
+//	  oidc:alice@example.com: [platform-admins]
+type GroupMappingConfig struct {
+	// Names maps groups, like the opaque IDs of some identity providers, to
+	// the names they are impersonated as.
+	Names map[string]string `json:"names,omitempty"`
+	// Include are regular expressions the groups must match one of, if any.
+	Include []string `json:"include,omitempty"`
+	// Exclude are regular expressions of the groups left out.
+	Exclude []string `json:"exclude,omitempty"`
+	// Bindings adds groups to users, by the username they're impersonated
+	// as, with the username prefix of their OIDC provider, so that the
+	// users of different providers can't be mistaken for one another.
+	Bindings map[string][]string `json:"bindings,omitempty"`
+}
+
+// GroupMapping maps the groups of the users, as configured by a
+// GroupMappingConfig.
+type GroupMapping struct {
+	names    map[string]string
+	include  []*regexp.Regexp
+	exclude  []*regexp.Regexp
+	bindings map[string][]string
+}
+
+// ParseGroupMapping parses a YAML GroupMappingConfig.
+func ParseGroupMapping(data []byte) (*GroupMapping, error) {
+	var cfg GroupMappingConfig
+
+	if err := yaml.UnmarshalStrict(data, &cfg); err != nil {
+		return nil, fmt.Errorf("parsing group mapping: %w", err)
+	}
+
+	return NewGroupMapping(cfg)
+}
+
+// NewGroupMapping returns the mapping of a GroupMappingConfig, failing if
+// its regular expressions don't compile.
+func NewGroupMapping(cfg GroupMappingConfig) (*GroupMapping, error) {
+	include, err := compileGroupPatterns(cfg.Include)
+	if err != nil {
+		return nil, err
+	}
+
+	exclude, err := compileGroupPatterns(cfg.Exclude)
+	if err != nil {
+		return nil, err
+	}
+
+	return &GroupMapping{
+		names:    cfg.Names,
+		include:  include,
+		exclude:  exclude,
+		bindings: cfg.Bindings,
+	}, nil
+}
+
+func compileGroupPatterns(patterns []string) ([]*regexp.Regexp, error) {
+	compiled := make([]*regexp.Regexp, 0, len(patterns))
+
+	for _, p := range patterns {
+		re, err := regexp.Compile(p)
+		if err != nil {
+			return nil, fmt.Errorf("invalid group pattern %q: %w", p, err)
+		}
+
+		compiled = append(compiled, re)
+	}
+
+	return compiled, nil
+}
+
+// MapGroups returns the groups a user is impersonated with, given the
+// username they're impersonated as and the groups of their claims. The order
+// of the groups is kept and duplicates are dropped.
+func (m *GroupMapping) MapGroups(username string, groups []string) []string {
+	var mapped []string
+
+	seen := map[string]bool{}
+
+	add := func(group string) {
+		if !seen[group] {
+			seen[group] = true
+			mapped = append(mapped, group)
+		}
+	}
+
+	for _, group := range groups {
+		if name, ok := m.names[group]; ok {
+			group = name
+		}
+
+		if m.included(group) {
+			add(group)
+		}
+	}
+
+	// users are bound to the groups explicitly, they aren't filtered
+	for _, group := range m.bindings[username] {
+		add(group)
+	}
+
+	return mapped
+}
+
+func (m *GroupMapping) included(group string) bool {
+	for _, re := range m.exclude {
+		if re.MatchString(group) {
+			return false
+		}
+	}
+
+	if len(m.include) == 0 {
+		return true
+	}
+
+	for _, re := range m.include {
+		if re.MatchString(group) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// mapPrincipal returns the principal with its groups mapped, the principal
+// itself if there is no mapping. Principals passing their token through
+// aren't impersonated, their groups are left alone.
+func (s *AuthServer) mapPrincipal(principal *UserPrincipal) *UserPrincipal {
+	if s.GroupMapping == nil || principal == nil || principal.Token() != "" {
+		return principal
+	}
+
+	prefixes := s.impersonationPrefixes(principal)
+
+	mapped := *principal
+	mapped.Groups = s.GroupMapping.MapGroups(prefixes.Username+principal.ID, principal.Groups)
+
+	return &mapped
+}
+
+// impersonationPrefixes returns the prefixes of the principal's provider,
+// those of the clusters if it has none.
+func (s *AuthServer) impersonationPrefixes(principal *UserPrincipal) ImpersonationPrefixes {
+	if principal.Prefixes != nil {
+		return *principal.Prefixes
+	}
+
+	return ImpersonationPrefixes{Username: s.OIDCConfig.UsernamePrefix, Groups: s.OIDCConfig.GroupsPrefix}
+}
@@ -0,0 +1,177 @@
+package auth_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	"github.com/weaveworks/weave-gitops/pkg/server/auth"
+)
+
+func TestGroupMapping(t *testing.T) {
+	mappingTests := []struct {
+		name   string
+		config string
+		user   string
+		groups []string
+		want   []string
+	}{
+		{
+			name:   "no mapping",
+			config: "",
+			user:   "alice",
+			groups: []string{"a", "b"},
+			want:   []string{"a", "b"},
+		},
+		{
+			name: "names",
+			config: `
+names:
+  8f2c6a4e: platform-team
+  0d6f5e7b: dev-team
+`,
+			user:   "alice",
+			groups: []string{"8f2c6a4e", "unknown", "0d6f5e7b"},
+			want:   []string{"platform-team", "unknown", "dev-team"},
+		},
+		{
+			name: "include and exclude the mapped names",
+			config: `
+names:
+  8f2c6a4e: platform-team
+  0d6f5e7b: platform-contractors
+include: ["^platform-", "^dev-"]
+exclude: ["-contractors$"]
+`,
+			user:   "alice",
+			groups: []string{"8f2c6a4e", "0d6f5e7b", "dev-team", "sales"},
+			want:   []string{"platform-team", "dev-team"},
+		},
+		{
+			name: "bindings aren't filtered",
+			config: `
+include: ["^dev-"]
+bindings:
+  alice: [ops, dev-team]
+  bob: [other]
+`,
+			user:   "alice",
+			groups: []string{"dev-team", "sales"},
+			want:   []string{"dev-team", "ops"},
+		},
+		{
+			name: "nothing left",
+			config: `
+exclude: [".*"]
+`,
+			user:   "alice",
+			groups: []string{"a"},
+			want:   nil,
+		},
+	}
+
+	for _, tt := range mappingTests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewGomegaWithT(t)
+
+			m, err := auth.ParseGroupMapping([]byte(tt.config))
+			g.Expect(err).NotTo(HaveOccurred())
+
+			g.Expect(m.MapGroups(tt.user, tt.groups)).To(Equal(tt.want))
+		})
+	}
+}
+
+func TestParseGroupMappingErrors(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	_, err := auth.ParseGroupMapping([]byte(`include: ["("]`))
+	g.Expect(err).To(MatchError(ContainSubstring(`invalid group pattern "("`)))
+
+	_, err = auth.ParseGroupMapping([]byte(`unknown: true`))
+	g.Expect(err).To(MatchError(ContainSubstring("parsing group mapping")))
+}
+
+func TestGroupsAreMappedBeforeImpersonating(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	tokenSignerVerifier, err := auth.NewHMACTokenSignerVerifier(5 * time.Minute)
+	g.Expect(err).NotTo(HaveOccurred())
+
+	s, m := makeAuthServer(t, nil, tokenSignerVerifier, []auth.AuthMethod{auth.OIDC}, &fakeSessionManager{})
+
+	// the mockoidc user has the groups engineering and design
+	s.GroupMapping, err = auth.NewGroupMapping(auth.GroupMappingConfig{
+		Names:   map[string]string{"engineering": "eng"},
+		Exclude: []string{"^design$"},
+		// bound by the username impersonated, the ID alone could be that
+		// of a user of another provider
+		Bindings: map[string][]string{
+			"oidc:jane.doe@example.com": {"oncall"},
+			"jane.doe@example.com":      {"unprefixed"},
+		},
+	})
+	g.Expect(err).NotTo(HaveOccurred())
+
+	s.OIDCConfig.UsernamePrefix = "oidc:"
+	s.OIDCConfig.GroupsPrefix = "oidc-groups:"
+
+	idToken := getVerifyTokens(t, m)["id_token"].(string)
+
+	t.Run("the API gets the principal with the mapped groups", func(t *testing.T) {
+		g := NewGomegaWithT(t)
+
+		var principal *auth.UserPrincipal
+
+		api := auth.WithAPIAuth(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			principal = auth.Principal(r.Context())
+		}), s, nil, &fakeSessionManager{})
+
+		req := httptest.NewRequest(http.MethodGet, "https://example.com/v1/objects", nil)
+		req.Header.Set("Authorization", "Bearer "+idToken)
+		api.ServeHTTP(httptest.NewRecorder(), req)
+
+		g.Expect(principal).NotTo(BeNil())
+		g.Expect(principal.ID).To(Equal("jane.doe@example.com"))
+		g.Expect(principal.Groups).To(Equal([]string{"eng", "oncall"}))
+	})
+
+	t.Run("the dry run shows the effective identity", func(t *testing.T) {
+		g := NewGomegaWithT(t)
+
+		body, err := json.Marshal(auth.IdentityRequest{Token: idToken})
+		g.Expect(err).NotTo(HaveOccurred())
+
+		w := httptest.NewRecorder()
+		s.Identity(w, httptest.NewRequest(http.MethodPost, "https://example.com/oauth2/identity", bytes.NewReader(body)))
+		g.Expect(w.Result().StatusCode).To(Equal(http.StatusOK))
+
+		var identity auth.Identity
+		g.Expect(json.NewDecoder(w.Result().Body).Decode(&identity)).To(Succeed())
+		g.Expect(identity).To(Equal(auth.Identity{
+			Provider:      auth.DefaultOIDCProviderName,
+			ID:            "jane.doe@example.com",
+			ClaimedGroups: []string{"engineering", "design"},
+			Groups:        []string{"eng", "oncall"},
+			Impersonation: &auth.Impersonation{
+				Username: "oidc:jane.doe@example.com",
+				Groups:   []string{"oidc-groups:eng", "oidc-groups:oncall"},
+			},
+		}))
+	})
+
+	t.Run("the dry run rejects invalid tokens", func(t *testing.T) {
+		g := NewGomegaWithT(t)
+
+		body, err := json.Marshal(auth.IdentityRequest{Token: "not-a-token"})
+		g.Expect(err).NotTo(HaveOccurred())
+
+		w := httptest.NewRecorder()
+		s.Identity(w, httptest.NewRequest(http.MethodPost, "https://example.com/oauth2/identity", bytes.NewReader(body)))
+		g.Expect(w.Result().StatusCode).To(Equal(http.StatusUnauthorized))
+	})
+}
@@ -0,0 +1,131 @@
+package auth
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/coreos/go-oidc/v3/oidc"
+)
+
+// IdentityRequest asks for the identity of a token, that of the session if
+// empty.
+type IdentityRequest struct {
+	Token string `json:"token"`
+}
+
+// Identity is who a token authenticates as, and how the user is
+// impersonated.
+type Identity struct {
+	// Provider is the name of the OIDC provider of the token, empty for
+	// the tokens of the cluster user.
+	Provider string `json:"provider,omitempty"`
+	ID       string `json:"id"`
+	// ClaimedGroups are the groups of the claims of the token.
+	ClaimedGroups []string `json:"claimedGroups"`
+	// Groups are the claimed groups once mapped.
+	Groups []string `json:"groups"`
+	// Impersonation is who the user is impersonated as, nil when the
+	// token is passed through to the clusters rather than impersonating.
+	Impersonation *Impersonation `json:"impersonation,omitempty"`
+}
+
+// Impersonation is the user and groups the requests to the clusters
+// impersonate.
+type Impersonation struct {
+	Username string   `json:"username"`
+	Groups   []string `json:"groups"`
+}
+
+// Identity shows who a token authenticates as, once its groups are mapped
+// and prefixed, without making any request to the clusters. It's meant to
+// check the group mapping and prefixes.
+func (s *AuthServer) Identity(rw http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		rw.Header().Add("Allow", "POST")
+		rw.WriteHeader(http.StatusMethodNotAllowed)
+
+		return
+	}
+
+	var req IdentityRequest
+
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		JSONError(s.Log, rw, "Failed to read request body.", http.StatusBadRequest)
+		return
+	}
+
+	token := req.Token
+	if token == "" {
+		token = s.SessionManager.GetString(r.Context(), IDTokenCookieName)
+	}
+
+	if token == "" {
+		JSONError(s.Log, rw, "no token given", http.StatusBadRequest)
+		return
+	}
+
+	identity, err := s.identity(oidc.ClientContext(r.Context(), s.client), token)
+	if err != nil {
+		JSONError(s.Log, rw, fmt.Sprintf("invalid token: %v", err), http.StatusUnauthorized)
+		return
+	}
+
+	rw.Header().Set("Content-Type", "application/json")
+
+	if err := json.NewEncoder(rw).Encode(identity); err != nil {
+		s.Log.Error(err, "Failing to write response")
+	}
+}
+
+func (s *AuthServer) identity(ctx context.Context, token string) (*Identity, error) {
+	var (
+		provider  string
+		principal *UserPrincipal
+	)
+
+	if claims, err := s.tokenSignerVerifier.Verify(token); err == nil {
+		principal = &UserPrincipal{ID: claims.Subject}
+	} else if s.oidcEnabled() {
+		p, idToken, err := s.providers.verify(ctx, token, (*oidcProvider).verifier)
+		if err != nil {
+			return nil, err
+		}
+
+		principal, err = p.principal(idToken)
+		if err != nil {
+			return nil, err
+		}
+
+		provider = p.name()
+
+		if s.oidcPassthroughEnabled() {
+			principal.SetToken(token)
+		}
+	} else {
+		return nil, errors.New("not a token of the cluster user")
+	}
+
+	mapped := s.mapPrincipal(principal)
+
+	identity := &Identity{
+		Provider:      provider,
+		ID:            principal.ID,
+		ClaimedGroups: principal.Groups,
+		Groups:        mapped.Groups,
+	}
+
+	if principal.Token() == "" {
+		prefixes := s.impersonationPrefixes(mapped)
+
+		identity.Impersonation = &Impersonation{Username: prefixes.Username + mapped.ID}
+
+		for _, group := range mapped.Groups {
+			identity.Impersonation.Groups = append(identity.Impersonation.Groups, prefixes.Groups+group)
+		}
+	}
+
+	return identity, nil
+}
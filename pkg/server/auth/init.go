@@ -5,9 +5,11 @@ import (
 	"fmt"
 	"net/http"
 	"net/url"
+	"time"
 
 	"github.com/alexedwards/scs/v2"
 	"github.com/go-logr/logr"
+	"github.com/weaveworks/weave-gitops/core/audit"
 	"github.com/weaveworks/weave-gitops/core/logger"
 	"github.com/weaveworks/weave-gitops/pkg/featureflags"
 	corev1 "k8s.io/api/core/v1"
@@ -23,6 +25,21 @@ type AuthParams struct {
 	NoAuthUser        string
 	Namespace         string
 	SessionManager    *scs.SessionManager
+	// AuditRecorder records the logins and logouts, if not nil.
+	AuditRecorder *audit.Recorder
+	// AccessTokens accepts the personal access tokens kept in it, if not nil.
+	AccessTokens AccessTokenStore
+	// SessionRefreshWindow is how long before their ID token expires the
+	// sessions are refreshed, DefaultSessionRefreshWindow if zero.
+	SessionRefreshWindow time.Duration
+	// OIDCProviderSecretNames are the names of the secrets configuring the
+	// OIDC providers users can sign in with besides that of OIDCConfig,
+	// with the keys of the OIDC secret. They are named after their secret
+	// unless they have a name.
+	OIDCProviderSecretNames []string
+	// GroupMapping maps the groups of the users before they're
+	// impersonated, if not nil.
+	GroupMapping *GroupMapping
 }
 
 // InitAuthServer creates a new AuthServer and configures it for the correct
@@ -46,6 +63,9 @@ func InitAuthServer(ctx context.Context, log logr.Logger, rawKubernetesClient ct
 	}
 
 	oidcConfig := authParams.OIDCConfig
+
+	var oidcProviders []OIDCConfig
+
 	if authMethods[OIDC] {
 		if authParams.OIDCSecretName != DefaultOIDCAuthSecretName {
 			log.V(logger.LogLevelDebug).Info("Reading OIDC configuration from alternate secret",
@@ -65,6 +85,20 @@ func InitAuthServer(ctx context.Context, log logr.Logger, rawKubernetesClient ct
 			}
 
 			oidcConfig = NewOIDCConfigFromSecret(secret)
+
+			// The prefixes are those of the clusters, the secret values
+			// taking precedence.
+			if oidcConfig.UsernamePrefix == "" {
+				oidcConfig.UsernamePrefix = authParams.OIDCConfig.UsernamePrefix
+			} else if authParams.OIDCConfig.UsernamePrefix != "" {
+				log.V(logger.LogLevelWarn).Info("OIDC username prefix configured by both CLI and secret. Secret values will take precedence.")
+			}
+
+			if oidcConfig.GroupsPrefix == "" {
+				oidcConfig.GroupsPrefix = authParams.OIDCConfig.GroupsPrefix
+			} else if authParams.OIDCConfig.GroupsPrefix != "" {
+				log.V(logger.LogLevelWarn).Info("OIDC groups prefix configured by both CLI and secret. Secret values will take precedence.")
+			}
 		} else if err != nil {
 			log.V(logger.LogLevelDebug).Info("Could not read OIDC secret",
 				"name", authParams.OIDCSecretName,
@@ -90,10 +124,20 @@ func InitAuthServer(ctx context.Context, log logr.Logger, rawKubernetesClient ct
 		if _, err := url.Parse(oidcConfig.RedirectURL); err != nil {
 			return nil, fmt.Errorf("invalid redirect URL: %w", err)
 		}
+
+		oidcProviders, err = readOIDCProviderSecrets(ctx, rawKubernetesClient, authParams.Namespace, authParams.OIDCProviderSecretNames)
+		if err != nil {
+			return nil, err
+		}
 	} else {
 		// Make sure there is no OIDC config if it's not an enabled authorization method
-		// the TokenDuration needs to be set so cookies can use it
-		oidcConfig = OIDCConfig{TokenDuration: defaultCookieDuration}
+		// the TokenDuration needs to be set so cookies can use it, and the prefixes
+		// still apply to the users impersonated
+		oidcConfig = OIDCConfig{
+			TokenDuration:  defaultCookieDuration,
+			UsernamePrefix: authParams.OIDCConfig.UsernamePrefix,
+			GroupsPrefix:   authParams.OIDCConfig.GroupsPrefix,
+		}
 	}
 
 	tsv, err := NewHMACTokenSignerVerifier(oidcConfig.TokenDuration)
@@ -107,15 +151,20 @@ func InitAuthServer(ctx context.Context, log logr.Logger, rawKubernetesClient ct
 	}
 
 	authServer, err := NewAuthServer(ctx, &AuthServerConfig{
-		Log:                 log.WithName("auth-server"),
-		client:              http.DefaultClient,
-		kubernetesClient:    rawKubernetesClient,
-		tokenSignerVerifier: tsv,
-		authMethods:         authMethods,
-		OIDCConfig:          oidcConfig,
-		namespace:           authParams.Namespace,
-		noAuthUser:          authParams.NoAuthUser,
-		SessionManager:      authParams.SessionManager,
+		Log:                  log.WithName("auth-server"),
+		client:               http.DefaultClient,
+		kubernetesClient:     rawKubernetesClient,
+		tokenSignerVerifier:  tsv,
+		authMethods:          authMethods,
+		OIDCConfig:           oidcConfig,
+		namespace:            authParams.Namespace,
+		noAuthUser:           authParams.NoAuthUser,
+		SessionManager:       authParams.SessionManager,
+		AuditRecorder:        authParams.AuditRecorder,
+		AccessTokens:         authParams.AccessTokens,
+		SessionRefreshWindow: authParams.SessionRefreshWindow,
+		OIDCProviders:        oidcProviders,
+		GroupMapping:         authParams.GroupMapping,
 	})
 	if err != nil {
 		return nil, fmt.Errorf("could not create auth server: %w", err)
@@ -125,3 +174,29 @@ func InitAuthServer(ctx context.Context, log logr.Logger, rawKubernetesClient ct
 
 	return authServer, err
 }
+
+// readOIDCProviderSecrets returns the configs of the additional OIDC
+// providers.
+func readOIDCProviderSecrets(ctx context.Context, c ctrlclient.Client, namespace string, names []string) ([]OIDCConfig, error) {
+	configs := []OIDCConfig{}
+
+	for _, name := range names {
+		var secret corev1.Secret
+		if err := c.Get(ctx, ctrlclient.ObjectKey{Namespace: namespace, Name: name}, &secret); err != nil {
+			return nil, fmt.Errorf("could not read OIDC provider secret %q: %w", name, err)
+		}
+
+		cfg := NewOIDCConfigFromSecret(secret)
+		if cfg.Name == "" {
+			cfg.Name = name
+		}
+
+		if _, err := url.Parse(cfg.IssuerURL); err != nil {
+			return nil, fmt.Errorf("invalid issuer URL of OIDC provider %q: %w", cfg.Name, err)
+		}
+
+		configs = append(configs, cfg)
+	}
+
+	return configs, nil
+}
@@ -179,3 +179,32 @@ func makeClusterUserSecret(password, secretName string) *corev1.Secret {
 		},
 	}
 }
+
+func TestInitAuthServerPrefixes(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+
+	m, err := mockoidc.Run()
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+
+	t.Cleanup(func() {
+		_ = m.Shutdown()
+	})
+
+	secret := makeOIDCSecret(m.Config(), auth.DefaultOIDCAuthSecretName)
+	secret.Data["oidcGroupsPrefix"] = []byte("secret-groups:")
+
+	featureflags.SetBoolean(auth.FeatureFlagOIDCAuth, false)
+
+	srv, err := auth.InitAuthServer(context.Background(), logr.Discard(), ctrlclient.NewClientBuilder().WithObjects(secret).Build(), auth.AuthParams{
+		AuthMethodStrings: []string{"oidc"},
+		OIDCConfig:        auth.OIDCConfig{UsernamePrefix: "cli:", GroupsPrefix: "cli-groups:"},
+		Namespace:         "test-namespace",
+		OIDCSecretName:    auth.DefaultOIDCAuthSecretName,
+		SessionManager:    scs.New(),
+	})
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+
+	// the secret takes precedence, the CLI prefixes applying otherwise
+	g.Expect(srv.OIDCConfig.UsernamePrefix).To(gomega.Equal("cli:"))
+	g.Expect(srv.OIDCConfig.GroupsPrefix).To(gomega.Equal("secret-groups:"))
+}
@@ -63,7 +63,7 @@ func (pg *BearerTokenPassthroughPrincipalGetter) Principal(r *http.Request) (*Us
 
 // NewJWTPassthroughCookiePrincipalGetter creates and returns a new
 // JWTPassthroughCookiePrincipalGetter.
-func NewJWTPassthroughCookiePrincipalGetter(log logr.Logger, verifier *oidc.IDTokenVerifier, cookieName string, sm SessionManager) PrincipalGetter {
+func NewJWTPassthroughCookiePrincipalGetter(log logr.Logger, verifier tokenVerifier, cookieName string, sm SessionManager) PrincipalGetter {
 	return &JWTPassthroughCookiePrincipalGetter{
 		log:        log,
 		verifier:   verifier,
@@ -78,7 +78,7 @@ func NewJWTPassthroughCookiePrincipalGetter(log logr.Logger, verifier *oidc.IDTo
 // The JWT Token is parsed, and the token and user/groups are available.
 type JWTPassthroughCookiePrincipalGetter struct {
 	log        logr.Logger
-	verifier   *oidc.IDTokenVerifier
+	verifier   tokenVerifier
 	cookieName string
 	sm         SessionManager
 }
@@ -0,0 +1,375 @@
+package auth
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/coreos/go-oidc/v3/oidc"
+	"github.com/go-logr/logr"
+	"github.com/weaveworks/weave-gitops/core/logger"
+	"golang.org/x/oauth2"
+)
+
+const (
+	// DefaultOIDCProviderName names the provider of the OIDCConfig of the
+	// server when it isn't named.
+	DefaultOIDCProviderName = "oidc"
+
+	// sessionProviderKey is the session key of the name of the provider the
+	// user logged in with.
+	sessionProviderKey = "provider"
+)
+
+// OIDCProviderInfo describes an OIDC provider users can sign in with.
+type OIDCProviderInfo struct {
+	Name        string `json:"name"`
+	DisplayName string `json:"displayName"`
+}
+
+// oidcProvider is an OIDC provider users can sign in with.
+type oidcProvider struct {
+	// config is that of the server for the provider of its OIDCConfig.
+	config   *OIDCConfig
+	provider *oidc.Provider
+	// prefixes are given to the principals of the provider, to be used
+	// rather than those of the clusters, when not nil. The provider of
+	// the OIDCConfig of the server uses those of the clusters, which it
+	// configures.
+	prefixes *ImpersonationPrefixes
+}
+
+func newOIDCProvider(ctx context.Context, cfg *OIDCConfig, ownPrefixes bool) (*oidcProvider, error) {
+	provider, err := oidc.NewProvider(ctx, cfg.IssuerURL)
+	if err != nil {
+		return nil, fmt.Errorf("could not create provider %q: %w", providerName(cfg), err)
+	}
+
+	p := &oidcProvider{config: cfg, provider: provider}
+
+	if ownPrefixes {
+		p.prefixes = &ImpersonationPrefixes{Username: cfg.UsernamePrefix, Groups: cfg.GroupsPrefix}
+	}
+
+	return p, nil
+}
+
+// newOIDCProviders returns the providers of the OIDCConfig of the server,
+// if it has an issuer, and of the additional configs.
+func newOIDCProviders(ctx context.Context, primary *OIDCConfig, additional []OIDCConfig) (oidcProviders, error) {
+	if err := validatePrefixes(primary, additional); err != nil {
+		return nil, err
+	}
+
+	providers := oidcProviders{}
+
+	if primary.IssuerURL != "" {
+		p, err := newOIDCProvider(ctx, primary, false)
+		if err != nil {
+			return nil, err
+		}
+
+		providers = append(providers, p)
+	}
+
+	for i := range additional {
+		cfg := &additional[i]
+
+		if providers.named(providerName(cfg)) != nil {
+			return nil, fmt.Errorf("duplicate OIDC provider %q", providerName(cfg))
+		}
+
+		p, err := newOIDCProvider(ctx, cfg, true)
+		if err != nil {
+			return nil, err
+		}
+
+		providers = append(providers, p)
+	}
+
+	return providers, nil
+}
+
+// validatePrefixes checks every additional provider has a username and a
+// groups prefix, distinct from those of the other providers, so that the
+// users of one provider can't be impersonated as those of another. A prefix
+// which starts another isn't distinct: "corp:" and "corp:eu:" would both give
+// "corp:eu:anne".
+func validatePrefixes(primary *OIDCConfig, additional []OIDCConfig) error {
+	usernames := map[string]string{}
+	groups := map[string]string{}
+
+	if primary.IssuerURL != "" {
+		if primary.UsernamePrefix != "" {
+			usernames[providerName(primary)] = primary.UsernamePrefix
+		}
+
+		if primary.GroupsPrefix != "" {
+			groups[providerName(primary)] = primary.GroupsPrefix
+		}
+	}
+
+	for i := range additional {
+		cfg := &additional[i]
+		name := providerName(cfg)
+
+		if cfg.UsernamePrefix == "" || cfg.GroupsPrefix == "" {
+			return fmt.Errorf("OIDC provider %q needs a username and a groups prefix", name)
+		}
+
+		if other := overlappingPrefix(usernames, cfg.UsernamePrefix); other != "" {
+			return fmt.Errorf("OIDC provider %q has a username prefix overlapping that of provider %q", name, other)
+		}
+
+		if other := overlappingPrefix(groups, cfg.GroupsPrefix); other != "" {
+			return fmt.Errorf("OIDC provider %q has a groups prefix overlapping that of provider %q", name, other)
+		}
+
+		usernames[name] = cfg.UsernamePrefix
+		groups[name] = cfg.GroupsPrefix
+	}
+
+	return nil
+}
+
+// overlappingPrefix returns the name of the provider whose prefix starts
+// prefix, or is started by it.
+func overlappingPrefix(prefixes map[string]string, prefix string) string {
+	for name, other := range prefixes {
+		if strings.HasPrefix(prefix, other) || strings.HasPrefix(other, prefix) {
+			return name
+		}
+	}
+
+	return ""
+}
+
+func providerName(cfg *OIDCConfig) string {
+	if cfg.Name == "" {
+		return DefaultOIDCProviderName
+	}
+
+	return cfg.Name
+}
+
+func (p *oidcProvider) name() string {
+	return providerName(p.config)
+}
+
+func (p *oidcProvider) info() OIDCProviderInfo {
+	displayName := p.config.DisplayName
+	if displayName == "" {
+		displayName = p.name()
+	}
+
+	return OIDCProviderInfo{Name: p.name(), DisplayName: displayName}
+}
+
+func (p *oidcProvider) verifier() *oidc.IDTokenVerifier {
+	return p.provider.Verifier(&oidc.Config{ClientID: p.config.ClientID})
+}
+
+// expiredTokenVerifier verifies tokens which may have expired, which still
+// tell who the user was.
+func (p *oidcProvider) expiredTokenVerifier() *oidc.IDTokenVerifier {
+	return p.provider.Verifier(&oidc.Config{ClientID: p.config.ClientID, SkipExpiryCheck: true})
+}
+
+func (p *oidcProvider) oauth2Config(scopes []string) *oauth2.Config {
+	requestScopes := []string{}
+	// Ensure "openid" scope is always present.
+	if !contains(scopes, oidc.ScopeOpenID) {
+		requestScopes = append(requestScopes, oidc.ScopeOpenID)
+	}
+
+	requestScopes = append(requestScopes, scopes...)
+
+	return &oauth2.Config{
+		ClientID:     p.config.ClientID,
+		ClientSecret: p.config.ClientSecret,
+		RedirectURL:  p.config.RedirectURL,
+		Endpoint:     p.provider.Endpoint(),
+		Scopes:       requestScopes,
+	}
+}
+
+// principal returns the user of a token of the provider, with the claims
+// and prefixes of the provider.
+func (p *oidcProvider) principal(token claimsToken) (*UserPrincipal, error) {
+	principal, err := p.config.ClaimsConfig.PrincipalFromClaims(token)
+	if err != nil {
+		return nil, err
+	}
+
+	principal.Prefixes = p.prefixes
+
+	return principal, nil
+}
+
+// oidcProviders are the providers users can sign in with, the first being
+// the default one.
+type oidcProviders []*oidcProvider
+
+func (ps oidcProviders) named(name string) *oidcProvider {
+	if len(ps) == 0 {
+		return nil
+	}
+
+	if name == "" {
+		return ps[0]
+	}
+
+	for _, p := range ps {
+		if p.name() == name {
+			return p
+		}
+	}
+
+	return nil
+}
+
+// Verify verifies an ID token with the providers it may come from, as told
+// by its issuer.
+func (ps oidcProviders) Verify(ctx context.Context, rawIDToken string) (*oidc.IDToken, error) {
+	_, token, err := ps.verify(ctx, rawIDToken, (*oidcProvider).verifier)
+
+	return token, err
+}
+
+// principal returns the user of an ID token of any of the providers.
+func (ps oidcProviders) principal(ctx context.Context, rawIDToken string) (*UserPrincipal, error) {
+	p, token, err := ps.verify(ctx, rawIDToken, (*oidcProvider).verifier)
+	if err != nil {
+		return nil, err
+	}
+
+	return p.principal(token)
+}
+
+// verify verifies a token with the verifier of the providers of its
+// issuer. Providers can share an issuer, with different clients.
+func (ps oidcProviders) verify(ctx context.Context, rawToken string, verifier func(*oidcProvider) *oidc.IDTokenVerifier) (*oidcProvider, *oidc.IDToken, error) {
+	issuer, err := unverifiedIssuer(rawToken)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to verify JWT token: %w", err)
+	}
+
+	var errs []error
+
+	for _, p := range ps {
+		if strings.TrimSuffix(p.config.IssuerURL, "/") != strings.TrimSuffix(issuer, "/") {
+			continue
+		}
+
+		token, err := verifier(p).Verify(ctx, rawToken)
+		if err == nil {
+			return p, token, nil
+		}
+
+		errs = append(errs, err)
+	}
+
+	if len(errs) == 0 {
+		return nil, nil, fmt.Errorf("failed to verify JWT token: no OIDC provider for issuer %q", issuer)
+	}
+
+	return nil, nil, fmt.Errorf("failed to verify JWT token: %w", errors.Join(errs...))
+}
+
+// unverifiedIssuer returns the issuer a token claims, to tell which provider
+// can verify it.
+func unverifiedIssuer(rawToken string) (string, error) {
+	parts := strings.Split(rawToken, ".")
+	if len(parts) != 3 {
+		return "", errors.New("malformed jwt")
+	}
+
+	payload, err := base64.RawURLEncoding.DecodeString(parts[1])
+	if err != nil {
+		return "", fmt.Errorf("malformed jwt payload: %w", err)
+	}
+
+	claims := struct {
+		Issuer string `json:"iss"`
+	}{}
+
+	if err := json.Unmarshal(payload, &claims); err != nil {
+		return "", fmt.Errorf("malformed jwt claims: %w", err)
+	}
+
+	return claims.Issuer, nil
+}
+
+// oidcProvidersPrincipalGetter inspects the Authorization header, or a
+// session cookie, for an ID token of any of the OIDC providers, and returns
+// the principal with the claims and prefixes of its provider.
+type oidcProvidersPrincipalGetter struct {
+	log        logr.Logger
+	providers  oidcProviders
+	cookieName string
+	sm         SessionManager
+}
+
+func (pg *oidcProvidersPrincipalGetter) Principal(r *http.Request) (*UserPrincipal, error) {
+	var rawToken string
+
+	if pg.cookieName == "" {
+		header := r.Header.Get("Authorization")
+		if header == "" {
+			return nil, nil
+		}
+
+		rawToken = extractToken(header)
+	} else {
+		rawToken = pg.sm.GetString(r.Context(), pg.cookieName)
+		if rawToken == "" {
+			pg.log.V(logger.LogLevelDebug).Info("no cookie in session", "cookieName", pg.cookieName)
+			return nil, nil
+		}
+	}
+
+	return pg.providers.principal(r.Context(), rawToken)
+}
+
+// Providers lists the OIDC providers users can sign in with, the first one
+// being the default.
+func (s *AuthServer) Providers(rw http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		rw.Header().Add("Allow", "GET")
+		rw.WriteHeader(http.StatusMethodNotAllowed)
+
+		return
+	}
+
+	providers := []OIDCProviderInfo{}
+
+	if s.oidcEnabled() {
+		for _, p := range s.providers {
+			providers = append(providers, p.info())
+		}
+	}
+
+	rw.Header().Set("Content-Type", "application/json")
+
+	if err := json.NewEncoder(rw).Encode(providers); err != nil {
+		s.Log.Error(err, "Failing to write response")
+	}
+}
+
+// sessionProvider returns the provider the user of the session logged in
+// with. Sessions from before there were several providers are for the
+// default one.
+func (s *AuthServer) sessionProvider(ctx context.Context) (*oidcProvider, error) {
+	name := s.SessionManager.GetString(ctx, sessionProviderKey)
+
+	p := s.providers.named(name)
+	if p == nil {
+		return nil, fmt.Errorf("unknown OIDC provider %q", name)
+	}
+
+	return p, nil
+}
@@ -0,0 +1,291 @@
+package auth_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/alexedwards/scs/v2"
+	"github.com/go-logr/logr"
+	"github.com/oauth2-proxy/mockoidc"
+	. "github.com/onsi/gomega"
+	"github.com/weaveworks/weave-gitops/pkg/featureflags"
+	"github.com/weaveworks/weave-gitops/pkg/server/auth"
+	ctrlclientfake "sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+const testRedirectURL = "https://example.com/oauth2/callback"
+
+func TestProviders(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	s, _, _ := makeMultiProviderAuthServer(t, scs.New())
+
+	w := httptest.NewRecorder()
+	s.Providers(w, httptest.NewRequest(http.MethodGet, "https://example.com/oauth2/providers", nil))
+	g.Expect(w.Result().StatusCode).To(Equal(http.StatusOK))
+
+	var providers []auth.OIDCProviderInfo
+	g.Expect(json.NewDecoder(w.Result().Body).Decode(&providers)).To(Succeed())
+	g.Expect(providers).To(Equal([]auth.OIDCProviderInfo{
+		{Name: auth.DefaultOIDCProviderName, DisplayName: auth.DefaultOIDCProviderName},
+		{Name: "corp", DisplayName: "Corp SSO"},
+	}))
+}
+
+func TestSignInWithAnotherProvider(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	sm := scs.New()
+	s, m, corp := makeMultiProviderAuthServer(t, sm)
+
+	// starts the flow with the provider picked
+	w := httptest.NewRecorder()
+	sm.LoadAndSave(s.OAuth2Flow()).ServeHTTP(w, httptest.NewRequest(http.MethodGet, "https://example.com/oauth2?provider=corp&return_url=https://example.com/app", nil))
+	g.Expect(w.Result().StatusCode).To(Equal(http.StatusSeeOther))
+
+	authorizeURL, err := url.Parse(w.Result().Header.Get("Location"))
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(authorizeURL.String()).To(HavePrefix(corp.AuthorizationEndpoint()))
+
+	stateCookies := w.Result().Cookies()
+
+	// corp redirects back with a code
+	authorizeResp, err := httpClient.Get(authorizeURL.String())
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(authorizeResp.StatusCode).To(Equal(http.StatusFound))
+
+	callback := httptest.NewRequest(http.MethodGet, authorizeResp.Header.Get("Location"), nil)
+	for _, c := range stateCookies {
+		callback.AddCookie(c)
+	}
+
+	// the code is exchanged with corp, not the default provider
+	w = httptest.NewRecorder()
+	sm.LoadAndSave(http.HandlerFunc(s.Callback)).ServeHTTP(w, callback)
+	g.Expect(w.Result().StatusCode).To(Equal(http.StatusSeeOther))
+	g.Expect(w.Result().Header.Get("Location")).To(Equal("https://example.com/app"))
+
+	sessionCookies := w.Result().Cookies()
+	g.Expect(sessionCookies).To(HaveLen(1))
+
+	var principal *auth.UserPrincipal
+
+	api := sm.LoadAndSave(auth.WithAPIAuth(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		principal = auth.Principal(r.Context())
+	}), s, nil, sm))
+
+	req := httptest.NewRequest(http.MethodGet, "https://example.com/v1/objects", nil)
+	req.AddCookie(sessionCookies[0])
+	api.ServeHTTP(httptest.NewRecorder(), req)
+
+	g.Expect(principal).NotTo(BeNil())
+	g.Expect(principal.ID).To(Equal("1234567890"), "the claims of corp are used")
+	g.Expect(principal.Prefixes).To(Equal(&auth.ImpersonationPrefixes{Username: "corp:", Groups: "corp-groups:"}))
+
+	req = httptest.NewRequest(http.MethodGet, "https://example.com/oauth2/userinfo", nil)
+	req.AddCookie(sessionCookies[0])
+
+	w = httptest.NewRecorder()
+	sm.LoadAndSave(http.HandlerFunc(s.UserInfo)).ServeHTTP(w, req)
+	g.Expect(w.Result().StatusCode).To(Equal(http.StatusOK))
+
+	var info auth.UserInfo
+	g.Expect(json.NewDecoder(w.Result().Body).Decode(&info)).To(Succeed())
+	g.Expect(info.ID).To(Equal("1234567890"))
+
+	t.Run("tokens of the default provider keep its claims and the cluster prefixes", func(t *testing.T) {
+		g := NewGomegaWithT(t)
+
+		principal = nil
+
+		req := httptest.NewRequest(http.MethodGet, "https://example.com/v1/objects", nil)
+		req.Header.Set("Authorization", "Bearer "+getVerifyTokens(t, m)["id_token"].(string))
+		api.ServeHTTP(httptest.NewRecorder(), req)
+
+		g.Expect(principal).NotTo(BeNil())
+		g.Expect(principal.ID).To(Equal("jane.doe@example.com"))
+		g.Expect(principal.Prefixes).To(BeNil())
+	})
+
+	t.Run("tokens of the other provider get its claims and prefixes", func(t *testing.T) {
+		g := NewGomegaWithT(t)
+
+		principal = nil
+
+		req := httptest.NewRequest(http.MethodGet, "https://example.com/v1/objects", nil)
+		req.Header.Set("Authorization", "Bearer "+getVerifyTokens(t, corp)["id_token"].(string))
+		api.ServeHTTP(httptest.NewRecorder(), req)
+
+		g.Expect(principal).NotTo(BeNil())
+		g.Expect(principal.ID).To(Equal("1234567890"))
+		g.Expect(principal.Prefixes.Username).To(Equal("corp:"))
+	})
+}
+
+func TestSignInWithUnknownProvider(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	sm := scs.New()
+	s, _, _ := makeMultiProviderAuthServer(t, sm)
+
+	w := httptest.NewRecorder()
+	sm.LoadAndSave(s.OAuth2Flow()).ServeHTTP(w, httptest.NewRequest(http.MethodGet, "https://example.com/oauth2?provider=other", nil))
+	g.Expect(w.Result().StatusCode).To(Equal(http.StatusBadRequest))
+}
+
+func TestDuplicateProviders(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	featureflags.SetBoolean("OIDC_AUTH", false)
+
+	m := runMockOIDC(t)
+
+	authCfg, err := auth.NewAuthServerConfig(logr.Discard(), mockOIDCConfig(m), ctrlclientfake.NewClientBuilder().Build(), nil, testNamespace, map[auth.AuthMethod]bool{auth.OIDC: true}, "", scs.New())
+	g.Expect(err).NotTo(HaveOccurred())
+
+	duplicate := mockOIDCConfig(m)
+	duplicate.UsernamePrefix = "duplicate:"
+	duplicate.GroupsPrefix = "duplicate-groups:"
+
+	authCfg.OIDCProviders = []auth.OIDCConfig{duplicate}
+
+	_, err = auth.NewAuthServer(context.Background(), authCfg)
+	g.Expect(err).To(MatchError(`duplicate OIDC provider "oidc"`))
+}
+
+func TestProviderPrefixes(t *testing.T) {
+	featureflags.SetBoolean("OIDC_AUTH", false)
+
+	m := runMockOIDC(t)
+
+	provider := func(name, usernamePrefix, groupsPrefix string) auth.OIDCConfig {
+		cfg := mockOIDCConfig(m)
+		cfg.Name = name
+		cfg.UsernamePrefix = usernamePrefix
+		cfg.GroupsPrefix = groupsPrefix
+
+		return cfg
+	}
+
+	primary := mockOIDCConfig(m)
+	primary.UsernamePrefix = "oidc:"
+	primary.GroupsPrefix = "oidc-groups:"
+
+	tests := []struct {
+		name       string
+		additional []auth.OIDCConfig
+		err        string
+	}{
+		{
+			name:       "distinct prefixes",
+			additional: []auth.OIDCConfig{provider("corp", "corp:", "corp-groups:"), provider("partner", "partner:", "partner-groups:")},
+		},
+		{
+			name:       "no username prefix",
+			additional: []auth.OIDCConfig{provider("corp", "", "corp-groups:")},
+			err:        `OIDC provider "corp" needs a username and a groups prefix`,
+		},
+		{
+			name:       "no groups prefix",
+			additional: []auth.OIDCConfig{provider("corp", "corp:", "")},
+			err:        `OIDC provider "corp" needs a username and a groups prefix`,
+		},
+		{
+			name:       "same prefix as the primary provider",
+			additional: []auth.OIDCConfig{provider("corp", "oidc:", "corp-groups:")},
+			err:        `OIDC provider "corp" has a username prefix overlapping that of provider "oidc"`,
+		},
+		{
+			name:       "same prefix as another provider",
+			additional: []auth.OIDCConfig{provider("corp", "corp:", "corp-groups:"), provider("partner", "partner:", "corp-groups:")},
+			err:        `OIDC provider "partner" has a groups prefix overlapping that of provider "corp"`,
+		},
+		{
+			name:       "prefix starting another",
+			additional: []auth.OIDCConfig{provider("corp", "corp:", "corp-groups:"), provider("corp-eu", "corp:eu:", "corp-eu-groups:")},
+			err:        `OIDC provider "corp-eu" has a username prefix overlapping that of provider "corp"`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewGomegaWithT(t)
+
+			authCfg, err := auth.NewAuthServerConfig(logr.Discard(), primary, ctrlclientfake.NewClientBuilder().Build(), nil, testNamespace, map[auth.AuthMethod]bool{auth.OIDC: true}, "", scs.New())
+			g.Expect(err).NotTo(HaveOccurred())
+
+			authCfg.OIDCProviders = tt.additional
+
+			_, err = auth.NewAuthServer(context.Background(), authCfg)
+			if tt.err == "" {
+				g.Expect(err).NotTo(HaveOccurred())
+			} else {
+				g.Expect(err).To(MatchError(tt.err))
+			}
+		})
+	}
+}
+
+// makeMultiProviderAuthServer returns an auth server signing in with the
+// default provider and another named "corp", using other claims and prefixes.
+func makeMultiProviderAuthServer(t *testing.T, sm auth.SessionManager) (*auth.AuthServer, *mockoidc.MockOIDC, *mockoidc.MockOIDC) {
+	t.Helper()
+	g := NewGomegaWithT(t)
+
+	featureflags.SetBoolean("OIDC_AUTH", false)
+
+	m, corp := runMockOIDC(t), runMockOIDC(t)
+
+	tokenSignerVerifier, err := auth.NewHMACTokenSignerVerifier(5 * time.Minute)
+	g.Expect(err).NotTo(HaveOccurred())
+
+	authCfg, err := auth.NewAuthServerConfig(logr.Discard(), mockOIDCConfig(m), ctrlclientfake.NewClientBuilder().Build(), tokenSignerVerifier, testNamespace, map[auth.AuthMethod]bool{auth.OIDC: true}, "", sm)
+	g.Expect(err).NotTo(HaveOccurred())
+
+	corpCfg := mockOIDCConfig(corp)
+	corpCfg.Name = "corp"
+	corpCfg.DisplayName = "Corp SSO"
+	corpCfg.ClaimsConfig = &auth.ClaimsConfig{Username: "sub", Groups: "groups"}
+	corpCfg.UsernamePrefix = "corp:"
+	corpCfg.GroupsPrefix = "corp-groups:"
+
+	authCfg.OIDCProviders = []auth.OIDCConfig{corpCfg}
+
+	s, err := auth.NewAuthServer(context.Background(), authCfg)
+	g.Expect(err).NotTo(HaveOccurred())
+
+	return s, m, corp
+}
+
+func runMockOIDC(t *testing.T) *mockoidc.MockOIDC {
+	t.Helper()
+
+	m, err := mockoidc.Run()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Cleanup(func() {
+		_ = m.Shutdown()
+	})
+
+	return m
+}
+
+func mockOIDCConfig(m *mockoidc.MockOIDC) auth.OIDCConfig {
+	cfg := m.Config()
+
+	return auth.OIDCConfig{
+		ClientID:     cfg.ClientID,
+		ClientSecret: cfg.ClientSecret,
+		IssuerURL:    cfg.Issuer,
+		RedirectURL:  testRedirectURL,
+		Scopes:       []string{"openid", "email", "profile", "groups"},
+		ClaimsConfig: &auth.ClaimsConfig{Username: auth.ClaimUsername, Groups: auth.ClaimGroups},
+	}
+}
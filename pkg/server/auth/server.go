@@ -12,6 +12,7 @@ import (
 
 	"github.com/coreos/go-oidc/v3/oidc"
 	"github.com/go-logr/logr"
+	"github.com/weaveworks/weave-gitops/core/audit"
 	"github.com/weaveworks/weave-gitops/core/logger"
 	"github.com/weaveworks/weave-gitops/pkg/featureflags"
 	"golang.org/x/crypto/bcrypt"
@@ -50,6 +51,12 @@ var DefaultScopes = []string{
 // OIDCConfig is used to configure an AuthServer to interact with
 // an OIDC issuer.
 type OIDCConfig struct {
+	// Name identifies the provider among those users can sign in with,
+	// DefaultOIDCProviderName if empty.
+	Name string
+	// DisplayName is what the provider is called on the sign in page, its
+	// Name if empty.
+	DisplayName    string
 	IssuerURL      string
 	ClientID       string
 	ClientSecret   string
@@ -77,13 +84,32 @@ type AuthServerConfig struct {
 
 	noAuthUser     string
 	SessionManager SessionManager
+	// AuditRecorder records the logins and logouts, which aren't recorded
+	// when nil.
+	AuditRecorder *audit.Recorder
+	// AccessTokens authenticates the requests with personal access tokens,
+	// which aren't accepted when nil.
+	AccessTokens AccessTokenStore
+	// SessionRefreshWindow is how long before their ID token expires the
+	// OIDC sessions are refreshed, DefaultSessionRefreshWindow if zero.
+	SessionRefreshWindow time.Duration
+	// OIDCProviders are the OIDC providers users can sign in with besides
+	// that of OIDCConfig. Their principals get their own username and
+	// groups prefixes, rather than those of the clusters.
+	OIDCProviders []OIDCConfig
+	// GroupMapping maps the groups of the users before they're
+	// impersonated, which are left as they are when nil.
+	GroupMapping *GroupMapping
 }
 
 // AuthServer interacts with an OIDC issuer to handle the OAuth2 process flow.
 type AuthServer struct {
 	AuthServerConfig
-	provider *oidc.Provider
-	sm       SessionManager
+	// providers are the OIDC providers users can sign in with, that of
+	// OIDCConfig first.
+	providers oidcProviders
+	sm        SessionManager
+	rotator   *tokenRotator
 }
 
 // LoginRequest represents the data submitted by client when the auth flow (non-OIDC) is used.
@@ -108,12 +134,16 @@ type UserInfo struct {
 //   - redirectURL
 //
 // The following keys are optional
+// - name - defaults to "oidc", to tell the provider from the others
+// - displayName - defaults to the name, shown on the sign in page
 // - tokenDuration - defaults to 1 hour.
 // - claimUsername - defaults to "email"
 // - claimGroups - defaults to "groups"
 // - customScopes - defaults to "openid","offline_access","email","groups"
 func NewOIDCConfigFromSecret(secret corev1.Secret) OIDCConfig {
 	cfg := OIDCConfig{
+		Name:           string(secret.Data["name"]),
+		DisplayName:    string(secret.Data["displayName"]),
 		IssuerURL:      string(secret.Data["issuerURL"]),
 		ClientID:       string(secret.Data["clientID"]),
 		ClientSecret:   string(secret.Data["clientSecret"]),
@@ -210,16 +240,22 @@ func NewAuthServer(ctx context.Context, cfg *AuthServerConfig) (*AuthServer, err
 		featureflags.SetBoolean(FeatureFlagClusterUser, false)
 	}
 
-	var provider *oidc.Provider
+	s := &AuthServer{
+		AuthServerConfig: *cfg,
+		sm:               cfg.SessionManager,
+		rotator:          &tokenRotator{},
+	}
+	// the providers keep pointers to their configs
+	s.OIDCProviders = append([]OIDCConfig(nil), cfg.OIDCProviders...)
 
-	if cfg.OIDCConfig.IssuerURL == "" {
+	if cfg.OIDCConfig.IssuerURL == "" && len(cfg.OIDCProviders) == 0 {
 		featureflags.SetBoolean(FeatureFlagOIDCAuth, false)
 	} else if cfg.authMethods[OIDC] {
 		var err error
 
-		provider, err = oidc.NewProvider(ctx, cfg.OIDCConfig.IssuerURL)
+		s.providers, err = newOIDCProviders(ctx, &s.OIDCConfig, s.OIDCProviders)
 		if err != nil {
-			return nil, fmt.Errorf("could not create provider: %w", err)
+			return nil, err
 		}
 		featureflags.SetBoolean(FeatureFlagOIDCAuth, true)
 	}
@@ -234,13 +270,17 @@ func NewAuthServer(ctx context.Context, cfg *AuthServerConfig) (*AuthServer, err
 		return nil, fmt.Errorf("OIDC auth, local auth or anonymous mode must be enabled, can't start")
 	}
 
-	return &AuthServer{*cfg, provider, cfg.SessionManager}, nil
+	return s, nil
 }
 
 // SetRedirectURL is used to set the redirect URL. This is meant to be used
 // in unit tests only.
 func (s *AuthServer) SetRedirectURL(url string) {
 	s.OIDCConfig.RedirectURL = url
+
+	for i := range s.OIDCProviders {
+		s.OIDCProviders[i].RedirectURL = url
+	}
 }
 
 func (s *AuthServer) oidcEnabled() bool {
@@ -251,28 +291,6 @@ func (s *AuthServer) oidcPassthroughEnabled() bool {
 	return featureflags.IsSet(FeatureFlagOIDCPassthrough)
 }
 
-func (s *AuthServer) verifier() *oidc.IDTokenVerifier {
-	return s.provider.Verifier(&oidc.Config{ClientID: s.OIDCConfig.ClientID})
-}
-
-func (s *AuthServer) oauth2Config(scopes []string) *oauth2.Config {
-	requestScopes := []string{}
-	// Ensure "openid" scope is always present.
-	if !contains(scopes, oidc.ScopeOpenID) {
-		requestScopes = append(requestScopes, oidc.ScopeOpenID)
-	}
-
-	requestScopes = append(requestScopes, scopes...)
-
-	return &oauth2.Config{
-		ClientID:     s.OIDCConfig.ClientID,
-		ClientSecret: s.OIDCConfig.ClientSecret,
-		RedirectURL:  s.OIDCConfig.RedirectURL,
-		Endpoint:     s.provider.Endpoint(),
-		Scopes:       requestScopes,
-	}
-}
-
 func (s *AuthServer) OAuth2Flow() http.HandlerFunc {
 	return func(rw http.ResponseWriter, r *http.Request) {
 		if !s.oidcEnabled() {
@@ -297,6 +315,7 @@ func (s *AuthServer) Callback(rw http.ResponseWriter, r *http.Request) {
 	// Authorization redirect callback from OAuth2 auth flow.
 	if errorCode := r.FormValue("error"); errorCode != "" {
 		s.Log.Info("authz redirect callback failed", "error", errorCode, "error_description", r.FormValue("error_description"))
+		s.recordAudit(r, audit.ActionLogin, nil, fmt.Errorf("%s: %s", errorCode, r.FormValue("error_description")))
 		rw.WriteHeader(http.StatusBadRequest)
 
 		return
@@ -341,9 +360,18 @@ func (s *AuthServer) Callback(rw http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	token, err := s.oauth2Config(nil).Exchange(ctx, code)
+	provider := s.providers.named(state.Provider)
+	if provider == nil {
+		s.Log.Info("unknown OIDC provider in state", "provider", state.Provider)
+		rw.WriteHeader(http.StatusBadRequest)
+
+		return
+	}
+
+	token, err := provider.oauth2Config(nil).Exchange(ctx, code)
 	if err != nil {
 		s.Log.Error(err, "failed to exchange auth code for token", "code", code)
+		s.recordAudit(r, audit.ActionLogin, nil, fmt.Errorf("failed to exchange auth code for token: %w", err))
 		rw.WriteHeader(http.StatusInternalServerError)
 
 		return
@@ -351,17 +379,35 @@ func (s *AuthServer) Callback(rw http.ResponseWriter, r *http.Request) {
 
 	rawIDToken, ok := token.Extra("id_token").(string)
 	if !ok {
+		s.recordAudit(r, audit.ActionLogin, nil, errors.New("no id_token in token response"))
 		JSONError(s.Log, rw, "no id_token in token response", http.StatusInternalServerError)
 		return
 	}
 
-	_, err = s.verifier().Verify(r.Context(), rawIDToken)
+	idToken, err := provider.verifier().Verify(r.Context(), rawIDToken)
 	if err != nil {
+		s.recordAudit(r, audit.ActionLogin, nil, fmt.Errorf("failed to verify ID token: %w", err))
 		JSONError(s.Log, rw, fmt.Sprintf("failed to verify ID token: %v", err), http.StatusInternalServerError)
 		return
 	}
 
+	principal, err := provider.principal(idToken)
+	if err != nil {
+		s.Log.V(logger.LogLevelWarn).Info("failed to read the user from the ID token", "error", err)
+	}
+
+	if err := s.startSession(r.Context(), principal); err != nil {
+		s.recordAudit(r, audit.ActionLogin, principal, err)
+		JSONError(s.Log, rw, "failed to start session", http.StatusInternalServerError)
+
+		return
+	}
+
+	s.recordAudit(r, audit.ActionLogin, principal, nil)
+
 	s.setCookies(r.Context(), rawIDToken, token.AccessToken, token.RefreshToken)
+	s.putIDTokenClaims(r.Context(), idToken)
+	s.SessionManager.Put(r.Context(), sessionProviderKey, provider.name())
 	// Clear state cookie
 	s.SessionManager.Remove(r.Context(), StateCookieName)
 
@@ -410,8 +456,11 @@ func (s *AuthServer) SignIn() http.HandlerFunc {
 			return
 		}
 
+		attempted := &UserPrincipal{ID: loginRequest.Username}
+
 		if loginRequest.Username != string(hashedSecret.Data["username"]) {
 			s.Log.Info("Wrong username")
+			s.recordAudit(r, audit.ActionLogin, attempted, errors.New("wrong username"))
 			rw.WriteHeader(http.StatusUnauthorized)
 
 			return
@@ -419,6 +468,7 @@ func (s *AuthServer) SignIn() http.HandlerFunc {
 
 		if err := bcrypt.CompareHashAndPassword(hashedSecret.Data["password"], []byte(loginRequest.Password)); err != nil {
 			s.Log.Error(err, "Failed to compare hash with password")
+			s.recordAudit(r, audit.ActionLogin, attempted, errors.New("wrong password"))
 			rw.WriteHeader(http.StatusUnauthorized)
 
 			return
@@ -432,7 +482,16 @@ func (s *AuthServer) SignIn() http.HandlerFunc {
 			return
 		}
 
+		if err := s.startSession(r.Context(), attempted); err != nil {
+			s.Log.Error(err, "Failed to start session")
+			s.recordAudit(r, audit.ActionLogin, attempted, err)
+			rw.WriteHeader(http.StatusInternalServerError)
+
+			return
+		}
+
 		s.SessionManager.Put(r.Context(), IDTokenCookieName, signed)
+		s.recordAudit(r, audit.ActionLogin, attempted, nil)
 		rw.WriteHeader(http.StatusOK)
 	}
 }
@@ -483,7 +542,15 @@ func (s *AuthServer) UserInfo(rw http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	info, err := s.verifier().Verify(r.Context(), idCookie)
+	provider, err := s.sessionProvider(r.Context())
+	if err != nil {
+		s.Log.Error(err, "failed to find the provider of the session")
+		JSONError(s.Log, rw, err.Error(), http.StatusUnauthorized)
+
+		return
+	}
+
+	info, err := provider.verifier().Verify(r.Context(), idCookie)
 	if err != nil {
 		s.Log.Error(err, "failed to parse user ID token")
 		JSONError(s.Log, rw, fmt.Sprintf("failed to parse id token: %v", err), http.StatusUnauthorized)
@@ -491,7 +558,7 @@ func (s *AuthServer) UserInfo(rw http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	userPrincipal, err := s.OIDCConfig.ClaimsConfig.PrincipalFromClaims(info)
+	userPrincipal, err := provider.principal(info)
 	if err != nil {
 		s.Log.Error(err, "failed to parse user info")
 		JSONError(s.Log, rw, fmt.Sprintf("failed to query user info endpoint: %v", err), http.StatusUnauthorized)
@@ -499,6 +566,8 @@ func (s *AuthServer) UserInfo(rw http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	userPrincipal = s.mapPrincipal(userPrincipal)
+
 	ui := UserInfo{
 		ID:     userPrincipal.ID,
 		Email:  userPrincipal.ID,
@@ -525,8 +594,9 @@ func (s *AuthServer) RefreshHandler(rw http.ResponseWriter, r *http.Request) {
 	rw.WriteHeader(http.StatusOK)
 }
 
-// Refresh is used to refresh the access token and id token. It updates the cookies on the response
-// with the new tokens. It returns the new user principal.
+// Refresh is used to refresh the access token and id token. It updates the session with the
+// new tokens, the refresh token being replaced if the provider rotates them. It returns the new
+// user principal.
 func (s *AuthServer) Refresh(rw http.ResponseWriter, r *http.Request) (*UserPrincipal, error) {
 	ctx := oidc.ClientContext(r.Context(), s.client)
 
@@ -535,11 +605,18 @@ func (s *AuthServer) Refresh(rw http.ResponseWriter, r *http.Request) (*UserPrin
 		return nil, errors.New("couldn't fetch refresh token from cookie")
 	}
 
-	token, err := s.oauth2Config(nil).TokenSource(
-		ctx,
-		&oauth2.Token{
-			RefreshToken: refreshTokenCookie,
-		}).Token()
+	provider, err := s.sessionProvider(r.Context())
+	if err != nil {
+		return nil, err
+	}
+
+	token, err := s.rotator.rotate(refreshTokenCookie, func() (*oauth2.Token, error) {
+		return provider.oauth2Config(nil).TokenSource(
+			ctx,
+			&oauth2.Token{
+				RefreshToken: refreshTokenCookie,
+			}).Token()
+	})
 	if err != nil {
 		return nil, fmt.Errorf("failed to refresh token: %w", err)
 	}
@@ -549,9 +626,20 @@ func (s *AuthServer) Refresh(rw http.ResponseWriter, r *http.Request) (*UserPrin
 		return nil, errors.New("no id_token in token response")
 	}
 
-	s.setCookies(r.Context(), rawIDToken, token.AccessToken, token.RefreshToken)
+	idToken, err := provider.verifier().Verify(ctx, rawIDToken)
+	if err != nil {
+		return nil, fmt.Errorf("failed to verify JWT token: %w", err)
+	}
+
+	refreshToken := token.RefreshToken
+	if refreshToken == "" {
+		refreshToken = refreshTokenCookie
+	}
+
+	s.setCookies(r.Context(), rawIDToken, token.AccessToken, refreshToken)
+	s.putIDTokenClaims(r.Context(), idToken)
 
-	return parseJWTToken(ctx, s.verifier(), rawIDToken, s.OIDCConfig.ClaimsConfig, s.Log)
+	return provider.principal(idToken)
 }
 
 func toJSON(rw http.ResponseWriter, ui UserInfo, log logr.Logger) {
@@ -574,6 +662,12 @@ func (s *AuthServer) startAuthFlow(rw http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	provider := s.providers.named(r.URL.Query().Get("provider"))
+	if provider == nil {
+		JSONError(s.Log, rw, fmt.Sprintf("unknown OIDC provider %q", r.URL.Query().Get("provider")), http.StatusBadRequest)
+		return
+	}
+
 	returnURL := r.URL.Query().Get("return_url")
 
 	if returnURL == "" {
@@ -583,6 +677,7 @@ func (s *AuthServer) startAuthFlow(rw http.ResponseWriter, r *http.Request) {
 	b, err := json.Marshal(SessionState{
 		Nonce:     nonce,
 		ReturnURL: returnURL,
+		Provider:  provider.name(),
 	})
 	if err != nil {
 		JSONError(s.Log, rw, fmt.Sprintf("failed to marshal state to JSON: %v", err), http.StatusInternalServerError)
@@ -591,7 +686,7 @@ func (s *AuthServer) startAuthFlow(rw http.ResponseWriter, r *http.Request) {
 
 	state := base64.StdEncoding.EncodeToString(b)
 
-	authCodeURL := s.oauth2Config(s.OIDCConfig.Scopes).AuthCodeURL(state)
+	authCodeURL := provider.oauth2Config(provider.config.Scopes).AuthCodeURL(state)
 
 	// Issue state cookie
 	s.SessionManager.Put(r.Context(), StateCookieName, state)
@@ -607,15 +702,80 @@ func (s *AuthServer) Logout(rw http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	principal := s.sessionPrincipal(r)
+
 	if err := s.SessionManager.Destroy(r.Context()); err != nil {
 		s.Log.Error(err, "failed to destroy session")
+		s.recordAudit(r, audit.ActionLogout, principal, err)
 		rw.WriteHeader(http.StatusInternalServerError)
 		return
 	}
 
+	s.recordAudit(r, audit.ActionLogout, principal, nil)
+
 	rw.WriteHeader(http.StatusOK)
 }
 
+// sessionPrincipal returns the user of the session, if it can be told from
+// its ID token. Expired ID tokens still tell who the user was.
+func (s *AuthServer) sessionPrincipal(r *http.Request) *UserPrincipal {
+	idToken := s.SessionManager.GetString(r.Context(), IDTokenCookieName)
+	if idToken == "" {
+		return nil
+	}
+
+	if claims, err := s.tokenSignerVerifier.Verify(idToken); err == nil {
+		return &UserPrincipal{ID: claims.Subject}
+	}
+
+	if !s.oidcEnabled() {
+		return nil
+	}
+
+	provider, err := s.sessionProvider(r.Context())
+	if err != nil {
+		return nil
+	}
+
+	info, err := provider.expiredTokenVerifier().Verify(r.Context(), idToken)
+	if err != nil {
+		return nil
+	}
+
+	principal, err := provider.principal(info)
+	if err != nil {
+		return nil
+	}
+
+	return principal
+}
+
+// recordAudit records a login or logout of the user, who may be unknown.
+// The outcome is a failure if err isn't nil.
+func (s *AuthServer) recordAudit(r *http.Request, action string, principal *UserPrincipal, err error) {
+	if s.AuditRecorder == nil {
+		return
+	}
+
+	event := audit.Event{
+		Action:   action,
+		Outcome:  audit.OutcomeSuccess,
+		SourceIP: audit.RequestSourceIP(r),
+	}
+
+	if principal != nil {
+		event.Principal = principal.Identity()
+		event.Groups = principal.IdentityGroups()
+	}
+
+	if err != nil {
+		event.Outcome = audit.OutcomeFailure
+		event.Message = err.Error()
+	}
+
+	s.AuditRecorder.Record(r.Context(), event)
+}
+
 // SessionState represents the state that needs to be persisted between
 // the AuthN request from the Relying Party (RP) to the authorization
 // endpoint of the OpenID Provider (OP) and the AuthN response back from
@@ -631,6 +791,8 @@ func (s *AuthServer) Logout(rw http.ResponseWriter, r *http.Request) {
 type SessionState struct {
 	Nonce     string `json:"n"`
 	ReturnURL string `json:"return_url"`
+	// Provider is the name of the OIDC provider the user signs in with.
+	Provider string `json:"p,omitempty"`
 }
 
 func contains(ss []string, s string) bool {
@@ -19,6 +19,7 @@ import (
 	"github.com/google/go-cmp/cmp"
 	"github.com/oauth2-proxy/mockoidc"
 	. "github.com/onsi/gomega"
+	"github.com/weaveworks/weave-gitops/core/audit"
 	"github.com/weaveworks/weave-gitops/pkg/featureflags"
 	"github.com/weaveworks/weave-gitops/pkg/server/auth"
 	"golang.org/x/crypto/bcrypt"
@@ -882,6 +883,71 @@ func TestLogoutSuccess(t *testing.T) {
 	g.Expect(sm.Destroyed).To(ContainElement("test-session"))
 }
 
+func TestSignInAndLogoutAreAudited(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	password := "my-secret-password"
+	hashed, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+	g.Expect(err).NotTo(HaveOccurred())
+
+	hashedSecret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "cluster-user-auth",
+			Namespace: "flux-system",
+		},
+		Data: map[string][]byte{
+			"username": []byte("admin"),
+			"password": hashed,
+		},
+	}
+	fakeKubernetesClient := ctrlclientfake.NewClientBuilder().WithObjects(hashedSecret).Build()
+
+	tokenSignerVerifier, err := auth.NewHMACTokenSignerVerifier(5 * time.Minute)
+	g.Expect(err).NotTo(HaveOccurred())
+
+	sm := &fakeSessionManager{}
+	s, _ := makeAuthServer(t, fakeKubernetesClient, tokenSignerVerifier, []auth.AuthMethod{auth.UserAccount}, sm)
+
+	recorder := audit.NewRecorder(logr.Discard(), audit.DefaultRetained)
+	s.AuditRecorder = recorder
+
+	signIn := func(password string) {
+		j, err := json.Marshal(auth.LoginRequest{Username: "admin", Password: password})
+		g.Expect(err).NotTo(HaveOccurred())
+
+		req := httptest.NewRequest(http.MethodPost, "https://example.com/signin", bytes.NewReader(j))
+		req.RemoteAddr = "192.168.0.1:4321"
+		s.SignIn().ServeHTTP(httptest.NewRecorder(), req)
+	}
+
+	signIn("wrong")
+	signIn(password)
+
+	req := httptest.NewRequest(http.MethodPost, "https://example.com/logout", nil).WithContext(
+		contextWithValues(context.Background(), map[string]any{
+			"sessionid":            "test-session",
+			auth.IDTokenCookieName: sm.stringValue(auth.IDTokenCookieName),
+		}))
+	s.Logout(httptest.NewRecorder(), req)
+
+	events := recorder.List(audit.Query{})
+	g.Expect(events).To(HaveLen(3))
+
+	logout, login, failed := events[0], events[1], events[2]
+
+	g.Expect(failed.Action).To(Equal(audit.ActionLogin))
+	g.Expect(failed.Outcome).To(Equal(audit.OutcomeFailure))
+	g.Expect(failed.Principal).To(Equal("admin"))
+	g.Expect(failed.SourceIP).To(Equal("192.168.0.1"))
+
+	g.Expect(login.Action).To(Equal(audit.ActionLogin))
+	g.Expect(login.Outcome).To(Equal(audit.OutcomeSuccess))
+
+	g.Expect(logout.Action).To(Equal(audit.ActionLogout))
+	g.Expect(logout.Outcome).To(Equal(audit.OutcomeSuccess))
+	g.Expect(logout.Principal).To(Equal("admin"), "the user is told from the session")
+}
+
 func TestLogoutWithWrongMethod(t *testing.T) {
 	g := NewGomegaWithT(t)
 
@@ -1060,6 +1126,24 @@ func TestNewOIDCConfigFromSecret(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "named provider",
+			data: map[string][]byte{
+				"name":               []byte("corp"),
+				"displayName":        []byte("Corp SSO"),
+				"oidcUsernamePrefix": []byte("corp:"),
+				"oidcGroupsPrefix":   []byte("corp-groups:"),
+			},
+			want: auth.OIDCConfig{
+				Name:           "corp",
+				DisplayName:    "Corp SSO",
+				UsernamePrefix: "corp:",
+				GroupsPrefix:   "corp-groups:",
+				TokenDuration:  time.Hour * 1,
+				ClaimsConfig:   &auth.ClaimsConfig{Username: "email", Groups: "groups"},
+				Scopes:         []string{oidc.ScopeOpenID, oidc.ScopeOfflineAccess, auth.ScopeEmail, auth.ScopeGroups},
+			},
+		},
 		{
 			name: "overridden scopes",
 			data: map[string][]byte{
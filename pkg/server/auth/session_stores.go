@@ -0,0 +1,531 @@
+package auth
+
+import (
+	"bufio"
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"strconv"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/util/retry"
+	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// DefaultSessionsSecretName is the Secret the sessions are kept in by
+	// the Secret session store.
+	DefaultSessionsSecretName = "weave-gitops-sessions"
+	// DefaultRedisSessionPrefix starts the keys of the sessions in Redis.
+	DefaultRedisSessionPrefix = "weave-gitops:session:"
+)
+
+// The session stores work with scs.SessionManager, which finds the sessions
+// by the token in the session cookie. Each of them implements scs.Store,
+// scs.CtxStore, scs.IterableStore and scs.IterableCtxStore, the latter
+// being needed to list and revoke the sessions.
+
+// storedSession is a session and when it expires.
+type storedSession struct {
+	Data   []byte    `json:"data"`
+	Expiry time.Time `json:"expiry"`
+}
+
+// SecretSessionStore keeps the sessions in a Secret, so they survive the
+// server restarting and are shared by its replicas. Each request reads the
+// Secret, and Secrets are limited to 1MiB, so it suits a few hundred
+// sessions; use Redis beyond that.
+type SecretSessionStore struct {
+	client ctrlclient.Client
+	key    ctrlclient.ObjectKey
+}
+
+// NewSecretSessionStore returns a store keeping the sessions in the named
+// Secret, which is created on the first session.
+func NewSecretSessionStore(c ctrlclient.Client, namespace, name string) *SecretSessionStore {
+	return &SecretSessionStore{
+		client: c,
+		key:    ctrlclient.ObjectKey{Namespace: namespace, Name: name},
+	}
+}
+
+func (s *SecretSessionStore) Find(token string) ([]byte, bool, error) {
+	return s.FindCtx(context.Background(), token)
+}
+
+func (s *SecretSessionStore) Commit(token string, b []byte, expiry time.Time) error {
+	return s.CommitCtx(context.Background(), token, b, expiry)
+}
+
+func (s *SecretSessionStore) Delete(token string) error {
+	return s.DeleteCtx(context.Background(), token)
+}
+
+func (s *SecretSessionStore) All() (map[string][]byte, error) {
+	return s.AllCtx(context.Background())
+}
+
+func (s *SecretSessionStore) FindCtx(ctx context.Context, token string) ([]byte, bool, error) {
+	secret, err := s.get(ctx)
+	if err != nil {
+		return nil, false, err
+	}
+
+	session, ok := decodeStoredSession(secret.Data[token])
+	if !ok || time.Now().After(session.Expiry) {
+		return nil, false, nil
+	}
+
+	return session.Data, true, nil
+}
+
+func (s *SecretSessionStore) CommitCtx(ctx context.Context, token string, b []byte, expiry time.Time) error {
+	data, err := json.Marshal(storedSession{Data: b, Expiry: expiry})
+	if err != nil {
+		return fmt.Errorf("encoding session: %w", err)
+	}
+
+	return s.update(ctx, func(secret *corev1.Secret) {
+		if secret.Data == nil {
+			secret.Data = map[string][]byte{}
+		}
+
+		// The expired sessions are pruned as the others are saved.
+		now := time.Now()
+
+		for t, d := range secret.Data {
+			if session, ok := decodeStoredSession(d); !ok || now.After(session.Expiry) {
+				delete(secret.Data, t)
+			}
+		}
+
+		secret.Data[token] = data
+	})
+}
+
+func (s *SecretSessionStore) DeleteCtx(ctx context.Context, token string) error {
+	secret, err := s.get(ctx)
+	if err != nil {
+		return err
+	}
+
+	if _, ok := secret.Data[token]; !ok {
+		return nil
+	}
+
+	return s.update(ctx, func(secret *corev1.Secret) {
+		delete(secret.Data, token)
+	})
+}
+
+func (s *SecretSessionStore) AllCtx(ctx context.Context) (map[string][]byte, error) {
+	secret, err := s.get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	sessions := map[string][]byte{}
+
+	for token, data := range secret.Data {
+		if session, ok := decodeStoredSession(data); ok && now.Before(session.Expiry) {
+			sessions[token] = session.Data
+		}
+	}
+
+	return sessions, nil
+}
+
+// get returns the Secret, or an empty one if it doesn't exist yet.
+func (s *SecretSessionStore) get(ctx context.Context) (*corev1.Secret, error) {
+	secret := &corev1.Secret{}
+
+	if err := s.client.Get(ctx, s.key, secret); err != nil {
+		if apierrors.IsNotFound(err) {
+			return &corev1.Secret{}, nil
+		}
+
+		return nil, fmt.Errorf("getting sessions: %w", err)
+	}
+
+	return secret, nil
+}
+
+// update applies change to the Secret, creating it if needed, and retries on
+// conflicts with the other replicas.
+func (s *SecretSessionStore) update(ctx context.Context, change func(*corev1.Secret)) error {
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		secret := &corev1.Secret{}
+
+		err := s.client.Get(ctx, s.key, secret)
+		if err != nil && !apierrors.IsNotFound(err) {
+			return fmt.Errorf("getting sessions: %w", err)
+		}
+
+		if apierrors.IsNotFound(err) {
+			secret = &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      s.key.Name,
+					Namespace: s.key.Namespace,
+				},
+			}
+
+			change(secret)
+
+			return s.client.Create(ctx, secret)
+		}
+
+		change(secret)
+
+		return s.client.Update(ctx, secret)
+	})
+}
+
+func decodeStoredSession(data []byte) (storedSession, bool) {
+	if data == nil {
+		return storedSession{}, false
+	}
+
+	session := storedSession{}
+	if err := json.Unmarshal(data, &session); err != nil {
+		return storedSession{}, false
+	}
+
+	return session, true
+}
+
+// RedisOptions configures the connection to Redis, or to a server speaking
+// its protocol.
+type RedisOptions struct {
+	// Address is the host:port of the server.
+	Address  string
+	Username string
+	Password string
+	DB       int
+	// TLS connects with TLS when set.
+	TLS *tls.Config
+	// Prefix starts the keys of the sessions, DefaultRedisSessionPrefix if
+	// not set.
+	Prefix string
+	// Timeout bounds connecting and each command, 5 seconds if not set.
+	Timeout time.Duration
+	// MaxIdleConns is how many connections are kept for reuse, 10 if not
+	// set.
+	MaxIdleConns int
+}
+
+// RedisSessionStore keeps the sessions in Redis, which expires them.
+type RedisSessionStore struct {
+	opts  RedisOptions
+	conns chan *redisConn
+}
+
+// NewRedisSessionStore returns a store keeping the sessions in Redis.
+// Connections are made as needed.
+func NewRedisSessionStore(opts RedisOptions) *RedisSessionStore {
+	if opts.Prefix == "" {
+		opts.Prefix = DefaultRedisSessionPrefix
+	}
+
+	if opts.Timeout == 0 {
+		opts.Timeout = 5 * time.Second
+	}
+
+	if opts.MaxIdleConns == 0 {
+		opts.MaxIdleConns = 10
+	}
+
+	return &RedisSessionStore{
+		opts:  opts,
+		conns: make(chan *redisConn, opts.MaxIdleConns),
+	}
+}
+
+func (s *RedisSessionStore) Find(token string) ([]byte, bool, error) {
+	return s.FindCtx(context.Background(), token)
+}
+
+func (s *RedisSessionStore) Commit(token string, b []byte, expiry time.Time) error {
+	return s.CommitCtx(context.Background(), token, b, expiry)
+}
+
+func (s *RedisSessionStore) Delete(token string) error {
+	return s.DeleteCtx(context.Background(), token)
+}
+
+func (s *RedisSessionStore) All() (map[string][]byte, error) {
+	return s.AllCtx(context.Background())
+}
+
+func (s *RedisSessionStore) FindCtx(ctx context.Context, token string) ([]byte, bool, error) {
+	reply, err := s.do(ctx, "GET", s.opts.Prefix+token)
+	if err != nil {
+		return nil, false, err
+	}
+
+	if reply == nil {
+		return nil, false, nil
+	}
+
+	b, ok := reply.([]byte)
+	if !ok {
+		return nil, false, fmt.Errorf("unexpected reply to GET: %v", reply)
+	}
+
+	return b, true, nil
+}
+
+func (s *RedisSessionStore) CommitCtx(ctx context.Context, token string, b []byte, expiry time.Time) error {
+	ttl := time.Until(expiry).Milliseconds()
+	if ttl <= 0 {
+		return s.DeleteCtx(ctx, token)
+	}
+
+	_, err := s.do(ctx, "SET", s.opts.Prefix+token, b, "PX", strconv.FormatInt(ttl, 10))
+
+	return err
+}
+
+func (s *RedisSessionStore) DeleteCtx(ctx context.Context, token string) error {
+	_, err := s.do(ctx, "DEL", s.opts.Prefix+token)
+	return err
+}
+
+func (s *RedisSessionStore) AllCtx(ctx context.Context) (map[string][]byte, error) {
+	sessions := map[string][]byte{}
+	cursor := "0"
+
+	for {
+		reply, err := s.do(ctx, "SCAN", cursor, "MATCH", s.opts.Prefix+"*", "COUNT", "100")
+		if err != nil {
+			return nil, err
+		}
+
+		page, ok := reply.([]interface{})
+		if !ok || len(page) != 2 {
+			return nil, fmt.Errorf("unexpected reply to SCAN: %v", reply)
+		}
+
+		next, _ := page[0].([]byte)
+		keys, _ := page[1].([]interface{})
+
+		for _, k := range keys {
+			key, _ := k.([]byte)
+			token := strings.TrimPrefix(string(key), s.opts.Prefix)
+
+			// Sessions expiring meanwhile are skipped.
+			b, found, err := s.FindCtx(ctx, token)
+			if err != nil {
+				return nil, err
+			}
+
+			if found {
+				sessions[token] = b
+			}
+		}
+
+		cursor = string(next)
+		if cursor == "0" || cursor == "" {
+			return sessions, nil
+		}
+	}
+}
+
+// do sends a command, on an idle connection if there is one, and returns
+// the reply: nil, a string, an int64, []byte or []interface{}.
+func (s *RedisSessionStore) do(ctx context.Context, args ...interface{}) (interface{}, error) {
+	conn, err := s.conn(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	reply, err := conn.do(ctx, s.opts.Timeout, args...)
+	if err != nil {
+		var redisErr redisError
+		if !errors.As(err, &redisErr) {
+			// the connection is in an unknown state
+			conn.Close()
+			return nil, fmt.Errorf("redis %s: %w", args[0], err)
+		}
+
+		s.release(conn)
+
+		return nil, fmt.Errorf("redis %s: %w", args[0], err)
+	}
+
+	s.release(conn)
+
+	return reply, nil
+}
+
+func (s *RedisSessionStore) conn(ctx context.Context) (*redisConn, error) {
+	select {
+	case conn := <-s.conns:
+		return conn, nil
+	default:
+	}
+
+	dialer := &net.Dialer{Timeout: s.opts.Timeout}
+
+	var (
+		c   net.Conn
+		err error
+	)
+
+	if s.opts.TLS != nil {
+		c, err = (&tls.Dialer{NetDialer: dialer, Config: s.opts.TLS}).DialContext(ctx, "tcp", s.opts.Address)
+	} else {
+		c, err = dialer.DialContext(ctx, "tcp", s.opts.Address)
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("connecting to redis: %w", err)
+	}
+
+	conn := &redisConn{Conn: c, r: bufio.NewReader(c)}
+
+	if s.opts.Password != "" {
+		args := []interface{}{"AUTH", s.opts.Password}
+		if s.opts.Username != "" {
+			args = []interface{}{"AUTH", s.opts.Username, s.opts.Password}
+		}
+
+		if _, err := conn.do(ctx, s.opts.Timeout, args...); err != nil {
+			conn.Close()
+			return nil, fmt.Errorf("authenticating to redis: %w", err)
+		}
+	}
+
+	if s.opts.DB != 0 {
+		if _, err := conn.do(ctx, s.opts.Timeout, "SELECT", strconv.Itoa(s.opts.DB)); err != nil {
+			conn.Close()
+			return nil, fmt.Errorf("selecting redis database: %w", err)
+		}
+	}
+
+	return conn, nil
+}
+
+func (s *RedisSessionStore) release(conn *redisConn) {
+	select {
+	case s.conns <- conn:
+	default:
+		conn.Close()
+	}
+}
+
+// redisError is an error replied by the server, after which the connection
+// can still be used.
+type redisError string
+
+func (e redisError) Error() string {
+	return string(e)
+}
+
+// redisConn speaks enough of RESP, the Redis protocol, for the sessions.
+type redisConn struct {
+	net.Conn
+	r *bufio.Reader
+}
+
+func (c *redisConn) do(ctx context.Context, timeout time.Duration, args ...interface{}) (interface{}, error) {
+	deadline := time.Now().Add(timeout)
+	if d, ok := ctx.Deadline(); ok && d.Before(deadline) {
+		deadline = d
+	}
+
+	if err := c.SetDeadline(deadline); err != nil {
+		return nil, err
+	}
+
+	var b strings.Builder
+
+	fmt.Fprintf(&b, "*%d\r\n", len(args))
+
+	for _, arg := range args {
+		var s string
+
+		switch a := arg.(type) {
+		case string:
+			s = a
+		case []byte:
+			s = string(a)
+		default:
+			return nil, fmt.Errorf("unsupported argument type %T", arg)
+		}
+
+		fmt.Fprintf(&b, "$%d\r\n%s\r\n", len(s), s)
+	}
+
+	if _, err := io.WriteString(c, b.String()); err != nil {
+		return nil, err
+	}
+
+	return c.readReply()
+}
+
+func (c *redisConn) readReply() (interface{}, error) {
+	line, err := c.r.ReadString('\n')
+	if err != nil {
+		return nil, err
+	}
+
+	line = strings.TrimSuffix(line, "\r\n")
+	if line == "" {
+		return nil, errors.New("empty reply")
+	}
+
+	switch line[0] {
+	case '+':
+		return line[1:], nil
+	case '-':
+		return nil, redisError(line[1:])
+	case ':':
+		return strconv.ParseInt(line[1:], 10, 64)
+	case '$':
+		n, err := strconv.Atoi(line[1:])
+		if err != nil {
+			return nil, err
+		}
+
+		if n < 0 {
+			return nil, nil
+		}
+
+		data := make([]byte, n+2)
+		if _, err := io.ReadFull(c.r, data); err != nil {
+			return nil, err
+		}
+
+		return data[:n], nil
+	case '*':
+		n, err := strconv.Atoi(line[1:])
+		if err != nil {
+			return nil, err
+		}
+
+		if n < 0 {
+			return nil, nil
+		}
+
+		items := make([]interface{}, n)
+
+		for i := range items {
+			if items[i], err = c.readReply(); err != nil {
+				return nil, err
+			}
+		}
+
+		return items, nil
+	default:
+		return nil, fmt.Errorf("unexpected reply %q", line)
+	}
+}
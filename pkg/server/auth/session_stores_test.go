@@ -0,0 +1,243 @@
+package auth_test
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"net"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/alexedwards/scs/v2"
+	. "github.com/onsi/gomega"
+	"github.com/weaveworks/weave-gitops/pkg/server/auth"
+	ctrlclientfake "sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestSecretSessionStore(t *testing.T) {
+	testSessionStore(t, auth.NewSecretSessionStore(ctrlclientfake.NewClientBuilder().Build(), testNamespace, auth.DefaultSessionsSecretName))
+}
+
+func TestRedisSessionStore(t *testing.T) {
+	redis := newFakeRedis(t, "secret")
+
+	testSessionStore(t, auth.NewRedisSessionStore(auth.RedisOptions{Address: redis.addr, Password: "secret"}))
+
+	t.Run("fails to authenticate with the wrong password", func(t *testing.T) {
+		g := NewGomegaWithT(t)
+
+		store := auth.NewRedisSessionStore(auth.RedisOptions{Address: redis.addr, Password: "wrong"})
+
+		_, _, err := store.Find("abc")
+		g.Expect(err).To(MatchError(ContainSubstring("authenticating to redis")))
+	})
+}
+
+type sessionStore interface {
+	scs.Store
+	scs.IterableStore
+}
+
+func testSessionStore(t *testing.T, store sessionStore) {
+	g := NewGomegaWithT(t)
+
+	_, found, err := store.Find("abc")
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(found).To(BeFalse())
+
+	g.Expect(store.Commit("abc", []byte("anne"), time.Now().Add(time.Hour))).To(Succeed())
+	g.Expect(store.Commit("def", []byte("bob"), time.Now().Add(time.Hour))).To(Succeed())
+	g.Expect(store.Commit("expired", []byte("carol"), time.Now().Add(-time.Second))).To(Succeed())
+
+	b, found, err := store.Find("abc")
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(found).To(BeTrue())
+	g.Expect(string(b)).To(Equal("anne"))
+
+	_, found, err = store.Find("expired")
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(found).To(BeFalse())
+
+	all, err := store.All()
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(all).To(Equal(map[string][]byte{"abc": []byte("anne"), "def": []byte("bob")}))
+
+	g.Expect(store.Commit("abc", []byte("anne again"), time.Now().Add(time.Hour))).To(Succeed())
+	b, _, err = store.Find("abc")
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(string(b)).To(Equal("anne again"))
+
+	g.Expect(store.Delete("abc")).To(Succeed())
+	g.Expect(store.Delete("abc")).To(Succeed(), "deleting a missing session is a no-op")
+
+	all, err = store.All()
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(all).To(HaveLen(1))
+	g.Expect(all).To(HaveKey("def"))
+}
+
+// fakeRedis speaks enough of the Redis protocol for the session store.
+type fakeRedis struct {
+	addr     string
+	password string
+
+	mu     sync.Mutex
+	values map[string]string
+	expiry map[string]time.Time
+}
+
+func newFakeRedis(t *testing.T, password string) *fakeRedis {
+	t.Helper()
+
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Cleanup(func() { l.Close() })
+
+	r := &fakeRedis{
+		addr:     l.Addr().String(),
+		password: password,
+		values:   map[string]string{},
+		expiry:   map[string]time.Time{},
+	}
+
+	go func() {
+		for {
+			conn, err := l.Accept()
+			if err != nil {
+				return
+			}
+
+			go r.serve(conn)
+		}
+	}()
+
+	return r
+}
+
+func (r *fakeRedis) serve(conn net.Conn) {
+	defer conn.Close()
+
+	reader := bufio.NewReader(conn)
+	authenticated := r.password == ""
+
+	for {
+		args, err := readCommand(reader)
+		if err != nil {
+			return
+		}
+
+		cmd := strings.ToUpper(args[0])
+
+		if cmd == "AUTH" {
+			if args[len(args)-1] != r.password {
+				fmt.Fprint(conn, "-WRONGPASS invalid password\r\n")
+				continue
+			}
+
+			authenticated = true
+
+			fmt.Fprint(conn, "+OK\r\n")
+
+			continue
+		}
+
+		if !authenticated {
+			fmt.Fprint(conn, "-NOAUTH Authentication required.\r\n")
+			continue
+		}
+
+		fmt.Fprint(conn, r.handle(cmd, args[1:]))
+	}
+}
+
+func (r *fakeRedis) handle(cmd string, args []string) string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for k, e := range r.expiry {
+		if time.Now().After(e) {
+			delete(r.values, k)
+			delete(r.expiry, k)
+		}
+	}
+
+	switch cmd {
+	case "SET":
+		ms, _ := strconv.Atoi(args[3])
+		r.values[args[0]] = args[1]
+		r.expiry[args[0]] = time.Now().Add(time.Duration(ms) * time.Millisecond)
+
+		return "+OK\r\n"
+	case "GET":
+		v, ok := r.values[args[0]]
+		if !ok {
+			return "$-1\r\n"
+		}
+
+		return fmt.Sprintf("$%d\r\n%s\r\n", len(v), v)
+	case "DEL":
+		_, ok := r.values[args[0]]
+		delete(r.values, args[0])
+
+		if ok {
+			return ":1\r\n"
+		}
+
+		return ":0\r\n"
+	case "SCAN":
+		// everything in one page
+		prefix := strings.TrimSuffix(args[2], "*")
+		keys := []string{}
+
+		for k := range r.values {
+			if strings.HasPrefix(k, prefix) {
+				keys = append(keys, fmt.Sprintf("$%d\r\n%s\r\n", len(k), k))
+			}
+		}
+
+		return fmt.Sprintf("*2\r\n$1\r\n0\r\n*%d\r\n%s", len(keys), strings.Join(keys, ""))
+	default:
+		return "-ERR unknown command\r\n"
+	}
+}
+
+func readCommand(r *bufio.Reader) ([]string, error) {
+	line, err := r.ReadString('\n')
+	if err != nil {
+		return nil, err
+	}
+
+	n, err := strconv.Atoi(strings.TrimSpace(line)[1:])
+	if err != nil {
+		return nil, err
+	}
+
+	args := make([]string, n)
+
+	for i := range args {
+		line, err := r.ReadString('\n')
+		if err != nil {
+			return nil, err
+		}
+
+		size, err := strconv.Atoi(strings.TrimSpace(line)[1:])
+		if err != nil {
+			return nil, err
+		}
+
+		data := make([]byte, size+2)
+		if _, err := io.ReadFull(r, data); err != nil {
+			return nil, err
+		}
+
+		args[i] = string(data[:size])
+	}
+
+	return args, nil
+}
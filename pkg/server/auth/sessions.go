@@ -0,0 +1,401 @@
+package auth
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/coreos/go-oidc/v3/oidc"
+	"github.com/weaveworks/weave-gitops/core/audit"
+	"github.com/weaveworks/weave-gitops/core/logger"
+	"golang.org/x/oauth2"
+)
+
+const (
+	// DefaultSessionRefreshWindow is how long before their ID token expires
+	// the sessions are refreshed.
+	DefaultSessionRefreshWindow = 5 * time.Minute
+
+	// The session keys describing who the session is for, so the sessions
+	// of a user can be found and revoked.
+	sessionUserKey        = "user"
+	sessionSubjectKey     = "sub"
+	sessionIDKey          = "sid"
+	sessionCreatedKey     = "created"
+	sessionTokenExpiryKey = "token_expiry"
+
+	// rotationGracePeriod is how long the tokens a refresh token was
+	// exchanged for are reused for the requests still sending it, rather
+	// than exchanging it again, which rotating identity providers refuse.
+	rotationGracePeriod = time.Minute
+
+	backChannelLogoutEvent = "http://schemas.openid.net/event/backchannel-logout"
+	// logoutTokenMaxAge is how old a logout token can be.
+	logoutTokenMaxAge = 5 * time.Minute
+)
+
+// SessionIterator is implemented by the session managers able to go through
+// all the sessions, to find and revoke them.
+type SessionIterator interface {
+	Iterate(ctx context.Context, fn func(context.Context) error) error
+}
+
+// sessionRenewer is implemented by the session managers able to give the
+// session a new ID, which is done on login against session fixation.
+type sessionRenewer interface {
+	RenewToken(ctx context.Context) error
+}
+
+// SessionInfo describes a session, without its tokens.
+type SessionInfo struct {
+	// ID identifies the session without giving its cookie away.
+	ID string
+	// UserID is the identity of the user, see UserPrincipal.Identity.
+	UserID string
+	// CreatedAt is when the user logged in.
+	CreatedAt time.Time
+	// TokenExpiry is when the ID token of the session expires, and the
+	// session needs to be refreshed.
+	TokenExpiry time.Time
+
+	subject   string
+	sessionID string
+	// provider is the name of the OIDC provider of the session, empty for
+	// the default one.
+	provider string
+}
+
+// Sessions lists and revokes the sessions of the users.
+type Sessions interface {
+	// ListSessions returns the sessions of a user, or of everyone if userID
+	// is empty.
+	ListSessions(ctx context.Context, userID string) ([]SessionInfo, error)
+	// RevokeSessions logs a user out of all their sessions, returning how
+	// many there were.
+	RevokeSessions(ctx context.Context, userID string) (int, error)
+}
+
+func (s *AuthServer) ListSessions(ctx context.Context, userID string) ([]SessionInfo, error) {
+	sessions := []SessionInfo{}
+
+	err := s.iterateSessions(ctx, func(ctx context.Context, info SessionInfo) error {
+		if userID == "" || info.UserID == userID {
+			sessions = append(sessions, info)
+		}
+
+		return nil
+	})
+
+	return sessions, err
+}
+
+func (s *AuthServer) RevokeSessions(ctx context.Context, userID string) (int, error) {
+	if userID == "" {
+		return 0, errors.New("no user given")
+	}
+
+	return s.revokeSessions(ctx, func(info SessionInfo) bool {
+		return info.UserID == userID
+	})
+}
+
+// revokeSessions destroys the sessions matching.
+func (s *AuthServer) revokeSessions(ctx context.Context, match func(SessionInfo) bool) (int, error) {
+	revoked := 0
+
+	err := s.iterateSessions(ctx, func(ctx context.Context, info SessionInfo) error {
+		if !match(info) {
+			return nil
+		}
+
+		if err := s.SessionManager.Destroy(ctx); err != nil {
+			return fmt.Errorf("revoking session %s: %w", info.ID, err)
+		}
+
+		revoked++
+
+		return nil
+	})
+
+	return revoked, err
+}
+
+func (s *AuthServer) iterateSessions(ctx context.Context, fn func(context.Context, SessionInfo) error) error {
+	iterator, ok := s.SessionManager.(SessionIterator)
+	if !ok {
+		return errors.New("the session store can't list the sessions")
+	}
+
+	return iterator.Iterate(ctx, func(ctx context.Context) error {
+		return fn(ctx, s.sessionInfo(ctx))
+	})
+}
+
+func (s *AuthServer) sessionInfo(ctx context.Context) SessionInfo {
+	info := SessionInfo{
+		UserID:      s.SessionManager.GetString(ctx, sessionUserKey),
+		CreatedAt:   parseSessionTime(s.SessionManager.GetString(ctx, sessionCreatedKey)),
+		TokenExpiry: parseSessionTime(s.SessionManager.GetString(ctx, sessionTokenExpiryKey)),
+		subject:     s.SessionManager.GetString(ctx, sessionSubjectKey),
+		sessionID:   s.SessionManager.GetString(ctx, sessionIDKey),
+		provider:    s.SessionManager.GetString(ctx, sessionProviderKey),
+	}
+
+	if tokener, ok := s.SessionManager.(interface{ Token(context.Context) string }); ok {
+		hash := sha256.Sum256([]byte(tokener.Token(ctx)))
+		info.ID = hex.EncodeToString(hash[:8])
+	}
+
+	return info
+}
+
+// startSession gives the session a new ID and records who it's for, after
+// the user logged in.
+func (s *AuthServer) startSession(ctx context.Context, principal *UserPrincipal) error {
+	if renewer, ok := s.SessionManager.(sessionRenewer); ok {
+		if err := renewer.RenewToken(ctx); err != nil {
+			return fmt.Errorf("renewing session: %w", err)
+		}
+	}
+
+	if principal != nil {
+		s.SessionManager.Put(ctx, sessionUserKey, principal.Identity())
+	}
+
+	s.SessionManager.Put(ctx, sessionCreatedKey, formatSessionTime(time.Now()))
+
+	return nil
+}
+
+// putIDTokenClaims records the claims of the ID token the back-channel
+// logouts and the refreshes rely on.
+func (s *AuthServer) putIDTokenClaims(ctx context.Context, idToken *oidc.IDToken) {
+	s.SessionManager.Put(ctx, sessionSubjectKey, idToken.Subject)
+	s.SessionManager.Put(ctx, sessionTokenExpiryKey, formatSessionTime(idToken.Expiry))
+
+	claims := struct {
+		SessionID string `json:"sid"`
+	}{}
+
+	if err := idToken.Claims(&claims); err == nil && claims.SessionID != "" {
+		s.SessionManager.Put(ctx, sessionIDKey, claims.SessionID)
+	}
+}
+
+// refreshExpiringSession refreshes the session tokens if the ID token is
+// about to expire, so requests don't fail and have to be retried once it
+// has. The request goes on with the current tokens if refreshing fails.
+func (s *AuthServer) refreshExpiringSession(rw http.ResponseWriter, r *http.Request) {
+	if s.SessionManager == nil || !s.oidcEnabled() || s.SessionManager.GetString(r.Context(), RefreshTokenCookieName) == "" {
+		return
+	}
+
+	expiry := parseSessionTime(s.SessionManager.GetString(r.Context(), sessionTokenExpiryKey))
+	if expiry.IsZero() || time.Until(expiry) > s.sessionRefreshWindow() {
+		return
+	}
+
+	if _, err := s.Refresh(rw, r); err != nil {
+		s.Log.V(logger.LogLevelWarn).Info("refreshing the expiring session failed", "error", err)
+	}
+}
+
+func (s *AuthServer) sessionRefreshWindow() time.Duration {
+	if s.SessionRefreshWindow == 0 {
+		return DefaultSessionRefreshWindow
+	}
+
+	return s.SessionRefreshWindow
+}
+
+// BackChannelLogout logs the users out of the sessions the identity provider
+// ended, as in OpenID Connect Back-Channel Logout.
+//
+// https://openid.net/specs/openid-connect-backchannel-1_0.html
+func (s *AuthServer) BackChannelLogout(rw http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		rw.Header().Add("Allow", "POST")
+		rw.WriteHeader(http.StatusMethodNotAllowed)
+
+		return
+	}
+
+	rw.Header().Set("Cache-Control", "no-store")
+
+	if !s.oidcEnabled() {
+		JSONError(s.Log, rw, "oidc provider not configured", http.StatusBadRequest)
+		return
+	}
+
+	provider, claims, err := s.verifyLogoutToken(oidc.ClientContext(r.Context(), s.client), r.FormValue("logout_token"))
+	if err != nil {
+		s.Log.Info("invalid logout token", "error", err)
+		JSONError(s.Log, rw, fmt.Sprintf("invalid logout token: %v", err), http.StatusBadRequest)
+
+		return
+	}
+
+	revoked, err := s.revokeSessions(r.Context(), func(info SessionInfo) bool {
+		// subjects are only unique to their provider
+		if s.providers.named(info.provider) != provider {
+			return false
+		}
+
+		return (claims.Subject == "" || info.subject == claims.Subject) &&
+			(claims.SessionID == "" || info.sessionID == claims.SessionID)
+	})
+
+	principal := &UserPrincipal{ID: claims.Subject, Prefixes: provider.prefixes}
+
+	if err != nil {
+		s.Log.Error(err, "failed to revoke the sessions logged out")
+		s.recordAudit(r, audit.ActionLogout, principal, err)
+		JSONError(s.Log, rw, "failed to log out", http.StatusInternalServerError)
+
+		return
+	}
+
+	s.Log.V(logger.LogLevelDebug).Info("back-channel logout", "provider", provider.name(), "subject", claims.Subject, "sid", claims.SessionID, "revoked", revoked)
+	s.recordAudit(r, audit.ActionLogout, principal, nil)
+
+	rw.WriteHeader(http.StatusOK)
+}
+
+type logoutTokenClaims struct {
+	Subject   string                     `json:"sub"`
+	SessionID string                     `json:"sid"`
+	Events    map[string]json.RawMessage `json:"events"`
+	Nonce     *string                    `json:"nonce"`
+}
+
+// verifyLogoutToken verifies a logout token, returning the provider which
+// issued it and its claims.
+func (s *AuthServer) verifyLogoutToken(ctx context.Context, rawToken string) (*oidcProvider, *logoutTokenClaims, error) {
+	if rawToken == "" {
+		return nil, nil, errors.New("no logout_token")
+	}
+
+	// Logout tokens don't have to expire, their age is checked instead.
+	provider, token, err := s.providers.verify(ctx, rawToken, (*oidcProvider).expiredTokenVerifier)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	claims, err := checkLogoutToken(token)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return provider, claims, nil
+}
+
+// checkLogoutToken returns the claims of a logout token, checking they are
+// those of one.
+func checkLogoutToken(token *oidc.IDToken) (*logoutTokenClaims, error) {
+	if time.Since(token.IssuedAt) > logoutTokenMaxAge {
+		return nil, errors.New("token too old")
+	}
+
+	if !token.Expiry.IsZero() && time.Now().After(token.Expiry) {
+		return nil, errors.New("token expired")
+	}
+
+	claims := &logoutTokenClaims{}
+	if err := token.Claims(claims); err != nil {
+		return nil, err
+	}
+
+	if _, ok := claims.Events[backChannelLogoutEvent]; !ok {
+		return nil, errors.New("not a logout token")
+	}
+
+	if claims.Nonce != nil {
+		return nil, errors.New("logout tokens can't have a nonce")
+	}
+
+	if claims.Subject == "" && claims.SessionID == "" {
+		return nil, errors.New("no sub or sid")
+	}
+
+	return claims, nil
+}
+
+// tokenRotator exchanges each refresh token once. The requests sending the
+// same refresh token meanwhile, or shortly after, get the same tokens: the
+// identity providers rotating refresh tokens refuse them once used, and may
+// revoke the whole session.
+type tokenRotator struct {
+	mu        sync.Mutex
+	rotations map[string]*rotation
+}
+
+type rotation struct {
+	done  chan struct{}
+	token *oauth2.Token
+	err   error
+	at    time.Time
+}
+
+func (t *tokenRotator) rotate(refreshToken string, exchange func() (*oauth2.Token, error)) (*oauth2.Token, error) {
+	hash := sha256.Sum256([]byte(refreshToken))
+	key := hex.EncodeToString(hash[:])
+	now := time.Now()
+
+	t.mu.Lock()
+
+	if t.rotations == nil {
+		t.rotations = map[string]*rotation{}
+	}
+
+	for k, r := range t.rotations {
+		if !r.at.IsZero() && now.Sub(r.at) > rotationGracePeriod {
+			delete(t.rotations, k)
+		}
+	}
+
+	if r, ok := t.rotations[key]; ok {
+		t.mu.Unlock()
+		<-r.done
+
+		return r.token, r.err
+	}
+
+	r := &rotation{done: make(chan struct{})}
+	t.rotations[key] = r
+	t.mu.Unlock()
+
+	token, err := exchange()
+
+	t.mu.Lock()
+	r.token, r.err, r.at = token, err, time.Now()
+
+	// failures can be retried
+	if err != nil {
+		delete(t.rotations, key)
+	}
+	t.mu.Unlock()
+
+	close(r.done)
+
+	return token, err
+}
+
+func formatSessionTime(t time.Time) string {
+	return strconv.FormatInt(t.Unix(), 10)
+}
+
+func parseSessionTime(s string) time.Time {
+	seconds, err := strconv.ParseInt(s, 10, 64)
+	if err != nil {
+		return time.Time{}
+	}
+
+	return time.Unix(seconds, 0)
+}
@@ -0,0 +1,260 @@
+package auth_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/alexedwards/scs/v2"
+	. "github.com/onsi/gomega"
+	"github.com/weaveworks/weave-gitops/pkg/server/auth"
+	"golang.org/x/crypto/bcrypt"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrlclientfake "sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+const backChannelLogoutEvent = "http://schemas.openid.net/event/backchannel-logout"
+
+func TestSessionsAreListedAndRevoked(t *testing.T) {
+	g := NewGomegaWithT(t)
+	ctx := context.Background()
+
+	password := "my-secret-password"
+	hashed, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.MinCost)
+	g.Expect(err).NotTo(HaveOccurred())
+
+	hashedSecret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: auth.ClusterUserAuthSecretName, Namespace: testNamespace},
+		Data: map[string][]byte{
+			"username": []byte("wego-admin"),
+			"password": hashed,
+		},
+	}
+
+	tokenSignerVerifier, err := auth.NewHMACTokenSignerVerifier(5 * time.Minute)
+	g.Expect(err).NotTo(HaveOccurred())
+
+	sm := scs.New()
+	s, _ := makeAuthServer(t, ctrlclientfake.NewClientBuilder().WithObjects(hashedSecret).Build(), tokenSignerVerifier, []auth.AuthMethod{auth.UserAccount}, sm)
+
+	signIn := sm.LoadAndSave(s.SignIn())
+
+	cookies := []*http.Cookie{}
+
+	for i := 0; i < 2; i++ {
+		body, err := json.Marshal(auth.LoginRequest{Username: "wego-admin", Password: password})
+		g.Expect(err).NotTo(HaveOccurred())
+
+		w := httptest.NewRecorder()
+		signIn.ServeHTTP(w, httptest.NewRequest(http.MethodPost, "https://example.com/signin", bytes.NewReader(body)))
+		g.Expect(w.Result().StatusCode).To(Equal(http.StatusOK))
+
+		cookies = append(cookies, w.Result().Cookies()...)
+	}
+
+	g.Expect(cookies).To(HaveLen(2))
+	g.Expect(cookies[0].Value).NotTo(Equal(cookies[1].Value), "each login gets a new session")
+
+	sessions, err := s.ListSessions(ctx, "wego-admin")
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(sessions).To(HaveLen(2))
+	g.Expect(sessions[0].ID).NotTo(Equal(sessions[1].ID))
+	g.Expect(sessions[0].CreatedAt).To(BeTemporally("~", time.Now(), time.Minute))
+
+	for _, session := range sessions {
+		for _, c := range cookies {
+			g.Expect(c.Value).NotTo(ContainSubstring(session.ID), "the session IDs don't give the cookies away")
+		}
+	}
+
+	sessions, err = s.ListSessions(ctx, "bob")
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(sessions).To(BeEmpty())
+
+	revoked, err := s.RevokeSessions(ctx, "wego-admin")
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(revoked).To(Equal(2))
+
+	sessions, err = s.ListSessions(ctx, "")
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(sessions).To(BeEmpty())
+
+	// the revoked cookies don't authenticate anymore
+	userInfo := sm.LoadAndSave(http.HandlerFunc(s.UserInfo))
+
+	req := httptest.NewRequest(http.MethodGet, "https://example.com/userinfo", nil)
+	req.AddCookie(cookies[0])
+
+	w := httptest.NewRecorder()
+	userInfo.ServeHTTP(w, req)
+	g.Expect(w.Result().StatusCode).To(Equal(http.StatusBadRequest))
+}
+
+func TestSessionsNeedAnIterableSessionManager(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	s, _ := makeAuthServer(t, nil, nil, []auth.AuthMethod{auth.OIDC}, &fakeSessionManager{})
+
+	_, err := s.ListSessions(context.Background(), "")
+	g.Expect(err).To(MatchError("the session store can't list the sessions"))
+}
+
+func TestRefreshReusesRotatedTokens(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	tokenSignerVerifier, err := auth.NewHMACTokenSignerVerifier(5 * time.Minute)
+	g.Expect(err).NotTo(HaveOccurred())
+
+	sm := &fakeSessionManager{}
+	s, m := makeAuthServer(t, nil, tokenSignerVerifier, []auth.AuthMethod{auth.OIDC}, sm)
+
+	refreshToken := getVerifyTokens(t, m)["refresh_token"].(string)
+
+	refresh := func() string {
+		req := httptest.NewRequest(http.MethodGet, "https://example.com/test", nil).WithContext(
+			contextWithSessionValues(map[string]any{auth.RefreshTokenCookieName: refreshToken}))
+
+		_, err := s.Refresh(httptest.NewRecorder(), req)
+		g.Expect(err).NotTo(HaveOccurred())
+
+		return sm.stringValue(auth.IDTokenCookieName)
+	}
+
+	first := refresh()
+
+	// Exchanging the refresh token again would get tokens issued later.
+	m.FastForward(10 * time.Second)
+
+	// Concurrent requests sending the refresh token being rotated get the
+	// same tokens, rather than exchanging it again.
+	g.Expect(refresh()).To(Equal(first))
+}
+
+func TestBackChannelLogout(t *testing.T) {
+	g := NewGomegaWithT(t)
+	ctx := context.Background()
+
+	tokenSignerVerifier, err := auth.NewHMACTokenSignerVerifier(5 * time.Minute)
+	g.Expect(err).NotTo(HaveOccurred())
+
+	sm := scs.New()
+	s, m := makeAuthServer(t, nil, tokenSignerVerifier, []auth.AuthMethod{auth.OIDC}, sm)
+
+	refreshToken := getVerifyTokens(t, m)["refresh_token"].(string)
+
+	// logs in with the tokens of the default mockoidc user
+	login := sm.LoadAndSave(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		sm.Put(r.Context(), auth.RefreshTokenCookieName, refreshToken)
+
+		_, err := s.Refresh(w, r)
+		g.Expect(err).NotTo(HaveOccurred())
+	}))
+
+	login.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "https://example.com/test", nil))
+
+	sessions, err := s.ListSessions(ctx, "")
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(sessions).To(HaveLen(1))
+	g.Expect(sessions[0].TokenExpiry).To(BeTemporally(">", time.Now()))
+
+	logout := func(claims logoutClaims) *http.Response {
+		token, err := m.Keypair.SignJWT(claims)
+		g.Expect(err).NotTo(HaveOccurred())
+
+		req := httptest.NewRequest(http.MethodPost, "https://example.com/oauth2/backchannel_logout",
+			strings.NewReader(url.Values{"logout_token": {token}}.Encode()))
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+		w := httptest.NewRecorder()
+		s.BackChannelLogout(w, req)
+
+		return w.Result()
+	}
+
+	validClaims := func() logoutClaims {
+		return logoutClaims{
+			"iss":    m.Issuer(),
+			"aud":    m.Config().ClientID,
+			"iat":    time.Now().Unix(),
+			"jti":    "logout-1",
+			"sub":    "1234567890",
+			"events": map[string]any{backChannelLogoutEvent: map[string]any{}},
+		}
+	}
+
+	t.Run("rejects invalid logout tokens", func(t *testing.T) {
+		g := NewGomegaWithT(t)
+
+		notLogout := validClaims()
+		delete(notLogout, "events")
+
+		withNonce := validClaims()
+		withNonce["nonce"] = "abc"
+
+		old := validClaims()
+		old["iat"] = time.Now().Add(-time.Hour).Unix()
+
+		otherClient := validClaims()
+		otherClient["aud"] = "other"
+
+		noSubject := validClaims()
+		delete(noSubject, "sub")
+
+		for name, claims := range map[string]logoutClaims{
+			"not a logout token": notLogout,
+			"nonce":              withNonce,
+			"old":                old,
+			"other client":       otherClient,
+			"no subject":         noSubject,
+		} {
+			g.Expect(logout(claims).StatusCode).To(Equal(http.StatusBadRequest), name)
+		}
+
+		sessions, err := s.ListSessions(ctx, "")
+		g.Expect(err).NotTo(HaveOccurred())
+		g.Expect(sessions).To(HaveLen(1))
+	})
+
+	t.Run("only accepts POST", func(t *testing.T) {
+		g := NewGomegaWithT(t)
+
+		w := httptest.NewRecorder()
+		s.BackChannelLogout(w, httptest.NewRequest(http.MethodGet, "https://example.com/oauth2/backchannel_logout", nil))
+		g.Expect(w.Result().StatusCode).To(Equal(http.StatusMethodNotAllowed))
+	})
+
+	t.Run("revokes the sessions of the subject", func(t *testing.T) {
+		g := NewGomegaWithT(t)
+
+		other := validClaims()
+		other["sub"] = "someone-else"
+		g.Expect(logout(other).StatusCode).To(Equal(http.StatusOK))
+
+		sessions, err := s.ListSessions(ctx, "")
+		g.Expect(err).NotTo(HaveOccurred())
+		g.Expect(sessions).To(HaveLen(1))
+
+		res := logout(validClaims())
+		g.Expect(res.StatusCode).To(Equal(http.StatusOK))
+		g.Expect(res.Header.Get("Cache-Control")).To(Equal("no-store"))
+
+		sessions, err = s.ListSessions(ctx, "")
+		g.Expect(err).NotTo(HaveOccurred())
+		g.Expect(sessions).To(BeEmpty())
+	})
+}
+
+// logoutClaims are the claims of a logout token, signed by the mockoidc
+// keypair.
+type logoutClaims map[string]any
+
+func (logoutClaims) Valid() error {
+	return nil
+}
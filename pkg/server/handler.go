@@ -16,6 +16,13 @@ var (
 	PublicRoutes = []string{
 		"/v1/featureflags",
 	}
+
+	// StreamingRoutes are those whose responses are written as they're
+	// produced, which mustn't be buffered on the way out.
+	StreamingRoutes = []string{
+		"/v1/objects/watch",
+		"/v1/sync/stream",
+	}
 )
 
 type Config struct {
@@ -0,0 +1,65 @@
+package server
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/NYTimes/gziphandler"
+	"github.com/alexedwards/scs/v2"
+)
+
+// WithCompression gzips the responses of h, except those of the streaming
+// routes, which the gzip writer would hold back until it had enough to
+// compress.
+func WithCompression(h http.Handler) http.Handler {
+	compressed := gziphandler.GzipHandler(h)
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if isStreamingRoute(r.URL.Path) {
+			h.ServeHTTP(w, r)
+			return
+		}
+
+		compressed.ServeHTTP(w, r)
+	})
+}
+
+// WithSessions loads and saves the sessions of the requests to h. The
+// session manager buffers the whole response to set the cookie before it,
+// so the streaming routes, which only read the session, only load it.
+func WithSessions(sm *scs.SessionManager, h http.Handler) http.Handler {
+	loadAndSave := sm.LoadAndSave(h)
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !isStreamingRoute(r.URL.Path) {
+			loadAndSave.ServeHTTP(w, r)
+			return
+		}
+
+		var token string
+		if cookie, err := r.Cookie(sm.Cookie.Name); err == nil {
+			token = cookie.Value
+		}
+
+		ctx, err := sm.Load(r.Context(), token)
+		if err != nil {
+			sm.ErrorFunc(w, r, err)
+			return
+		}
+
+		w.Header().Add("Vary", "Cookie")
+		h.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
+
+// isStreamingRoute matches the path by suffix, as it may still carry the
+// route prefix.
+func isStreamingRoute(path string) bool {
+	for _, route := range StreamingRoutes {
+		if strings.HasSuffix(path, route) {
+			return true
+		}
+	}
+
+	return false
+}
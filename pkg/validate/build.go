@@ -0,0 +1,575 @@
+package validate
+
+import (
+	"bytes"
+	"encoding/base64"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	kustomizev1 "github.com/fluxcd/kustomize-controller/api/v1"
+	"github.com/fluxcd/pkg/ssa"
+	"github.com/weaveworks/weave-gitops/pkg/fluxbuild"
+	"github.com/weaveworks/weave-gitops/pkg/logger"
+	"github.com/weaveworks/weave-gitops/pkg/sourceignore"
+	"github.com/yannh/kubeconform/pkg/resource"
+	"github.com/yannh/kubeconform/pkg/validator"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/kustomize/api/konfig"
+	"sigs.k8s.io/yaml"
+)
+
+// definition is a Flux Kustomization of the validated files.
+type definition struct {
+	ks   *kustomizev1.Kustomization
+	file string
+}
+
+// visitor is called with each resource rendered, the path built and the
+// Flux Kustomization which rendered it, if any.
+type visitor func(obj *unstructured.Unstructured, filename, kustomization string)
+
+// builder renders the Flux Kustomizations and the kustomizations of the
+// validated files, from a copy of the repository, and visits the rendered
+// resources.
+type builder struct {
+	visit   visitor
+	rootDir string
+	// sourceDir is the copy of the repository the builds are run in, like
+	// kustomize-controller builds a copy of the source artifact.
+	sourceDir   string
+	sourceNames map[string]bool
+	report      *Report
+
+	// sources are the data of the ConfigMaps and Secrets variables can be
+	// substituted from, by namespace and name, nil for those whose data
+	// can't be read, like encrypted Secrets.
+	sources map[string]map[string]string
+	// applied are the Kustomizations which applied a resource, by cluster
+	// and resource, to find the resources applied by several of them.
+	applied map[string]map[string]string
+	// built are the Kustomizations built, by cluster, and reached those
+	// built in any cluster.
+	built   map[string]bool
+	reached map[string]bool
+	// builtPaths are the paths of the Kustomizations built, whose
+	// kustomization.yaml files aren't built again on their own.
+	builtPaths map[string]bool
+}
+
+// buildFiles renders the Flux Kustomizations of the files and the
+// kustomizations among them, visits the rendered resources and adds the
+// errors of the builds to the report.
+func buildFiles(log logger.Logger, visit visitor, opts Options, files []string, report *Report) error {
+	sourceDir, err := os.MkdirTemp("", "gitops-validate-")
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(sourceDir)
+
+	ignored := ignoreFilter(log, opts.RootDir)
+
+	if err := copyRepository(opts.RootDir, sourceDir, ignored); err != nil {
+		return fmt.Errorf("copying the repository: %w", err)
+	}
+
+	b := &builder{
+		visit:       visit,
+		rootDir:     opts.RootDir,
+		sourceDir:   sourceDir,
+		sourceNames: map[string]bool{},
+		report:      report,
+		sources:     map[string]map[string]string{},
+		applied:     map[string]map[string]string{},
+		built:       map[string]bool{},
+		reached:     map[string]bool{},
+		builtPaths:  map[string]bool{},
+	}
+
+	for _, name := range opts.SourceNames {
+		b.sourceNames[name] = true
+	}
+
+	var definitions []definition
+
+	for _, file := range files {
+		objects, err := readObjects(file)
+		if err != nil {
+			// the schema validation reports the files which can't be read
+			continue
+		}
+
+		for _, obj := range objects {
+			b.addSource(obj)
+
+			if !isKustomization(obj) {
+				continue
+			}
+
+			ks, err := toKustomization(obj)
+			if err != nil {
+				b.report.add(Result{
+					Filename:   file,
+					Kind:       obj.GetKind(),
+					APIVersion: obj.GetAPIVersion(),
+					Namespace:  obj.GetNamespace(),
+					Name:       obj.GetName(),
+					Status:     StatusError,
+					Message:    err.Error(),
+				})
+
+				continue
+			}
+
+			if b.fromRepository(ks) {
+				definitions = append(definitions, definition{ks: ks, file: file})
+			}
+		}
+	}
+
+	// The roots are built first, the Kustomizations they apply being built
+	// in the same cluster. Those in the path of another Kustomization are
+	// expected to be applied by it, the others not reached are built as
+	// roots of their own anyway.
+	for _, d := range definitions {
+		if !b.inOtherPath(d, definitions) {
+			b.build(d.ks, clusterOf(d), d.file)
+		}
+	}
+
+	for _, d := range definitions {
+		if !b.reached[objectKey(d.ks)] {
+			b.build(d.ks, clusterOf(d), d.file)
+		}
+	}
+
+	for _, file := range files {
+		if isKustomizationFile(file) {
+			b.buildKustomizationFile(file)
+		}
+	}
+
+	return nil
+}
+
+// fromRepository tells whether a Kustomization builds the validated
+// repository rather than another source.
+func (b *builder) fromRepository(ks *kustomizev1.Kustomization) bool {
+	return len(b.sourceNames) == 0 || b.sourceNames[ks.Spec.SourceRef.Name]
+}
+
+// inOtherPath tells whether the file of a Kustomization is in the path of
+// another Kustomization.
+func (b *builder) inOtherPath(d definition, definitions []definition) bool {
+	for _, other := range definitions {
+		if other.ks == d.ks {
+			continue
+		}
+
+		rel, err := filepath.Rel(b.repositoryPath(other.ks), d.file)
+		if err == nil && rel != ".." && !strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// repositoryPath returns the path of a Kustomization in the repository.
+func (b *builder) repositoryPath(ks *kustomizev1.Kustomization) string {
+	return filepath.Join(b.rootDir, filepath.Clean("/"+ks.Spec.Path))
+}
+
+// build renders a Kustomization, visits the resources it applies and
+// builds the Kustomizations among them, in the cluster of the resources.
+func (b *builder) build(ks *kustomizev1.Kustomization, cluster, file string) {
+	key := objectKey(ks)
+
+	if b.built[cluster+"|"+key] {
+		return
+	}
+
+	b.built[cluster+"|"+key] = true
+	b.reached[key] = true
+
+	path := b.repositoryPath(ks)
+	b.builtPaths[path] = true
+
+	result := Result{
+		Filename:      path,
+		Kind:          kustomizev1.KustomizationKind,
+		APIVersion:    kustomizev1.GroupVersion.String(),
+		Namespace:     ks.Namespace,
+		Name:          ks.Name,
+		Kustomization: key,
+	}
+
+	objects, err := fluxbuild.Build(b.sourceDir, &ks.Spec)
+	if err != nil {
+		result.Filename = file
+		result.Status = StatusError
+		result.Message = err.Error()
+		b.report.add(result)
+
+		return
+	}
+
+	vars, known := b.variables(ks)
+
+	var children []*kustomizev1.Kustomization
+
+	for _, obj := range objects {
+		result := Result{
+			Filename:      path,
+			Kind:          obj.GetKind(),
+			APIVersion:    obj.GetAPIVersion(),
+			Namespace:     obj.GetNamespace(),
+			Name:          obj.GetName(),
+			Kustomization: key,
+		}
+
+		if ks.Spec.PostBuild != nil {
+			if missing, _ := fluxbuild.MissingVariables(obj, vars); len(missing) > 0 {
+				if known {
+					result.Status = StatusInvalid
+					result.Message = fmt.Sprintf("missing postBuild substitution variables: %s", strings.Join(missing, ", "))
+				} else {
+					result.Status = StatusSkipped
+					result.Message = fmt.Sprintf("the postBuild substitution variables %s may be set by sources which aren't in the repository", strings.Join(missing, ", "))
+				}
+
+				b.report.add(result)
+
+				continue
+			}
+		}
+
+		if obj, err = fluxbuild.Substitute(obj, vars); err != nil {
+			result.Status = StatusError
+			result.Message = err.Error()
+			b.report.add(result)
+
+			continue
+		}
+
+		if meta := ks.Spec.CommonMetadata; meta != nil {
+			ssa.SetCommonMetadata([]*unstructured.Unstructured{obj}, meta.Labels, meta.Annotations)
+		}
+
+		b.addSource(obj)
+
+		if other := b.apply(cluster, obj, key); other != "" {
+			result.Status = StatusInvalid
+			result.Message = fmt.Sprintf("also applied by Kustomization %s", other)
+			b.report.add(result)
+
+			continue
+		}
+
+		b.visit(obj, path, key)
+
+		if isKustomization(obj) {
+			if child, err := toKustomization(obj); err == nil && b.fromRepository(child) && objectKey(child) != key {
+				children = append(children, child)
+			}
+		}
+	}
+
+	for _, child := range children {
+		childCluster := cluster
+		if child.Spec.KubeConfig != nil {
+			childCluster += "|kubeconfig:" + child.Spec.KubeConfig.SecretRef.Name
+		}
+
+		b.build(child, childCluster, path)
+	}
+}
+
+// buildKustomizationFile renders a kustomization file on its own, unless
+// it was built for a Flux Kustomization already, and visits the
+// rendered resources.
+func (b *builder) buildKustomizationFile(file string) {
+	dir := filepath.Dir(file)
+
+	if b.builtPaths[dir] {
+		return
+	}
+
+	rel, err := filepath.Rel(b.rootDir, dir)
+	if err != nil || rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+		return
+	}
+
+	data, err := os.ReadFile(file)
+	if err != nil {
+		return
+	}
+
+	var kustomization struct {
+		Kind string `json:"kind"`
+	}
+
+	// components are only built as part of kustomizations
+	if err := yaml.Unmarshal(data, &kustomization); err != nil || kustomization.Kind == "Component" {
+		return
+	}
+
+	objects, err := fluxbuild.BuildDir(filepath.Join(b.sourceDir, rel))
+	if err != nil {
+		b.report.add(Result{
+			Filename: file,
+			Kind:     "Kustomization",
+			Status:   StatusError,
+			Message:  err.Error(),
+		})
+
+		return
+	}
+
+	for _, obj := range objects {
+		// The variables are substituted by the Flux Kustomizations which
+		// use the kustomization, the defaults are used to validate it.
+		if missing, _ := fluxbuild.MissingVariables(obj, nil); len(missing) > 0 {
+			b.report.add(Result{
+				Filename:   file,
+				Kind:       obj.GetKind(),
+				APIVersion: obj.GetAPIVersion(),
+				Namespace:  obj.GetNamespace(),
+				Name:       obj.GetName(),
+				Status:     StatusSkipped,
+				Message:    fmt.Sprintf("refers to the postBuild substitution variables %s", strings.Join(missing, ", ")),
+			})
+
+			continue
+		}
+
+		substituted, err := fluxbuild.Substitute(obj, map[string]string{})
+		if err != nil {
+			substituted = obj
+		}
+
+		b.visit(substituted, file, "")
+	}
+}
+
+// validateRendered returns the visitor validating the rendered resources
+// against their schema, adding the results to the report.
+func validateRendered(v validator.Validator, report *Report) visitor {
+	return func(obj *unstructured.Unstructured, filename, kustomization string) {
+		data, err := yaml.Marshal(obj.Object)
+		if err != nil {
+			return
+		}
+
+		result, ok := resultOf(v.ValidateResource(resource.Resource{Path: filename, Bytes: data}))
+		if !ok {
+			return
+		}
+
+		result.Kustomization = kustomization
+		report.add(result)
+	}
+}
+
+// apply records that a Kustomization applies a resource to a cluster, and
+// returns the other Kustomization applying it already, if any.
+func (b *builder) apply(cluster string, obj *unstructured.Unstructured, kustomization string) string {
+	applied, ok := b.applied[cluster]
+	if !ok {
+		applied = map[string]string{}
+		b.applied[cluster] = applied
+	}
+
+	gvk := obj.GroupVersionKind()
+	key := fmt.Sprintf("%s/%s/%s/%s", gvk.Group, gvk.Kind, obj.GetNamespace(), obj.GetName())
+
+	if other, ok := applied[key]; ok && other != kustomization {
+		return other
+	}
+
+	applied[key] = kustomization
+
+	return ""
+}
+
+// variables returns the post build substitution variables of a
+// Kustomization, nil if it has none, and false when some of its sources
+// aren't known, so that the variables may be incomplete.
+func (b *builder) variables(ks *kustomizev1.Kustomization) (map[string]string, bool) {
+	if ks.Spec.PostBuild == nil {
+		return nil, true
+	}
+
+	vars := map[string]string{}
+	known := true
+
+	for _, ref := range ks.Spec.PostBuild.SubstituteFrom {
+		data, found := b.sources[sourceKey(ref.Kind, ks.Namespace, ref.Name)]
+		if !found {
+			data, found = b.sources[sourceKey(ref.Kind, "", ref.Name)]
+		}
+
+		if !found && ref.Optional {
+			continue
+		}
+
+		if data == nil {
+			known = false
+			continue
+		}
+
+		for k, v := range data {
+			vars[k] = v
+		}
+	}
+
+	for k, v := range ks.Spec.PostBuild.Substitute {
+		vars[k] = v
+	}
+
+	return vars, known
+}
+
+// addSource records the data of the ConfigMaps and Secrets, which variables
+// can be substituted from.
+func (b *builder) addSource(obj *unstructured.Unstructured) {
+	if obj.GetAPIVersion() != "v1" {
+		return
+	}
+
+	key := sourceKey(obj.GetKind(), obj.GetNamespace(), obj.GetName())
+
+	switch obj.GetKind() {
+	case "ConfigMap":
+		data, _, _ := unstructured.NestedStringMap(obj.Object, "data")
+		b.sources[key] = data
+	case "Secret":
+		b.sources[key] = secretData(obj)
+	}
+}
+
+// secretData returns the data of a Secret, nil if it's encrypted.
+func secretData(obj *unstructured.Unstructured) map[string]string {
+	if _, encrypted := obj.Object["sops"]; encrypted {
+		return nil
+	}
+
+	data := map[string]string{}
+
+	encoded, _, _ := unstructured.NestedStringMap(obj.Object, "data")
+	for k, v := range encoded {
+		decoded, err := base64.StdEncoding.DecodeString(v)
+		if err != nil {
+			return nil
+		}
+
+		data[k] = string(decoded)
+	}
+
+	stringData, _, _ := unstructured.NestedStringMap(obj.Object, "stringData")
+	for k, v := range stringData {
+		data[k] = v
+	}
+
+	return data
+}
+
+func sourceKey(kind, namespace, name string) string {
+	return fmt.Sprintf("%s/%s/%s", kind, namespace, name)
+}
+
+func objectKey(ks *kustomizev1.Kustomization) string {
+	return fmt.Sprintf("%s/%s", ks.Namespace, ks.Name)
+}
+
+// clusterOf returns the cluster the Kustomizations built from a root are
+// applied to, told apart by the file of the root.
+func clusterOf(d definition) string {
+	cluster := d.file + "|" + objectKey(d.ks)
+	if d.ks.Spec.KubeConfig != nil {
+		cluster += "|kubeconfig:" + d.ks.Spec.KubeConfig.SecretRef.Name
+	}
+
+	return cluster
+}
+
+func isKustomization(obj *unstructured.Unstructured) bool {
+	return obj.GetKind() == kustomizev1.KustomizationKind &&
+		obj.GroupVersionKind().Group == kustomizev1.GroupVersion.Group
+}
+
+func toKustomization(obj *unstructured.Unstructured) (*kustomizev1.Kustomization, error) {
+	ks := &kustomizev1.Kustomization{}
+
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(obj.Object, ks); err != nil {
+		return nil, fmt.Errorf("reading Kustomization: %w", err)
+	}
+
+	return ks, nil
+}
+
+func isKustomizationFile(file string) bool {
+	for _, name := range konfig.RecognizedKustomizationFileNames() {
+		if filepath.Base(file) == name {
+			return true
+		}
+	}
+
+	return false
+}
+
+func readObjects(file string) ([]*unstructured.Unstructured, error) {
+	data, err := os.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+
+	return ssa.ReadObjects(bytes.NewReader(data))
+}
+
+// copyRepository copies the files of the repository but the ignored ones,
+// like the artifacts of Flux leave them out.
+func copyRepository(rootDir, dest string, ignored sourceignore.IgnoreFilter) error {
+	return filepath.Walk(rootDir, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+
+		rel, err := filepath.Rel(rootDir, path)
+		if err != nil {
+			return err
+		}
+
+		if rel == "." {
+			return nil
+		}
+
+		if ignored(rel, info) {
+			if info.IsDir() {
+				return filepath.SkipDir
+			}
+
+			return nil
+		}
+
+		target := filepath.Join(dest, rel)
+
+		if info.IsDir() {
+			return os.MkdirAll(target, 0o755)
+		}
+
+		if !info.Mode().IsRegular() {
+			return nil
+		}
+
+		src, err := os.Open(path)
+		if err != nil {
+			return err
+		}
+		defer src.Close()
+
+		return writeFile(target, src)
+	})
+}
@@ -0,0 +1,278 @@
+package validate_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"github.com/weaveworks/weave-gitops/pkg/logger"
+	"github.com/weaveworks/weave-gitops/pkg/validate"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func fluxKustomization(name, path, spec string) string {
+	return `apiVersion: kustomize.toolkit.fluxcd.io/v1
+kind: Kustomization
+metadata:
+  name: ` + name + `
+  namespace: flux-system
+spec:
+  interval: 10m
+  path: ` + path + `
+  prune: true
+  sourceRef:
+    kind: GitRepository
+    name: flux-system
+` + spec
+}
+
+func TestValidateBuilds(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	repo := t.TempDir()
+	writeFiles(t, repo, map[string]string{
+		"clusters/prod/flux-system/gotk-sync.yaml": fluxKustomization("flux-system", "./clusters/prod", ""),
+		"clusters/prod/vars.yaml": `apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: cluster-vars
+  namespace: flux-system
+data:
+  region: eu-west-1
+`,
+		"clusters/prod/apps.yaml": fluxKustomization("apps", "./apps/prod", `  postBuild:
+    substitute:
+      replicas: "2"
+    substituteFrom:
+    - kind: ConfigMap
+      name: cluster-vars
+`),
+		"clusters/prod/extra.yaml": fluxKustomization("extra", "./apps/extra", ""),
+		"clusters/prod/remote.yaml": fluxKustomization("remote", "./apps/remote", `  kubeConfig:
+    secretRef:
+      name: remote-kubeconfig
+  postBuild:
+    substituteFrom:
+    - kind: Secret
+      name: remote-vars
+`),
+		"clusters/staging/flux-system/gotk-sync.yaml": fluxKustomization("flux-system", "./clusters/staging", ""),
+		"clusters/staging/apps.yaml":                  fluxKustomization("apps", "./apps/extra", ""),
+		"apps/base/kustomization.yaml": `apiVersion: kustomize.config.k8s.io/v1beta1
+kind: Kustomization
+resources:
+- settings.yaml
+- zones.yaml
+`,
+		"apps/base/settings.yaml": `apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: settings
+data:
+  region: ${region}
+  replicas: replicas-${replicas}
+`,
+		"apps/base/zones.yaml": `apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: zones
+data:
+  zone: ${zone}
+`,
+		"apps/prod/kustomization.yaml": `apiVersion: kustomize.config.k8s.io/v1beta1
+kind: Kustomization
+namespace: apps
+resources:
+- ../base
+`,
+		"apps/extra/settings.yaml": `apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: settings
+  namespace: apps
+data:
+  region: us-east-1
+`,
+		"apps/remote/settings.yaml": `apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: settings
+  namespace: apps
+data:
+  region: ${remote_region}
+`,
+		"apps/broken/kustomization.yaml": `apiVersion: kustomize.config.k8s.io/v1beta1
+kind: Kustomization
+resources:
+- missing.yaml
+`,
+	})
+
+	bundle := filepath.Join(t.TempDir(), "schemas.tar.gz")
+	writeBundle(t, bundle, map[string]string{
+		"flux/" + fluxVersion + "/master-standalone-strict/kustomization-kustomize-v1.json": `{"type": "object"}`,
+		"kubernetes/v1.28.0-standalone-strict/configmap-v1.json":                            configMapSchema,
+	})
+
+	var out bytes.Buffer
+
+	err := validate.Validate(logger.NewCLILogger(os.Stderr), repo, validate.Options{
+		KubernetesVersion: "v1.28.0",
+		FluxVersion:       fluxVersion,
+		CacheDir:          t.TempDir(),
+		SchemaBundle:      bundle,
+		Offline:           true,
+		Output:            validate.OutputJSON,
+		Writer:            &out,
+	})
+	g.Expect(err).To(MatchError(validate.ErrValidationFailed))
+
+	var report validate.Report
+	g.Expect(json.Unmarshal(out.Bytes(), &report)).To(Succeed())
+
+	prod := filepath.Join(repo, "apps", "prod")
+	extra := filepath.Join(repo, "apps", "extra")
+
+	// the variables are substituted from the inline values and the
+	// ConfigMap applied by the parent Kustomization
+	g.Expect(report.Resources).To(ContainElement(validate.Result{
+		Filename:      prod,
+		Kind:          "ConfigMap",
+		APIVersion:    "v1",
+		Namespace:     "apps",
+		Name:          "settings",
+		Status:        validate.StatusValid,
+		Kustomization: "flux-system/apps",
+	}))
+
+	g.Expect(report.Resources).To(ContainElement(validate.Result{
+		Filename:      prod,
+		Kind:          "ConfigMap",
+		APIVersion:    "v1",
+		Namespace:     "apps",
+		Name:          "zones",
+		Status:        validate.StatusInvalid,
+		Message:       "missing postBuild substitution variables: zone",
+		Kustomization: "flux-system/apps",
+	}))
+
+	// the settings are applied by two Kustomizations of the prod cluster,
+	// and by one of the staging cluster
+	g.Expect(report.Resources).To(ContainElement(validate.Result{
+		Filename:      extra,
+		Kind:          "ConfigMap",
+		APIVersion:    "v1",
+		Namespace:     "apps",
+		Name:          "settings",
+		Status:        validate.StatusInvalid,
+		Message:       "also applied by Kustomization flux-system/apps",
+		Kustomization: "flux-system/extra",
+	}))
+
+	g.Expect(report.Resources).To(ContainElement(validate.Result{
+		Filename:      extra,
+		Kind:          "ConfigMap",
+		APIVersion:    "v1",
+		Namespace:     "apps",
+		Name:          "settings",
+		Status:        validate.StatusValid,
+		Kustomization: "flux-system/apps",
+	}))
+
+	// the remote cluster isn't the prod one, and its variables come from a
+	// Secret which isn't in the repository
+	g.Expect(report.Resources).To(ContainElement(SatisfyAll(
+		HaveField("Kustomization", "flux-system/remote"),
+		HaveField("Name", "settings"),
+		HaveField("Status", validate.StatusSkipped),
+		HaveField("Message", ContainSubstring("remote_region")),
+	)))
+
+	// the kustomizations are built on their own too, unless a Flux
+	// Kustomization built them
+	g.Expect(report.Resources).To(ContainElement(SatisfyAll(
+		HaveField("Filename", filepath.Join(repo, "apps", "broken", "kustomization.yaml")),
+		HaveField("Status", validate.StatusError),
+		HaveField("Message", ContainSubstring("kustomize build failed")),
+	)))
+
+	g.Expect(report.Resources).To(ContainElement(SatisfyAll(
+		HaveField("Filename", filepath.Join(repo, "apps", "base", "kustomization.yaml")),
+		HaveField("Name", "settings"),
+		HaveField("Status", validate.StatusSkipped),
+	)))
+
+	g.Expect(report.Resources).NotTo(ContainElement(SatisfyAll(
+		HaveField("Filename", filepath.Join(repo, "apps", "prod", "kustomization.yaml")),
+		HaveField("Kind", "ConfigMap"),
+	)))
+
+	// the Flux Kustomizations are built once per cluster
+	built := map[string]int{}
+
+	for _, res := range report.Resources {
+		if res.Kind == "Kustomization" && res.Kustomization == "flux-system/flux-system" {
+			built[res.Filename+"/"+res.Name]++
+		}
+	}
+
+	g.Expect(built).To(Equal(map[string]int{
+		filepath.Join(repo, "clusters", "prod") + "/apps":           1,
+		filepath.Join(repo, "clusters", "prod") + "/extra":          1,
+		filepath.Join(repo, "clusters", "prod") + "/flux-system":    1,
+		filepath.Join(repo, "clusters", "prod") + "/remote":         1,
+		filepath.Join(repo, "clusters", "staging") + "/apps":        1,
+		filepath.Join(repo, "clusters", "staging") + "/flux-system": 1,
+	}))
+
+	g.Expect(filepath.Join(extra, "kustomization.yaml")).NotTo(BeAnExistingFile(), "the repository isn't changed")
+}
+
+func TestRender(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	repo := t.TempDir()
+	writeFiles(t, repo, map[string]string{
+		"clusters/prod/apps.yaml": fluxKustomization("apps", "./apps/prod", `  postBuild:
+    substitute:
+      region: eu-west-1
+`),
+		"apps/prod/kustomization.yaml": `apiVersion: kustomize.config.k8s.io/v1beta1
+kind: Kustomization
+namespace: apps
+resources:
+- settings.yaml
+`,
+		"apps/prod/settings.yaml": `apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: settings
+data:
+  region: ${region}
+`,
+	})
+
+	rendered, err := validate.Render(logger.NewCLILogger(os.Stderr), repo, validate.Options{})
+	g.Expect(err).NotTo(HaveOccurred())
+
+	names := []string{}
+
+	for _, r := range rendered {
+		region, _, _ := unstructured.NestedString(r.Object.Object, "data", "region")
+		names = append(names, fmt.Sprintf("%s %s/%s %s %s", r.Kustomization, r.Object.GetNamespace(), r.Object.GetName(), region, r.Filename))
+	}
+
+	g.Expect(names).To(ConsistOf(
+		" flux-system/apps  "+filepath.Join(repo, "clusters", "prod", "apps.yaml"),
+		" /settings ${region} "+filepath.Join(repo, "apps", "prod", "settings.yaml"),
+		"flux-system/apps apps/settings eu-west-1 "+filepath.Join(repo, "apps", "prod"),
+	), "the kustomization.yaml isn't built on its own, its directory was built")
+
+	rendered, err = validate.Render(logger.NewCLILogger(os.Stderr), repo, validate.Options{SkipBuild: true})
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(rendered).To(HaveLen(2))
+}
@@ -0,0 +1,391 @@
+package validate
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/yannh/kubeconform/pkg/validator"
+)
+
+// The formats the results can be written in.
+const (
+	OutputText  = "text"
+	OutputJSON  = "json"
+	OutputJUnit = "junit"
+	OutputSARIF = "sarif"
+)
+
+// Outputs are the formats the results can be written in.
+var Outputs = []string{OutputText, OutputJSON, OutputJUnit, OutputSARIF}
+
+// The statuses of the results.
+const (
+	StatusValid   = "valid"
+	StatusInvalid = "invalid"
+	StatusError   = "error"
+	StatusSkipped = "skipped"
+)
+
+// Result is the result of the validation of a resource.
+type Result struct {
+	Filename   string `json:"filename"`
+	Kind       string `json:"kind,omitempty"`
+	APIVersion string `json:"apiVersion,omitempty"`
+	Namespace  string `json:"namespace,omitempty"`
+	Name       string `json:"name,omitempty"`
+	Status     string `json:"status"`
+	Message    string `json:"message,omitempty"`
+	// Kustomization is the namespace and name of the Flux Kustomization
+	// whose build the resource is from, if any.
+	Kustomization string `json:"kustomization,omitempty"`
+}
+
+// describe returns the kind and name of a resource, and the Kustomization
+// it is from, for the messages.
+func (r Result) describe() string {
+	description := "resource"
+
+	switch {
+	case r.Kind == "":
+	case r.Name == "":
+		description = r.Kind
+	default:
+		description = fmt.Sprintf("%s %s", r.Kind, r.Name)
+	}
+
+	if r.Kustomization != "" {
+		description += fmt.Sprintf(" (Kustomization %s)", r.Kustomization)
+	}
+
+	return description
+}
+
+// Summary counts the results.
+type Summary struct {
+	Files     int `json:"files"`
+	Resources int `json:"resources"`
+	Valid     int `json:"valid"`
+	Invalid   int `json:"invalid"`
+	Errors    int `json:"errors"`
+	Skipped   int `json:"skipped"`
+}
+
+// Report is the result of the validation of a directory. The resources
+// without a schema are skipped.
+type Report struct {
+	Resources []Result `json:"resources"`
+	Summary   Summary  `json:"summary"`
+}
+
+func newReport() *Report {
+	return &Report{Resources: []Result{}}
+}
+
+// resultOf returns the result of a kubeconform validation, false for empty
+// documents, which aren't resources.
+func resultOf(res validator.Result) (Result, bool) {
+	result := Result{Filename: res.Resource.Path}
+
+	switch res.Status {
+	case validator.Valid:
+		result.Status = StatusValid
+	case validator.Invalid:
+		result.Status = StatusInvalid
+	case validator.Error:
+		result.Status = StatusError
+	case validator.Skipped:
+		result.Status = StatusSkipped
+	default:
+		return result, false
+	}
+
+	if sig, err := res.Resource.Signature(); err == nil {
+		result.Kind = sig.Kind
+		result.APIVersion = sig.Version
+		result.Namespace = sig.Namespace
+		result.Name = sig.Name
+	}
+
+	if res.Err != nil {
+		result.Message = res.Err.Error()
+	}
+
+	return result, true
+}
+
+func (r *Report) add(result Result) {
+	switch result.Status {
+	case StatusValid:
+		r.Summary.Valid++
+	case StatusInvalid:
+		r.Summary.Invalid++
+	case StatusError:
+		r.Summary.Errors++
+	case StatusSkipped:
+		r.Summary.Skipped++
+	}
+
+	r.Summary.Resources++
+	r.Resources = append(r.Resources, result)
+}
+
+// sort orders the results, which come in any order, by file and resource.
+func (r *Report) sort() {
+	sort.SliceStable(r.Resources, func(i, j int) bool {
+		a, b := r.Resources[i], r.Resources[j]
+
+		for _, f := range [][2]string{
+			{a.Filename, b.Filename},
+			{a.Kustomization, b.Kustomization},
+			{a.Kind, b.Kind},
+			{a.Namespace, b.Namespace},
+			{a.Name, b.Name},
+			{a.Message, b.Message},
+		} {
+			if f[0] != f[1] {
+				return f[0] < f[1]
+			}
+		}
+
+		return false
+	})
+}
+
+func (r *Report) failed() []Result {
+	var failed []Result
+
+	for _, res := range r.Resources {
+		if res.Status == StatusInvalid || res.Status == StatusError {
+			failed = append(failed, res)
+		}
+	}
+
+	return failed
+}
+
+type reportWriter func(io.Writer, *Report) error
+
+func writerFor(output string) (reportWriter, error) {
+	switch output {
+	case OutputText:
+		return writeText, nil
+	case OutputJSON:
+		return writeJSON, nil
+	case OutputJUnit:
+		return writeJUnit, nil
+	case OutputSARIF:
+		return writeSARIF, nil
+	}
+
+	return nil, fmt.Errorf("unsupported output %q, must be one of %s", output, strings.Join(Outputs, ", "))
+}
+
+func writeText(w io.Writer, r *Report) error {
+	for _, res := range r.failed() {
+		verb := "is invalid"
+		if res.Status == StatusError {
+			verb = "failed validation"
+		}
+
+		if _, err := fmt.Fprintf(w, "%s - %s %s: %s\n", res.Filename, res.describe(), verb, res.Message); err != nil {
+			return err
+		}
+	}
+
+	s := r.Summary
+
+	_, err := fmt.Fprintf(w, "Summary: %d resources found in %d files - Valid: %d, Invalid: %d, Errors: %d, Skipped: %d\n",
+		s.Resources, s.Files, s.Valid, s.Invalid, s.Errors, s.Skipped)
+
+	return err
+}
+
+func writeJSON(w io.Writer, r *Report) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+
+	return enc.Encode(r)
+}
+
+type junitTestSuites struct {
+	XMLName  xml.Name         `xml:"testsuites"`
+	Name     string           `xml:"name,attr"`
+	Tests    int              `xml:"tests,attr"`
+	Failures int              `xml:"failures,attr"`
+	Errors   int              `xml:"errors,attr"`
+	Skipped  int              `xml:"skipped,attr"`
+	Suites   []junitTestSuite `xml:"testsuite"`
+}
+
+type junitTestSuite struct {
+	Name     string          `xml:"name,attr"`
+	Tests    int             `xml:"tests,attr"`
+	Failures int             `xml:"failures,attr"`
+	Errors   int             `xml:"errors,attr"`
+	Skipped  int             `xml:"skipped,attr"`
+	Cases    []junitTestCase `xml:"testcase"`
+}
+
+type junitTestCase struct {
+	Name      string        `xml:"name,attr"`
+	ClassName string        `xml:"classname,attr"`
+	Failure   *junitMessage `xml:"failure,omitempty"`
+	Error     *junitMessage `xml:"error,omitempty"`
+	Skipped   *junitMessage `xml:"skipped,omitempty"`
+}
+
+type junitMessage struct {
+	Message string `xml:"message,attr,omitempty"`
+}
+
+// writeJUnit writes a test suite per file, with a test case per resource.
+func writeJUnit(w io.Writer, r *Report) error {
+	suites := junitTestSuites{Name: "gitops validate"}
+
+	for _, res := range r.Resources {
+		if len(suites.Suites) == 0 || suites.Suites[len(suites.Suites)-1].Name != res.Filename {
+			suites.Suites = append(suites.Suites, junitTestSuite{Name: res.Filename})
+		}
+
+		suite := &suites.Suites[len(suites.Suites)-1]
+		tc := junitTestCase{Name: res.describe(), ClassName: res.APIVersion}
+
+		switch res.Status {
+		case StatusInvalid:
+			tc.Failure = &junitMessage{Message: res.Message}
+			suite.Failures++
+		case StatusError:
+			tc.Error = &junitMessage{Message: res.Message}
+			suite.Errors++
+		case StatusSkipped:
+			tc.Skipped = &junitMessage{Message: "no schema found"}
+			suite.Skipped++
+		}
+
+		suite.Tests++
+		suite.Cases = append(suite.Cases, tc)
+	}
+
+	for _, suite := range suites.Suites {
+		suites.Tests += suite.Tests
+		suites.Failures += suite.Failures
+		suites.Errors += suite.Errors
+		suites.Skipped += suite.Skipped
+	}
+
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return err
+	}
+
+	enc := xml.NewEncoder(w)
+	enc.Indent("", "  ")
+
+	if err := enc.Encode(suites); err != nil {
+		return err
+	}
+
+	_, err := io.WriteString(w, "\n")
+
+	return err
+}
+
+const (
+	sarifVersion = "2.1.0"
+	sarifSchema  = "https://json.schemastore.org/sarif-2.1.0.json"
+
+	ruleInvalid = "invalid-resource"
+	ruleError   = "validation-error"
+)
+
+type sarifLog struct {
+	Version string     `json:"version"`
+	Schema  string     `json:"$schema"`
+	Runs    []sarifRun `json:"runs"`
+}
+
+type sarifRun struct {
+	Tool    sarifTool     `json:"tool"`
+	Results []sarifResult `json:"results"`
+}
+
+type sarifTool struct {
+	Driver sarifDriver `json:"driver"`
+}
+
+type sarifDriver struct {
+	Name           string      `json:"name"`
+	InformationURI string      `json:"informationUri"`
+	Rules          []sarifRule `json:"rules"`
+}
+
+type sarifRule struct {
+	ID               string       `json:"id"`
+	ShortDescription sarifMessage `json:"shortDescription"`
+}
+
+type sarifResult struct {
+	RuleID    string          `json:"ruleId"`
+	Level     string          `json:"level"`
+	Message   sarifMessage    `json:"message"`
+	Locations []sarifLocation `json:"locations"`
+}
+
+type sarifMessage struct {
+	Text string `json:"text"`
+}
+
+type sarifLocation struct {
+	PhysicalLocation sarifPhysicalLocation `json:"physicalLocation"`
+}
+
+type sarifPhysicalLocation struct {
+	ArtifactLocation sarifArtifactLocation `json:"artifactLocation"`
+}
+
+type sarifArtifactLocation struct {
+	URI string `json:"uri"`
+}
+
+// writeSARIF writes the invalid resources as the results of a SARIF log,
+// for code scanning tools.
+func writeSARIF(w io.Writer, r *Report) error {
+	run := sarifRun{
+		Tool: sarifTool{Driver: sarifDriver{
+			Name:           "gitops validate",
+			InformationURI: "https://docs.gitops.weave.works",
+			Rules: []sarifRule{
+				{ID: ruleInvalid, ShortDescription: sarifMessage{Text: "The resource doesn't match its schema"}},
+				{ID: ruleError, ShortDescription: sarifMessage{Text: "The resource couldn't be validated"}},
+			},
+		}},
+		Results: []sarifResult{},
+	}
+
+	for _, res := range r.failed() {
+		ruleID := ruleInvalid
+		if res.Status == StatusError {
+			ruleID = ruleError
+		}
+
+		run.Results = append(run.Results, sarifResult{
+			RuleID:  ruleID,
+			Level:   "error",
+			Message: sarifMessage{Text: fmt.Sprintf("%s: %s", res.describe(), res.Message)},
+			Locations: []sarifLocation{{
+				PhysicalLocation: sarifPhysicalLocation{
+					ArtifactLocation: sarifArtifactLocation{URI: filepath.ToSlash(res.Filename)},
+				},
+			}},
+		})
+	}
+
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+
+	return enc.Encode(sarifLog{Version: sarifVersion, Schema: sarifSchema, Runs: []sarifRun{run}})
+}
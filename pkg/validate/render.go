@@ -0,0 +1,75 @@
+package validate
+
+import (
+	"github.com/weaveworks/weave-gitops/pkg/logger"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+// Rendered is a resource of the files of a directory, or rendered by a
+// build of them.
+type Rendered struct {
+	Object *unstructured.Unstructured
+	// Filename is the file of the resource, or the path which was built.
+	Filename string
+	// Kustomization is the Flux Kustomization which rendered the resource,
+	// if any.
+	Kustomization string
+}
+
+// Render returns the resources of the YAML files of a directory and,
+// unless SkipBuild is set, those its Flux Kustomizations and kustomizations
+// render, as Validate builds them. The options of the schemas and of the
+// output are ignored. The files which can't be read, the builds which fail
+// and the resources left out of them, like those with missing substitution
+// variables, are logged rather than stopping the rendering.
+func Render(log logger.Logger, targetDir string, opts Options) ([]Rendered, error) {
+	if opts.RootDir == "" {
+		opts.RootDir = repositoryRoot(targetDir)
+	}
+
+	files, err := findFiles(log, targetDir, opts.RootDir)
+	if err != nil {
+		return nil, err
+	}
+
+	var rendered []Rendered
+
+	for _, file := range files {
+		// the kustomizations are rendered by the builds
+		if isKustomizationFile(file) {
+			continue
+		}
+
+		objects, err := readObjects(file)
+		if err != nil {
+			log.Warningf("Couldn't read the resources of %s: %v", file, err)
+			continue
+		}
+
+		for _, obj := range objects {
+			rendered = append(rendered, Rendered{Object: obj, Filename: file})
+		}
+	}
+
+	if opts.SkipBuild {
+		return rendered, nil
+	}
+
+	report := newReport()
+
+	visit := func(obj *unstructured.Unstructured, filename, kustomization string) {
+		rendered = append(rendered, Rendered{Object: obj, Filename: filename, Kustomization: kustomization})
+	}
+
+	if err := buildFiles(log, visit, opts, files, report); err != nil {
+		return nil, err
+	}
+
+	report.sort()
+
+	for _, result := range report.failed() {
+		log.Warningf("%s: %s: %s", result.Filename, result.describe(), result.Message)
+	}
+
+	return rendered, nil
+}
@@ -0,0 +1,247 @@
+package validate
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/hashicorp/go-cleanhttp"
+)
+
+const (
+	// schemaStrictPrefix is the directory of the Flux schemas, which don't
+	// depend on the version of Kubernetes.
+	schemaStrictPrefix = "master-standalone-strict"
+
+	// kustomizeConfigSchema is the file name of the schema of the
+	// kustomization.yaml files of kustomize, as looked up by kubeconform.
+	kustomizeConfigSchema = "kustomize.config.k8s.io-kustomization-kustomize-v1beta1.json"
+
+	kustomizeConfigSchemaURL = "https://json.schemastore.org/kustomization.json"
+)
+
+// ErrSchemasNotCached is returned when validating offline without the Flux
+// schemas in the cache.
+var ErrSchemasNotCached = errors.New("schemas not cached")
+
+// DefaultCacheDir returns the directory the schemas are cached in by
+// default, in the cache directory of the user.
+func DefaultCacheDir() (string, error) {
+	userCacheDir, err := os.UserCacheDir()
+	if err != nil {
+		return "", err
+	}
+
+	return filepath.Join(userCacheDir, ".gitops", "schemas"), nil
+}
+
+// schemaCache is the directory the schemas are cached in, laid out as:
+//
+//	flux/<flux version>/master-standalone-strict/  the Flux schemas
+//	kubernetes/<version>-standalone-strict/        Kubernetes schemas
+//	http/                                          schemas downloaded by kubeconform
+//
+// A bundle seeding the cache has the same layout, the Kubernetes schemas
+// being those of github.com/yannh/kubernetes-json-schema.
+type schemaCache string
+
+func (c schemaCache) fluxDir(fluxVersion string) string {
+	return filepath.Join(string(c), "flux", fluxVersion)
+}
+
+func (c schemaCache) kubernetesDir() string {
+	return filepath.Join(string(c), "kubernetes")
+}
+
+// hasKubernetesSchemas tells whether the schemas of a version of Kubernetes,
+// without its "v" prefix, are cached.
+func (c schemaCache) hasKubernetesSchemas(kubernetesVersion string) bool {
+	if kubernetesVersion != "master" {
+		kubernetesVersion = "v" + kubernetesVersion
+	}
+
+	_, err := os.Stat(filepath.Join(c.kubernetesDir(), kubernetesVersion+"-standalone-strict"))
+
+	return err == nil
+}
+
+func (c schemaCache) httpDir() string {
+	return filepath.Join(string(c), "http")
+}
+
+// locations returns the kubeconform schema locations of the cache, in the
+// order they are looked up.
+func (c schemaCache) locations(fluxVersion string) []string {
+	fluxDir := filepath.Join(c.fluxDir(fluxVersion), "master-standalone{{ .StrictSuffix }}")
+
+	return []string{
+		// special case for K8s Kustomization config
+		filepath.Join(fluxDir, "{{ .Group }}-{{ .ResourceKind }}{{ .KindSuffix }}.json"),
+		// standard Flux schemas
+		filepath.Join(fluxDir, "{{ .ResourceKind }}{{ .KindSuffix }}.json"),
+		// the Kubernetes schemas of the bundle
+		filepath.Join(c.kubernetesDir(), "{{ .NormalizedKubernetesVersion }}-standalone{{ .StrictSuffix }}", "{{ .ResourceKind }}{{ .KindSuffix }}.json"),
+	}
+}
+
+// seed extracts a bundle of schemas into the cache.
+func (c schemaCache) seed(bundle string) error {
+	f, err := os.Open(bundle)
+	if err != nil {
+		return fmt.Errorf("opening schema bundle: %w", err)
+	}
+	defer f.Close()
+
+	if err := untar(string(c), f); err != nil {
+		return fmt.Errorf("extracting schema bundle %s: %w", bundle, err)
+	}
+
+	return nil
+}
+
+// ensureFluxSchemas makes sure the schemas of a version of Flux are cached,
+// downloading them unless offline.
+func (c schemaCache) ensureFluxSchemas(fluxVersion string, offline bool) error {
+	dir := c.fluxDir(fluxVersion)
+
+	if _, err := os.Stat(dir); err == nil {
+		return nil
+	} else if !os.IsNotExist(err) {
+		return err
+	}
+
+	if offline {
+		return fmt.Errorf("%w: no schemas of Flux %s in %s, seed the cache with a schema bundle", ErrSchemasNotCached, fluxVersion, string(c))
+	}
+
+	if err := os.MkdirAll(filepath.Dir(dir), 0o755); err != nil {
+		return err
+	}
+
+	// the schemas are downloaded next to the cache, and moved there once
+	// complete, so that a failed download isn't taken for cached schemas
+	tmp, err := os.MkdirTemp(filepath.Dir(dir), ".download-")
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(tmp)
+
+	cli := cleanhttp.DefaultClient()
+
+	if err := download(cli, fluxSchemasURL(fluxVersion), func(r io.Reader) error {
+		return untar(filepath.Join(tmp, schemaStrictPrefix), r)
+	}); err != nil {
+		return fmt.Errorf("downloading schemas of Flux %s: %w", fluxVersion, err)
+	}
+
+	if err := download(cli, kustomizeConfigSchemaURL, func(r io.Reader) error {
+		return writeFile(filepath.Join(tmp, schemaStrictPrefix, kustomizeConfigSchema), r)
+	}); err != nil {
+		return fmt.Errorf("downloading kustomization schema: %w", err)
+	}
+
+	return os.Rename(tmp, dir)
+}
+
+func fluxSchemasURL(fluxVersion string) string {
+	if fluxVersion == "" || fluxVersion == "latest" {
+		return "https://github.com/fluxcd/flux2/releases/latest/download/crd-schemas.tar.gz"
+	}
+
+	return fmt.Sprintf("https://github.com/fluxcd/flux2/releases/download/%s/crd-schemas.tar.gz", fluxVersion)
+}
+
+func download(cli *http.Client, url string, save func(io.Reader) error) error {
+	response, err := cli.Get(url)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode != http.StatusOK {
+		return fmt.Errorf("GET %s: %s", url, response.Status)
+	}
+
+	return save(response.Body)
+}
+
+func writeFile(path string, r io.Reader) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+
+	if _, err := io.Copy(f, r); err != nil {
+		f.Close()
+		return err
+	}
+
+	return f.Close()
+}
+
+func untar(destDir string, r io.Reader) (retErr error) {
+	gzr, err := gzip.NewReader(r)
+	if err != nil {
+		return err
+	}
+
+	defer func(gzr *gzip.Reader) {
+		err := gzr.Close()
+		if err != nil {
+			retErr = err
+		}
+	}(gzr)
+
+	tr := tar.NewReader(gzr)
+
+	for {
+		header, err := tr.Next()
+
+		switch {
+		// if no more files are found return
+		case err == io.EOF:
+			return nil
+
+		// return any other error
+		case err != nil:
+			return err
+
+		// if the header is nil, just skip it (not sure how this happens)
+		case header == nil:
+			continue
+		}
+
+		// the target location where the dir/file should be created
+		target := filepath.Join(destDir, header.Name)
+
+		// bundles come from anywhere, they mustn't write outside the cache
+		if rel, err := filepath.Rel(destDir, target); err != nil || rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+			return fmt.Errorf("invalid path in archive: %s", header.Name)
+		}
+
+		// check the file type
+		switch header.Typeflag {
+		// if it's a dir and doesn't exist create it
+		case tar.TypeDir:
+			if err := os.MkdirAll(target, 0o755); err != nil {
+				return err
+			}
+
+		// if it's a file create it
+		case tar.TypeReg:
+			if err := writeFile(target, tr); err != nil {
+				return err
+			}
+		}
+	}
+}
@@ -1,162 +1,259 @@
 package validate
 
 import (
-	"archive/tar"
-	"compress/gzip"
 	"context"
-	"fmt"
+	"errors"
 	"io"
 	"os"
 	"path/filepath"
+	"strings"
 	"sync"
 
-	"github.com/hashicorp/go-cleanhttp"
 	"github.com/weaveworks/weave-gitops/pkg/logger"
 	"github.com/weaveworks/weave-gitops/pkg/sourceignore"
-	"github.com/yannh/kubeconform/pkg/output"
+	"github.com/weaveworks/weave-gitops/pkg/version"
 	"github.com/yannh/kubeconform/pkg/resource"
 	"github.com/yannh/kubeconform/pkg/validator"
 )
 
-func Validate(log logger.Logger, targetDir, rootDir, kubernetesVersion, fluxVersion string) error {
-	var (
-		o     output.Output
-		err   error
-		files []string
-	)
+// ErrValidationFailed is returned when some of the resources are invalid,
+// once they are all reported.
+var ErrValidationFailed = errors.New("validation failed")
+
+// Options configure the validation of a directory.
+type Options struct {
+	// RootDir is the root of the repository, whose .sourceignore is
+	// honoured and the paths of the Flux Kustomizations are relative to.
+	// The git repository of the validated directory if empty, else the
+	// directory itself.
+	RootDir string
+	// SourceNames are the names of the Flux sources of the repository, the
+	// Flux Kustomizations of other sources aren't built. All the sources
+	// are taken to be the repository if empty.
+	SourceNames []string
+	// SkipBuild only validates the files, without building the Flux
+	// Kustomizations and the kustomizations.
+	SkipBuild bool
+	// KubernetesVersion is the version of Kubernetes whose schemas are
+	// validated against, the latest if empty.
+	KubernetesVersion string
+	// FluxVersion is the version of Flux whose schemas are validated
+	// against, that of the CLI if empty.
+	FluxVersion string
+	// CacheDir is the directory the schemas are cached in, DefaultCacheDir
+	// if empty.
+	CacheDir string
+	// SchemaBundle is a tar.gz archive of schemas seeding the cache, with
+	// its layout, if not empty.
+	SchemaBundle string
+	// Offline only validates against the cached schemas, never downloading
+	// any.
+	Offline bool
+	// IgnoreMissingSchemas skips the resources without a schema, which are
+	// reported as errors otherwise.
+	IgnoreMissingSchemas bool
+	// Output is the format the results are written in, OutputText if empty.
+	Output string
+	// Writer is where the results are written, stdout if nil.
+	Writer io.Writer
+}
+
+// Validate validates the YAML files of a directory against the schemas of
+// Kubernetes and Flux, and writes the results. Unless skipped, the Flux
+// Kustomizations and the kustomizations of the directory are built, and the
+// resources they render validated too: the resources applied by several
+// Flux Kustomizations to a cluster and the missing post build substitution
+// variables are reported. It returns ErrValidationFailed if any resource is
+// invalid.
+func Validate(log logger.Logger, targetDir string, opts Options) error {
+	if opts.RootDir == "" {
+		opts.RootDir = repositoryRoot(targetDir)
+	}
+
+	if opts.KubernetesVersion == "" {
+		opts.KubernetesVersion = "master"
+	}
+
+	opts.KubernetesVersion = strings.TrimPrefix(opts.KubernetesVersion, "v")
+
+	if opts.FluxVersion == "" {
+		opts.FluxVersion = version.FluxVersion
+	}
+
+	if opts.Output == "" {
+		opts.Output = OutputText
+	}
+
+	if opts.Writer == nil {
+		opts.Writer = os.Stdout
+	}
 
-	userCacheDir, err := os.UserCacheDir()
+	write, err := writerFor(opts.Output)
 	if err != nil {
 		return err
 	}
 
-	fluxSchemaDir := filepath.Join(userCacheDir, ".gitops", "flux", "schemas")
-	if _, err := os.Stat(fluxSchemaDir); os.IsNotExist(err) {
-		if err := os.MkdirAll(fluxSchemaDir, 0o755); err != nil {
+	if opts.CacheDir == "" {
+		if opts.CacheDir, err = DefaultCacheDir(); err != nil {
 			return err
 		}
+	}
 
-		cli := cleanhttp.DefaultClient()
-		url := fmt.Sprintf("https://github.com/fluxcd/flux2/releases/download/%s/crd-schemas.tar.gz", fluxVersion)
-		response, err := cli.Get(url)
+	cache := schemaCache(opts.CacheDir)
 
-		if err != nil {
+	if opts.SchemaBundle != "" {
+		if err := cache.seed(opts.SchemaBundle); err != nil {
 			return err
 		}
+	}
 
-		defer func(Body io.ReadCloser) {
-			err := Body.Close()
-			if err != nil {
-				fmt.Println(err)
-			}
-		}(response.Body)
-
-		const schemaStrictPrefix = "master-standalone-strict"
+	if err := cache.ensureFluxSchemas(opts.FluxVersion, opts.Offline); err != nil {
+		return err
+	}
 
-		if err := untar(filepath.Join(fluxSchemaDir, schemaStrictPrefix), response.Body); err != nil {
-			return err
-		}
+	files, err := findFiles(log, targetDir, opts.RootDir)
+	if err != nil {
+		return err
+	}
 
-		ksConfig, err := cli.Get("https://json.schemastore.org/kustomization.json")
-		if err != nil {
-			return err
-		}
+	schemaLocations := cache.locations(opts.FluxVersion)
 
-		defer func(body io.ReadCloser) {
-			if err := body.Close(); err != nil {
-				fmt.Println(err)
-			}
-		}(ksConfig.Body)
+	if !opts.Offline {
+		// the default K8s schemas
+		schemaLocations = append(schemaLocations, "default")
 
-		ksConfigFile, err := os.Create(filepath.Join(fluxSchemaDir, schemaStrictPrefix, "kustomize.config.k8s.io-kustomization-kustomize-v1beta1.json"))
-		if err != nil {
+		// make sure the cache directory exists
+		if err := os.MkdirAll(cache.httpDir(), 0o755); err != nil {
 			return err
 		}
+	} else if !cache.hasKubernetesSchemas(opts.KubernetesVersion) {
+		if opts.IgnoreMissingSchemas {
+			log.Warningf("No schemas of Kubernetes %s in %s, the Kubernetes resources are skipped", opts.KubernetesVersion, opts.CacheDir)
+		} else {
+			log.Warningf("No schemas of Kubernetes %s in %s, the Kubernetes resources are reported as errors", opts.KubernetesVersion, opts.CacheDir)
+		}
+	}
 
-		defer func(file *os.File) {
-			if err := file.Close(); err != nil {
-				fmt.Println(err)
-			}
-		}(ksConfigFile)
+	v, err := validator.New(schemaLocations, validator.Opts{
+		Cache:                cache.httpDir(),
+		KubernetesVersion:    opts.KubernetesVersion,
+		Strict:               true,
+		IgnoreMissingSchemas: opts.IgnoreMissingSchemas,
+	})
+	if err != nil {
+		return err
+	}
 
-		if _, err := io.Copy(ksConfigFile, ksConfig.Body); err != nil {
+	report := validateFiles(v, files)
+
+	if !opts.SkipBuild {
+		if err := buildFiles(log, validateRendered(v, report), opts, files, report); err != nil {
 			return err
 		}
 	}
 
-	// load sourceignore patterns
-	ignorePath := filepath.Join(rootDir, sourceignore.IgnoreFilename)
+	report.sort()
 
-	ps, err := sourceignore.ReadIgnoreFile(ignorePath, nil)
-	if err != nil {
-		log.Warningf("Couldn't read the ignore file %s: %v", ignorePath, err)
+	if err := write(opts.Writer, report); err != nil {
+		return err
+	}
+
+	if report.Summary.Invalid > 0 || report.Summary.Errors > 0 {
+		return ErrValidationFailed
 	}
 
-	filter := sourceignore.IgnoreFileFilter(ps, []string{})
+	return nil
+}
+
+// findFiles returns the YAML files of a directory, but those ignored by the
+// .sourceignore of the root directory.
+func findFiles(log logger.Logger, targetDir, rootDir string) ([]string, error) {
+	ignored := ignoreFilter(log, rootDir)
+
+	var files []string
 
 	// walk the target directory and find all YAML files
-	err = filepath.Walk(targetDir, func(path string, info os.FileInfo, err error) error {
+	err := filepath.Walk(targetDir, func(path string, info os.FileInfo, err error) error {
 		if err != nil {
 			return err
 		}
 
-		if !info.IsDir() && (filepath.Ext(path) == ".yaml" || filepath.Ext(path) == ".yml") && !filter(path, info) {
+		// the patterns are relative to the root, like those of Flux
+		rel, err := filepath.Rel(rootDir, path)
+		if err == nil && rel != "." && ignored(rel, info) {
+			if info.IsDir() {
+				return filepath.SkipDir
+			}
+
+			return nil
+		}
+
+		if !info.IsDir() && (filepath.Ext(path) == ".yaml" || filepath.Ext(path) == ".yml") {
 			files = append(files, path)
 		}
 
 		return nil
 	})
 
+	return files, err
+}
+
+// ignoreFilter returns the filter of the files ignored by the .sourceignore
+// of the root directory, and by default, matching their path relative to it.
+func ignoreFilter(log logger.Logger, rootDir string) sourceignore.IgnoreFilter {
+	// load sourceignore patterns
+	ignorePath := filepath.Join(rootDir, sourceignore.IgnoreFilename)
+
+	ps, err := sourceignore.ReadIgnoreFile(ignorePath, nil)
 	if err != nil {
-		return err
+		log.Warningf("Couldn't read the ignore file %s: %v", ignorePath, err)
 	}
 
-	if o, err = output.New("text", false, false, false); err != nil {
-		return err
-	}
+	return sourceignore.IgnoreFileFilter(ps, nil)
+}
 
-	cacheDir := filepath.Join(userCacheDir, ".gitops", "schema-cache")
-	// make sure the cache directory exists
-	if err := os.MkdirAll(cacheDir, 0o755); err != nil {
-		return err
+// repositoryRoot returns the root of the git repository of a directory, the
+// directory itself if it isn't in one. The root is relative if the
+// directory is.
+func repositoryRoot(dir string) string {
+	abs, err := filepath.Abs(dir)
+	if err != nil {
+		return dir
 	}
 
-	schemaLocations := []string{
-		// special case for K8s Kustomization config
-		fluxSchemaDir + "/master-standalone{{ .StrictSuffix }}/{{ .Group }}-{{ .ResourceKind }}{{ .KindSuffix }}.json",
-		// standard Flux schemas
-		fluxSchemaDir,
-		// the default K8s schemas
-		"default",
-	}
+	for d := abs; ; d = filepath.Dir(d) {
+		if _, err := os.Stat(filepath.Join(d, ".git")); err == nil {
+			if filepath.IsAbs(dir) {
+				return d
+			}
 
-	var v validator.Validator
-	v, err = validator.New(schemaLocations, validator.Opts{
-		Cache:                cacheDir,
-		Debug:                false,
-		SkipTLS:              false,
-		SkipKinds:            nil,
-		RejectKinds:          nil,
-		KubernetesVersion:    kubernetesVersion,
-		Strict:               true,
-		IgnoreMissingSchemas: true,
-	})
+			wd, err := os.Getwd()
+			if err != nil {
+				return d
+			}
 
-	if err != nil {
-		return err
+			rel, err := filepath.Rel(wd, d)
+			if err != nil {
+				return d
+			}
+
+			return rel
+		}
+
+		if filepath.Dir(d) == d {
+			return dir
+		}
 	}
+}
 
-	validationResults := make(chan validator.Result)
-	ctx, cancel := context.WithCancel(context.Background())
-	successChan := processResults(cancel, o, validationResults, true)
+// validateFiles validates the resources of the files, reporting all of the
+// errors rather than stopping at the first one.
+func validateFiles(v validator.Validator, files []string) *Report {
+	var ignoreFilenamePatterns []string
 
-	var (
-		resourcesChan          <-chan resource.Resource
-		errors                 <-chan error
-		ignoreFilenamePatterns []string
-	)
+	resourcesChan, errorsChan := resource.FromFiles(context.Background(), files, ignoreFilenamePatterns)
 
-	resourcesChan, errors = resource.FromFiles(ctx, files, ignoreFilenamePatterns)
+	validationResults := make(chan validator.Result)
 
 	// Process discovered resources across multiple workers
 	const numberOfWorkers = 4
@@ -165,158 +262,56 @@ func Validate(log logger.Logger, targetDir, rootDir, kubernetesVersion, fluxVers
 	for i := 0; i < numberOfWorkers; i++ {
 		wg.Add(1)
 
-		go func(resources <-chan resource.Resource, validationResults chan<- validator.Result, v validator.Validator) {
-			for res := range resources {
+		go func() {
+			defer wg.Done()
+
+			for res := range resourcesChan {
 				validationResults <- v.ValidateResource(res)
 			}
-
-			wg.Done()
-		}(resourcesChan, validationResults, v)
+		}()
 	}
 
 	wg.Add(1)
 
 	go func() {
+		defer wg.Done()
+
 		// Process errors while discovering resources
-		for err := range errors {
+		for err := range errorsChan {
 			if err == nil {
 				continue
 			}
 
-			if err, ok := err.(resource.DiscoveryError); ok {
+			var discoveryErr resource.DiscoveryError
+			if errors.As(err, &discoveryErr) {
 				validationResults <- validator.Result{
-					Resource: resource.Resource{Path: err.Path},
-					Err:      err.Err,
+					Resource: resource.Resource{Path: discoveryErr.Path},
+					Err:      discoveryErr.Err,
 					Status:   validator.Error,
 				}
 			} else {
 				validationResults <- validator.Result{
-					Resource: resource.Resource{},
-					Err:      err,
-					Status:   validator.Error,
+					Err:    err,
+					Status: validator.Error,
 				}
 			}
-
-			cancel()
 		}
-
-		wg.Done()
 	}()
 
-	wg.Wait()
-
-	close(validationResults)
-
-	success := <-successChan
-
-	if err := o.Flush(); err != nil {
-		return err
-	}
-
-	if !success {
-		return fmt.Errorf("validation failed")
-	}
-
-	return nil
-}
-
-func processResults(cancel context.CancelFunc, o output.Output, validationResults <-chan validator.Result, exitOnError bool) <-chan bool {
-	success := true
-	result := make(chan bool)
-
 	go func() {
-		for res := range validationResults {
-			if res.Status == validator.Error || res.Status == validator.Invalid {
-				success = false
-			}
-
-			if o != nil {
-				if err := o.Write(res); err != nil {
-					fmt.Fprint(os.Stderr, "failed writing log\n")
-				}
-			}
-
-			if !success && exitOnError {
-				cancel() // early exit - signal to stop searching for resources
-				break
-			}
-		}
-
-		for range validationResults { // allow resource finders to exit
-		}
-
-		result <- success
+		wg.Wait()
+		close(validationResults)
 	}()
 
-	return result
-}
-
-func untar(destDir string, r io.Reader) (retErr error) {
-	gzr, err := gzip.NewReader(r)
-	if err != nil {
-		return err
-	}
+	report := newReport()
 
-	defer func(gzr *gzip.Reader) {
-		err := gzr.Close()
-		if err != nil {
-			retErr = err
+	for res := range validationResults {
+		if result, ok := resultOf(res); ok {
+			report.add(result)
 		}
-	}(gzr)
-
-	tr := tar.NewReader(gzr)
-
-	for {
-		header, err := tr.Next()
-
-		switch {
-		// if no more files are found return
-		case err == io.EOF:
-			return nil
-
-		// return any other error
-		case err != nil:
-			return err
-
-		// if the header is nil, just skip it (not sure how this happens)
-		case header == nil:
-			continue
-		}
-
-		// the target location where the dir/file should be created
-		target := filepath.Join(destDir, header.Name)
-
-		// the following switch could also be done using fi.Mode(), not sure if there
-		// a benefit of using one vs. the other.
-		// fi := header.FileInfo()
-
-		// check the file type
-		switch header.Typeflag {
-		// if it's a dir and doesn't exist create it
-		case tar.TypeDir:
-			if _, err := os.Stat(target); err != nil {
-				if err := os.MkdirAll(target, 0o755); err != nil {
-					return err
-				}
-			}
-
-		// if it's a file create it
-		case tar.TypeReg:
-			f, err := os.OpenFile(target, os.O_CREATE|os.O_RDWR, os.FileMode(header.Mode))
-			if err != nil {
-				return err
-			}
+	}
 
-			// copy over contents
-			if _, err := io.Copy(f, tr); err != nil {
-				return err
-			}
+	report.Summary.Files = len(files)
 
-			// manually close here after each file operation; defering would cause each file close
-			// to wait until all operations have completed.
-			if err := f.Close(); err != nil {
-				return err
-			}
-		}
-	}
+	return report
 }
@@ -0,0 +1,331 @@
+package validate_test
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"encoding/json"
+	"encoding/xml"
+	"os"
+	"path/filepath"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"github.com/weaveworks/weave-gitops/pkg/logger"
+	"github.com/weaveworks/weave-gitops/pkg/validate"
+)
+
+const fluxVersion = "v2.1.2"
+
+const kustomizationSchema = `{
+  "type": "object",
+  "required": ["spec"],
+  "properties": {
+    "apiVersion": {"type": "string"},
+    "kind": {"type": "string"},
+    "metadata": {"type": "object"},
+    "spec": {
+      "type": "object",
+      "required": ["interval", "sourceRef"],
+      "additionalProperties": false,
+      "properties": {
+        "interval": {"type": "string"},
+        "path": {"type": "string"},
+        "prune": {"type": "boolean"},
+        "sourceRef": {"type": "object"}
+      }
+    }
+  }
+}`
+
+const configMapSchema = `{
+  "type": "object",
+  "additionalProperties": false,
+  "properties": {
+    "apiVersion": {"type": "string"},
+    "kind": {"type": "string"},
+    "metadata": {"type": "object"},
+    "data": {"type": "object", "additionalProperties": {"type": "string"}}
+  }
+}`
+
+func TestValidateOffline(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	repo := t.TempDir()
+	writeFiles(t, repo, map[string]string{
+		".sourceignore": "/drafts/\n",
+		"clusters/app.yaml": `apiVersion: kustomize.toolkit.fluxcd.io/v1
+kind: Kustomization
+metadata:
+  name: app
+  namespace: flux-system
+spec:
+  interval: 10m
+  path: ./apps
+  sourceRef:
+    kind: GitRepository
+    name: flux-system
+---
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: settings
+data:
+  replicas: "2"
+`,
+		"clusters/broken.yml": `apiVersion: kustomize.toolkit.fluxcd.io/v1
+kind: Kustomization
+metadata:
+  name: broken
+spec:
+  interval: 10m
+  retries: 3
+  sourceRef:
+    kind: GitRepository
+    name: flux-system
+`,
+		"clusters/unknown.yaml": `apiVersion: example.com/v1
+kind: Widget
+metadata:
+  name: widget
+`,
+		"clusters/README.md": "not a manifest",
+		"drafts/wip.yaml": `apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: wip
+unknown: field
+`,
+	})
+
+	bundle := filepath.Join(t.TempDir(), "schemas.tar.gz")
+	writeBundle(t, bundle, map[string]string{
+		"flux/" + fluxVersion + "/master-standalone-strict/kustomization-kustomize-v1.json": kustomizationSchema,
+		"kubernetes/v1.28.0-standalone-strict/configmap-v1.json":                            configMapSchema,
+	})
+
+	cacheDir := t.TempDir()
+
+	validateRepo := func(output string) (string, error) {
+		var out bytes.Buffer
+
+		err := validate.Validate(logger.NewCLILogger(os.Stderr), repo, validate.Options{
+			KubernetesVersion:    "v1.28.0",
+			FluxVersion:          fluxVersion,
+			CacheDir:             cacheDir,
+			Offline:              true,
+			IgnoreMissingSchemas: true,
+			SkipBuild:            true,
+			Output:               output,
+			Writer:               &out,
+		})
+
+		return out.String(), err
+	}
+
+	t.Run("offline validation needs the schemas to be cached", func(t *testing.T) {
+		g := NewGomegaWithT(t)
+
+		_, err := validateRepo(validate.OutputText)
+		g.Expect(err).To(MatchError(validate.ErrSchemasNotCached))
+	})
+
+	err := validate.Validate(logger.NewCLILogger(os.Stderr), repo, validate.Options{
+		KubernetesVersion: "v1.28.0",
+		FluxVersion:       fluxVersion,
+		CacheDir:          cacheDir,
+		SchemaBundle:      bundle,
+		Offline:           true,
+		SkipBuild:         true,
+		Writer:            &bytes.Buffer{},
+	})
+	g.Expect(err).To(MatchError(validate.ErrValidationFailed))
+
+	t.Run("json", func(t *testing.T) {
+		g := NewGomegaWithT(t)
+
+		out, err := validateRepo(validate.OutputJSON)
+		g.Expect(err).To(MatchError(validate.ErrValidationFailed))
+
+		var report validate.Report
+		g.Expect(json.Unmarshal([]byte(out), &report)).To(Succeed())
+
+		g.Expect(report.Summary).To(Equal(validate.Summary{Files: 3, Resources: 4, Valid: 2, Invalid: 1, Skipped: 1}))
+		g.Expect(report.Resources).To(HaveLen(4))
+		g.Expect(report.Resources[0]).To(Equal(validate.Result{
+			Filename:   filepath.Join(repo, "clusters", "app.yaml"),
+			Kind:       "ConfigMap",
+			APIVersion: "v1",
+			Name:       "settings",
+			Status:     validate.StatusValid,
+		}))
+
+		invalid := report.Resources[2]
+		g.Expect(invalid.Filename).To(Equal(filepath.Join(repo, "clusters", "broken.yml")))
+		g.Expect(invalid.Name).To(Equal("broken"))
+		g.Expect(invalid.Status).To(Equal(validate.StatusInvalid))
+		g.Expect(invalid.Message).To(ContainSubstring("Additional property retries is not allowed"))
+
+		g.Expect(report.Resources[3].Kind).To(Equal("Widget"))
+		g.Expect(report.Resources[3].Status).To(Equal(validate.StatusSkipped))
+	})
+
+	t.Run("resources without a schema are errors unless ignored", func(t *testing.T) {
+		g := NewGomegaWithT(t)
+
+		var out bytes.Buffer
+
+		// the bundle has no schemas of Kubernetes v1.29.0
+		err := validate.Validate(logger.NewCLILogger(os.Stderr), repo, validate.Options{
+			KubernetesVersion: "v1.29.0",
+			FluxVersion:       fluxVersion,
+			CacheDir:          cacheDir,
+			Offline:           true,
+			SkipBuild:         true,
+			Output:            validate.OutputJSON,
+			Writer:            &out,
+		})
+		g.Expect(err).To(MatchError(validate.ErrValidationFailed))
+
+		var report validate.Report
+		g.Expect(json.Unmarshal(out.Bytes(), &report)).To(Succeed())
+
+		g.Expect(report.Summary).To(Equal(validate.Summary{Files: 3, Resources: 4, Valid: 1, Invalid: 1, Errors: 2}))
+		g.Expect(report.Resources[0].Kind).To(Equal("ConfigMap"))
+		g.Expect(report.Resources[0].Status).To(Equal(validate.StatusError))
+		g.Expect(report.Resources[3].Kind).To(Equal("Widget"))
+		g.Expect(report.Resources[3].Status).To(Equal(validate.StatusError))
+	})
+
+	t.Run("text", func(t *testing.T) {
+		g := NewGomegaWithT(t)
+
+		out, err := validateRepo(validate.OutputText)
+		g.Expect(err).To(MatchError(validate.ErrValidationFailed))
+		g.Expect(out).To(ContainSubstring(filepath.Join(repo, "clusters", "broken.yml") + " - Kustomization broken is invalid: "))
+		g.Expect(out).To(HaveSuffix("Summary: 4 resources found in 3 files - Valid: 2, Invalid: 1, Errors: 0, Skipped: 1\n"))
+	})
+
+	t.Run("junit", func(t *testing.T) {
+		g := NewGomegaWithT(t)
+
+		out, err := validateRepo(validate.OutputJUnit)
+		g.Expect(err).To(MatchError(validate.ErrValidationFailed))
+
+		var suites struct {
+			Tests    int `xml:"tests,attr"`
+			Failures int `xml:"failures,attr"`
+			Skipped  int `xml:"skipped,attr"`
+			Suites   []struct {
+				Name string `xml:"name,attr"`
+			} `xml:"testsuite"`
+		}
+		g.Expect(xml.Unmarshal([]byte(out), &suites)).To(Succeed())
+		g.Expect(suites.Tests).To(Equal(4))
+		g.Expect(suites.Failures).To(Equal(1))
+		g.Expect(suites.Skipped).To(Equal(1))
+		g.Expect(suites.Suites).To(HaveLen(3))
+	})
+
+	t.Run("sarif", func(t *testing.T) {
+		g := NewGomegaWithT(t)
+
+		out, err := validateRepo(validate.OutputSARIF)
+		g.Expect(err).To(MatchError(validate.ErrValidationFailed))
+
+		var log struct {
+			Version string `json:"version"`
+			Runs    []struct {
+				Results []struct {
+					RuleID    string `json:"ruleId"`
+					Locations []struct {
+						PhysicalLocation struct {
+							ArtifactLocation struct {
+								URI string `json:"uri"`
+							} `json:"artifactLocation"`
+						} `json:"physicalLocation"`
+					} `json:"locations"`
+				} `json:"results"`
+			} `json:"runs"`
+		}
+		g.Expect(json.Unmarshal([]byte(out), &log)).To(Succeed())
+		g.Expect(log.Version).To(Equal("2.1.0"))
+		g.Expect(log.Runs).To(HaveLen(1))
+		g.Expect(log.Runs[0].Results).To(HaveLen(1))
+		g.Expect(log.Runs[0].Results[0].RuleID).To(Equal("invalid-resource"))
+		g.Expect(log.Runs[0].Results[0].Locations[0].PhysicalLocation.ArtifactLocation.URI).To(Equal(filepath.ToSlash(filepath.Join(repo, "clusters", "broken.yml"))))
+	})
+
+	t.Run("unsupported output", func(t *testing.T) {
+		g := NewGomegaWithT(t)
+
+		_, err := validateRepo("yaml")
+		g.Expect(err).To(MatchError(ContainSubstring(`unsupported output "yaml"`)))
+	})
+}
+
+func TestSchemaBundleCannotEscapeCache(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	bundle := filepath.Join(t.TempDir(), "schemas.tar.gz")
+	writeBundle(t, bundle, map[string]string{"../escaped.json": "{}"})
+
+	cacheDir := filepath.Join(t.TempDir(), "cache")
+
+	err := validate.Validate(logger.NewCLILogger(os.Stderr), t.TempDir(), validate.Options{
+		CacheDir:     cacheDir,
+		SchemaBundle: bundle,
+		Offline:      true,
+		Writer:       &bytes.Buffer{},
+	})
+	g.Expect(err).To(MatchError(ContainSubstring("invalid path in archive")))
+	g.Expect(filepath.Join(filepath.Dir(cacheDir), "escaped.json")).NotTo(BeAnExistingFile())
+}
+
+func writeFiles(t *testing.T, dir string, files map[string]string) {
+	t.Helper()
+
+	for name, content := range files {
+		path := filepath.Join(dir, name)
+
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			t.Fatal(err)
+		}
+
+		if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func writeBundle(t *testing.T, path string, files map[string]string) {
+	t.Helper()
+
+	var buf bytes.Buffer
+
+	gzw := gzip.NewWriter(&buf)
+	tw := tar.NewWriter(gzw)
+
+	for name, content := range files {
+		if err := tw.WriteHeader(&tar.Header{Name: name, Mode: 0o644, Size: int64(len(content)), Typeflag: tar.TypeReg}); err != nil {
+			t.Fatal(err)
+		}
+
+		if _, err := tw.Write([]byte(content)); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := tw.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := gzw.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := os.WriteFile(path, buf.Bytes(), 0o644); err != nil {
+		t.Fatal(err)
+	}
+}
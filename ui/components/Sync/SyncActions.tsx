@@ -2,6 +2,7 @@ import React from "react";
 import styled from "styled-components";
 import { useSyncFluxObject } from "../../hooks/automations";
 import { useToggleSuspend } from "../../hooks/flux";
+import { useKindPermissions } from "../../hooks/permissions";
 import { Kind } from "../../lib/api/core/types.pb";
 import SuspendMessageModal from "./SuspendMessageModal";
 import SyncControls, { SyncType } from "./SyncControls";
@@ -69,15 +70,19 @@ const SyncActions = ({
     "object"
   );
 
+  // Syncing, suspending and resuming all patch the object.
+  const permissions = useKindPermissions(clusterName, namespace, kind);
+  const canPatch = permissions ? permissions.patch : true;
+
   return (
     <>
       <SyncControls
         className={className}
         hideSyncOptions={hideSyncOptions}
         syncLoading={sync.isLoading}
-        syncDisabled={suspended}
-        suspendDisabled={suspend.isLoading || suspended}
-        resumeDisabled={resume.isLoading || !suspended}
+        syncDisabled={suspended || !canPatch}
+        suspendDisabled={suspend.isLoading || suspended || !canPatch}
+        resumeDisabled={resume.isLoading || !suspended || !canPatch}
         customActions={customActions}
         onSyncClick={syncHandler}
         onSuspendClick={() =>
@@ -0,0 +1,55 @@
+import { useContext } from "react";
+import { useMutation, useQuery, useQueryClient } from "react-query";
+import { CoreClientContext } from "../contexts/CoreClientContext";
+import {
+  CreateAccessTokenRequest,
+  CreateAccessTokenResponse,
+  DeleteAccessTokenResponse,
+  ListAccessTokensResponse,
+} from "../lib/api/core/core.pb";
+import { RequestError } from "../lib/types";
+import { notifyError, notifySuccess } from "../lib/utils";
+
+const accessTokensKey = "accessTokens";
+
+export function useListAccessTokens() {
+  const { api } = useContext(CoreClientContext);
+
+  return useQuery<ListAccessTokensResponse, RequestError>(
+    [accessTokensKey],
+    () => api.ListAccessTokens({}),
+    { retry: false, refetchOnWindowFocus: false }
+  );
+}
+
+// The token in the response is the only time the secret is available, it's
+// up to the caller to show it to the user.
+export function useCreateAccessToken() {
+  const { api } = useContext(CoreClientContext);
+  const queryClient = useQueryClient();
+
+  return useMutation<
+    CreateAccessTokenResponse,
+    RequestError,
+    CreateAccessTokenRequest
+  >((req) => api.CreateAccessToken(req), {
+    onSuccess: () => queryClient.invalidateQueries([accessTokensKey]),
+    onError: (error) => notifyError(error.message),
+  });
+}
+
+export function useDeleteAccessToken() {
+  const { api } = useContext(CoreClientContext);
+  const queryClient = useQueryClient();
+
+  return useMutation<DeleteAccessTokenResponse, RequestError, string>(
+    (id) => api.DeleteAccessToken({ id }),
+    {
+      onSuccess: () => {
+        notifySuccess("Access token revoked");
+        queryClient.invalidateQueries([accessTokensKey]);
+      },
+      onError: (error) => notifyError(error.message),
+    }
+  );
+}
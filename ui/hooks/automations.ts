@@ -77,10 +77,25 @@ export function useSyncFluxObject(objs: ObjectRef[]) {
     SyncFluxObjectResponse,
     RequestError,
     SyncFluxObjectRequest
-  >(({ withSource }) => api.SyncFluxObject({ objects: objs, withSource }), {
-    onSuccess: () => notifySuccess("Sync request successful!"),
-    onError: (error) => notifyError(error.message),
-  });
+  >(
+    ({ withSource }) =>
+      api.SyncFluxObject({ objects: objs, withSource }).then((res) => {
+        // The other statuses are the state of the objects once synced.
+        const failed = (res.results || []).filter((r) =>
+          ["Forbidden", "NotFound", "Conflict", "Error"].includes(r.status)
+        );
+        if (failed.length) {
+          throw new Error(
+            failed.map((r) => `${r.object?.name}: ${r.message}`).join("\n")
+          );
+        }
+        return res;
+      }),
+    {
+      onSuccess: () => notifySuccess("Sync request successful!"),
+      onError: (error) => notifyError(error.message),
+    }
+  );
 
   return mutation;
 }
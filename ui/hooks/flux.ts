@@ -130,7 +130,18 @@ export function useToggleSuspend(
   const { api } = useContext(CoreClientContext);
   const queryClient = useQueryClient();
   const mutation = useMutation<ToggleSuspendResourceResponse, RequestError>(
-    () => api.ToggleSuspendResource(req),
+    () =>
+      api.ToggleSuspendResource(req).then((res) => {
+        const failed = (res.results || []).filter(
+          (r) => r.status !== "Success"
+        );
+        if (failed.length) {
+          throw new Error(
+            failed.map((r) => `${r.object?.name}: ${r.message}`).join("\n")
+          );
+        }
+        return res;
+      }),
     {
       onSuccess: () => {
         const suspend = req.suspend ? "Suspend" : "Resume";
@@ -0,0 +1,31 @@
+import { useContext } from "react";
+import { useQuery } from "react-query";
+import { AppContext } from "../contexts/AppContext";
+import { AuthRoutes } from "../contexts/AuthContext";
+import { RequestError } from "../lib/types";
+
+export type OIDCProvider = {
+  name: string;
+  displayName: string;
+};
+
+// useOIDCProviders lists the OIDC providers users can sign in with, the
+// first one being the default.
+export function useOIDCProviders(enabled = true) {
+  const { request } = useContext(AppContext);
+
+  return useQuery<OIDCProvider[], RequestError>(
+    "oidcProviders",
+    async () => {
+      const res = await request(AuthRoutes.PROVIDERS);
+      if (!res.ok) {
+        const err: RequestError = new Error(res.statusText);
+        err.code = res.status;
+        throw err;
+      }
+
+      return res.json();
+    },
+    { enabled, staleTime: Infinity, retry: false }
+  );
+}
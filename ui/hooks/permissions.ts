@@ -0,0 +1,38 @@
+import { useContext } from "react";
+import { useQuery } from "react-query";
+import { CoreClientContext } from "../contexts/CoreClientContext";
+import {
+  GetUserPermissionsResponse,
+  KindPermissions,
+} from "../lib/api/core/core.pb";
+import { Kind } from "../lib/api/core/types.pb";
+import { RequestError } from "../lib/types";
+
+export function useUserPermissions(clusterName?: string, namespace?: string) {
+  const { api } = useContext(CoreClientContext);
+
+  return useQuery<GetUserPermissionsResponse, RequestError>(
+    ["userPermissions", clusterName, namespace],
+    () => api.GetUserPermissions({ clusterName, namespace }),
+    {
+      enabled: !!clusterName && !!namespace,
+      retry: false,
+      refetchOnWindowFocus: false,
+    }
+  );
+}
+
+// Returns what the user can do with the objects of a kind in a namespace, or
+// undefined if it isn't known, e.g. for kinds the server doesn't report. The
+// actions whose permissions aren't known are left for the API server to refuse.
+export function useKindPermissions(
+  clusterName?: string,
+  namespace?: string,
+  kind?: Kind
+): KindPermissions | undefined {
+  const { data } = useUserPermissions(clusterName, namespace);
+
+  return data?.permissions
+    ?.find((p) => p.clusterName === clusterName && p.namespace === namespace)
+    ?.kinds?.find((k) => k.kind === kind);
+}
@@ -0,0 +1,39 @@
+import { useContext } from "react";
+import { useMutation, useQuery, useQueryClient } from "react-query";
+import { CoreClientContext } from "../contexts/CoreClientContext";
+import {
+  ListSessionsResponse,
+  RevokeSessionsResponse,
+} from "../lib/api/core/core.pb";
+import { RequestError } from "../lib/types";
+import { notifyError, notifySuccess } from "../lib/utils";
+
+const sessionsKey = "sessions";
+
+// Lists the sessions of a user, the current one if userId is empty. Only
+// admins can list the sessions of others.
+export function useListSessions(userId = "") {
+  const { api } = useContext(CoreClientContext);
+
+  return useQuery<ListSessionsResponse, RequestError>(
+    [sessionsKey, userId],
+    () => api.ListSessions({ userId }),
+    { retry: false, refetchOnWindowFocus: false }
+  );
+}
+
+export function useRevokeSessions() {
+  const { api } = useContext(CoreClientContext);
+  const queryClient = useQueryClient();
+
+  return useMutation<RevokeSessionsResponse, RequestError, string>(
+    (userId) => api.RevokeSessions({ userId }),
+    {
+      onSuccess: (res) => {
+        notifySuccess(`Revoked ${res.revoked} sessions`);
+        queryClient.invalidateQueries([sessionsKey]);
+      },
+      onError: (error) => notifyError(error.message),
+    }
+  );
+}
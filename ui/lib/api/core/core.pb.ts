@@ -19,6 +19,215 @@ export type GetInventoryResponse = {
   entries?: Gitops_coreV1Types.InventoryEntry[]
 }
 
+export type GetDriftRequest = {
+  kind?: string
+  name?: string
+  namespace?: string
+  clusterName?: string
+}
+
+export type FieldDrift = {
+  path?: string
+  type?: string
+  desired?: string
+  live?: string
+}
+
+export type ObjectDrift = {
+  groupVersionKind?: Gitops_coreV1Types.GroupVersionKind
+  name?: string
+  namespace?: string
+  status?: string
+  fields?: FieldDrift[]
+  message?: string
+}
+
+export type GetDriftResponse = {
+  objects?: ObjectDrift[]
+  revision?: string
+}
+
+export type GetDependencyGraphRequest = {
+  clusterName?: string
+  namespace?: string
+}
+
+export type DependencyGraphNode = {
+  id?: string
+  kind?: string
+  name?: string
+  namespace?: string
+  clusterName?: string
+  status?: string
+  message?: string
+  suspended?: boolean
+  missing?: boolean
+  blockedBy?: string[]
+}
+
+export type DependencyGraphEdge = {
+  from?: string
+  to?: string
+  type?: string
+}
+
+export type DependencyCycle = {
+  nodeIds?: string[]
+}
+
+export type GetDependencyGraphResponse = {
+  nodes?: DependencyGraphNode[]
+  edges?: DependencyGraphEdge[]
+  cycles?: DependencyCycle[]
+  errors?: ListError[]
+}
+
+export type GetObjectHistoryRequest = {
+  kind?: string
+  name?: string
+  namespace?: string
+  clusterName?: string
+  since?: string
+  until?: string
+  limit?: number
+}
+
+export type HistoryEvent = {
+  type?: string
+  timestamp?: string
+  revision?: string
+  status?: string
+  reason?: string
+  message?: string
+  principal?: string
+}
+
+export type GetObjectHistoryResponse = {
+  events?: HistoryEvent[]
+}
+
+export type Freeze = {
+  name?: string
+  objects?: Gitops_coreV1Types.ObjectRef[]
+  selectors?: ObjectSelector[]
+  start?: string
+  end?: string
+  schedule?: string
+  duration?: string
+  comment?: string
+  createdBy?: string
+  activeUntil?: string
+  nextStart?: string
+}
+
+export type CreateFreezeRequest = {
+  freeze?: Freeze
+}
+
+export type CreateFreezeResponse = {
+  freeze?: Freeze
+  results?: ObjectResult[]
+}
+
+export type ListFreezesRequest = {
+}
+
+export type ListFreezesResponse = {
+  freezes?: Freeze[]
+}
+
+export type DeleteFreezeRequest = {
+  name?: string
+}
+
+export type DeleteFreezeResponse = {
+  results?: ObjectResult[]
+}
+
+export type ListAuditEventsRequest = {
+  principal?: string
+  action?: string
+  clusterName?: string
+  since?: string
+  until?: string
+  limit?: number
+}
+
+export type AuditEvent = {
+  id?: string
+  timestamp?: string
+  principal?: string
+  groups?: string[]
+  action?: string
+  clusterName?: string
+  objects?: Gitops_coreV1Types.ObjectRef[]
+  outcome?: string
+  message?: string
+  sourceIP?: string
+}
+
+export type ListAuditEventsResponse = {
+  events?: AuditEvent[]
+}
+
+export type AccessToken = {
+  id?: string
+  name?: string
+  createdAt?: string
+  expiresAt?: string
+  readOnly?: boolean
+  clusters?: string[]
+}
+
+export type CreateAccessTokenRequest = {
+  name?: string
+  expiresIn?: string
+  readOnly?: boolean
+  clusters?: string[]
+}
+
+export type CreateAccessTokenResponse = {
+  accessToken?: AccessToken
+  token?: string
+}
+
+export type ListAccessTokensRequest = {
+}
+
+export type ListAccessTokensResponse = {
+  accessTokens?: AccessToken[]
+}
+
+export type DeleteAccessTokenRequest = {
+  id?: string
+}
+
+export type DeleteAccessTokenResponse = {
+}
+
+export type Session = {
+  id?: string
+  userId?: string
+  createdAt?: string
+  tokenExpiry?: string
+}
+
+export type ListSessionsRequest = {
+  userId?: string
+}
+
+export type ListSessionsResponse = {
+  sessions?: Session[]
+}
+
+export type RevokeSessionsRequest = {
+  userId?: string
+}
+
+export type RevokeSessionsResponse = {
+  revoked?: number
+}
+
 export type PolicyValidation = {
   id?: string
   message?: string
@@ -82,6 +291,51 @@ export type PolicyParamRepeatedString = {
   value?: string[]
 }
 
+export type GetPolicyValidationsSummaryRequest = {
+  clusterName?: string
+  validationType?: string
+  since?: string
+  until?: string
+  window?: string
+  groupBy?: string[]
+}
+
+export type PolicyValidationsWindow = {
+  start?: string
+  end?: string
+  count?: number
+}
+
+export type PolicyValidationsGroup = {
+  value?: string
+  name?: string
+  total?: number
+  windows?: PolicyValidationsWindow[]
+}
+
+export type PolicyValidationsGrouping = {
+  dimension?: string
+  groups?: PolicyValidationsGroup[]
+}
+
+export type ApplicationCompliance = {
+  clusterName?: string
+  kind?: string
+  namespace?: string
+  name?: string
+  score?: number
+  violations?: number
+  violatedPolicies?: string[]
+}
+
+export type GetPolicyValidationsSummaryResponse = {
+  total?: number
+  windows?: PolicyValidationsWindow[]
+  groupings?: PolicyValidationsGrouping[]
+  applications?: ApplicationCompliance[]
+  errors?: ListError[]
+}
+
 export type Pagination = {
   pageSize?: number
   pageToken?: string
@@ -147,6 +401,16 @@ export type ListObjectsRequest = {
   kind?: string
   clusterName?: string
   labels?: {[key: string]: string}
+  pagination?: Pagination
+  sortBy?: string
+  sortDescending?: boolean
+  filters?: ListObjectsFilters
+}
+
+export type ListObjectsFilters = {
+  ready?: string
+  suspended?: string
+  sourceRef?: Gitops_coreV1Types.ObjectRef
 }
 
 export type ClusterNamespaceList = {
@@ -158,6 +422,21 @@ export type ListObjectsResponse = {
   objects?: Gitops_coreV1Types.Object[]
   errors?: ListError[]
   searchedNamespaces?: ClusterNamespaceList[]
+  nextPageToken?: string
+  total?: number
+}
+
+export type WatchObjectsRequest = {
+  namespace?: string
+  kind?: string
+  clusterName?: string
+  labels?: {[key: string]: string}
+}
+
+export type WatchObjectsResponse = {
+  type?: string
+  object?: Gitops_coreV1Types.Object
+  error?: ListError
 }
 
 export type GetReconciledObjectsRequest = {
@@ -197,6 +476,50 @@ export type ListNamespacesResponse = {
   namespaces?: Gitops_coreV1Types.Namespace[]
 }
 
+export type ListClustersRequest = {
+}
+
+export type ClusterStatus = {
+  name?: string
+  host?: string
+  status?: string
+  message?: string
+  latency?: string
+  kubernetesVersion?: string
+  fluxInstalled?: boolean
+  fluxNamespace?: string
+  fluxVersion?: string
+  lastProbed?: string
+  lastSeen?: string
+}
+
+export type ListClustersResponse = {
+  clusters?: ClusterStatus[]
+}
+
+export type GetUserPermissionsRequest = {
+  clusterName?: string
+  namespace?: string
+}
+
+export type KindPermissions = {
+  kind?: string
+  list?: boolean
+  get?: boolean
+  patch?: boolean
+}
+
+export type NamespacePermissions = {
+  clusterName?: string
+  namespace?: string
+  kinds?: KindPermissions[]
+}
+
+export type GetUserPermissionsResponse = {
+  permissions?: NamespacePermissions[]
+  errors?: ListError[]
+}
+
 export type ListEventsRequest = {
   involvedObject?: Gitops_coreV1Types.ObjectRef
 }
@@ -208,9 +531,27 @@ export type ListEventsResponse = {
 export type SyncFluxObjectRequest = {
   objects?: Gitops_coreV1Types.ObjectRef[]
   withSource?: boolean
+  wait?: boolean
+  timeout?: string
+  selectors?: ObjectSelector[]
+}
+
+export type SyncResult = {
+  object?: Gitops_coreV1Types.ObjectRef
+  status?: string
+  revision?: string
+  message?: string
 }
 
 export type SyncFluxObjectResponse = {
+  results?: SyncResult[]
+}
+
+export type SyncFluxObjectProgress = {
+  object?: Gitops_coreV1Types.ObjectRef
+  stage?: string
+  revision?: string
+  message?: string
 }
 
 export type GetVersionRequest = {
@@ -235,9 +576,25 @@ export type ToggleSuspendResourceRequest = {
   objects?: Gitops_coreV1Types.ObjectRef[]
   suspend?: boolean
   comment?: string
+  selectors?: ObjectSelector[]
+  until?: string
 }
 
 export type ToggleSuspendResourceResponse = {
+  results?: ObjectResult[]
+}
+
+export type ObjectSelector = {
+  kind?: string
+  clusterName?: string
+  namespace?: string
+  labels?: {[key: string]: string}
+}
+
+export type ObjectResult = {
+  object?: Gitops_coreV1Types.ObjectRef
+  status?: string
+  message?: string
 }
 
 export type GetSessionLogsRequest = {
@@ -341,6 +698,9 @@ export class Core {
   static ListObjects(req: ListObjectsRequest, initReq?: fm.InitReq): Promise<ListObjectsResponse> {
     return fm.fetchReq<ListObjectsRequest, ListObjectsResponse>(`/v1/objects`, {...initReq, method: "POST", body: JSON.stringify(req)})
   }
+  static WatchObjects(req: WatchObjectsRequest, entityNotifier?: fm.NotifyStreamEntityArrival<WatchObjectsResponse>, initReq?: fm.InitReq): Promise<void> {
+    return fm.fetchStreamingRequest<WatchObjectsRequest, WatchObjectsResponse>(`/v1/objects/watch?${fm.renderURLSearchParams(req, [])}`, entityNotifier, {...initReq, method: "GET"})
+  }
   static ListFluxRuntimeObjects(req: ListFluxRuntimeObjectsRequest, initReq?: fm.InitReq): Promise<ListFluxRuntimeObjectsResponse> {
     return fm.fetchReq<ListFluxRuntimeObjectsRequest, ListFluxRuntimeObjectsResponse>(`/v1/flux_runtime_objects?${fm.renderURLSearchParams(req, [])}`, {...initReq, method: "GET"})
   }
@@ -365,12 +725,21 @@ export class Core {
   static ListNamespaces(req: ListNamespacesRequest, initReq?: fm.InitReq): Promise<ListNamespacesResponse> {
     return fm.fetchReq<ListNamespacesRequest, ListNamespacesResponse>(`/v1/namespaces?${fm.renderURLSearchParams(req, [])}`, {...initReq, method: "GET"})
   }
+  static ListClusters(req: ListClustersRequest, initReq?: fm.InitReq): Promise<ListClustersResponse> {
+    return fm.fetchReq<ListClustersRequest, ListClustersResponse>(`/v1/clusters?${fm.renderURLSearchParams(req, [])}`, {...initReq, method: "GET"})
+  }
+  static GetUserPermissions(req: GetUserPermissionsRequest, initReq?: fm.InitReq): Promise<GetUserPermissionsResponse> {
+    return fm.fetchReq<GetUserPermissionsRequest, GetUserPermissionsResponse>(`/v1/user-permissions?${fm.renderURLSearchParams(req, [])}`, {...initReq, method: "GET"})
+  }
   static ListEvents(req: ListEventsRequest, initReq?: fm.InitReq): Promise<ListEventsResponse> {
     return fm.fetchReq<ListEventsRequest, ListEventsResponse>(`/v1/events?${fm.renderURLSearchParams(req, [])}`, {...initReq, method: "GET"})
   }
   static SyncFluxObject(req: SyncFluxObjectRequest, initReq?: fm.InitReq): Promise<SyncFluxObjectResponse> {
     return fm.fetchReq<SyncFluxObjectRequest, SyncFluxObjectResponse>(`/v1/sync`, {...initReq, method: "POST", body: JSON.stringify(req)})
   }
+  static SyncFluxObjectStream(req: SyncFluxObjectRequest, entityNotifier?: fm.NotifyStreamEntityArrival<SyncFluxObjectProgress>, initReq?: fm.InitReq): Promise<void> {
+    return fm.fetchStreamingRequest<SyncFluxObjectRequest, SyncFluxObjectProgress>(`/v1/sync/stream`, entityNotifier, {...initReq, method: "POST", body: JSON.stringify(req)})
+  }
   static GetVersion(req: GetVersionRequest, initReq?: fm.InitReq): Promise<GetVersionResponse> {
     return fm.fetchReq<GetVersionRequest, GetVersionResponse>(`/v1/version?${fm.renderURLSearchParams(req, [])}`, {...initReq, method: "GET"})
   }
@@ -389,6 +758,42 @@ export class Core {
   static GetInventory(req: GetInventoryRequest, initReq?: fm.InitReq): Promise<GetInventoryResponse> {
     return fm.fetchReq<GetInventoryRequest, GetInventoryResponse>(`/v1/inventory?${fm.renderURLSearchParams(req, [])}`, {...initReq, method: "GET"})
   }
+  static GetDrift(req: GetDriftRequest, initReq?: fm.InitReq): Promise<GetDriftResponse> {
+    return fm.fetchReq<GetDriftRequest, GetDriftResponse>(`/v1/drift?${fm.renderURLSearchParams(req, [])}`, {...initReq, method: "GET"})
+  }
+  static GetDependencyGraph(req: GetDependencyGraphRequest, initReq?: fm.InitReq): Promise<GetDependencyGraphResponse> {
+    return fm.fetchReq<GetDependencyGraphRequest, GetDependencyGraphResponse>(`/v1/dependency_graph?${fm.renderURLSearchParams(req, [])}`, {...initReq, method: "GET"})
+  }
+  static GetObjectHistory(req: GetObjectHistoryRequest, initReq?: fm.InitReq): Promise<GetObjectHistoryResponse> {
+    return fm.fetchReq<GetObjectHistoryRequest, GetObjectHistoryResponse>(`/v1/object_history?${fm.renderURLSearchParams(req, [])}`, {...initReq, method: "GET"})
+  }
+  static CreateFreeze(req: CreateFreezeRequest, initReq?: fm.InitReq): Promise<CreateFreezeResponse> {
+    return fm.fetchReq<CreateFreezeRequest, CreateFreezeResponse>(`/v1/freezes`, {...initReq, method: "POST", body: JSON.stringify(req)})
+  }
+  static ListFreezes(req: ListFreezesRequest, initReq?: fm.InitReq): Promise<ListFreezesResponse> {
+    return fm.fetchReq<ListFreezesRequest, ListFreezesResponse>(`/v1/freezes?${fm.renderURLSearchParams(req, [])}`, {...initReq, method: "GET"})
+  }
+  static DeleteFreeze(req: DeleteFreezeRequest, initReq?: fm.InitReq): Promise<DeleteFreezeResponse> {
+    return fm.fetchReq<DeleteFreezeRequest, DeleteFreezeResponse>(`/v1/freezes/${req["name"]}`, {...initReq, method: "DELETE"})
+  }
+  static ListAuditEvents(req: ListAuditEventsRequest, initReq?: fm.InitReq): Promise<ListAuditEventsResponse> {
+    return fm.fetchReq<ListAuditEventsRequest, ListAuditEventsResponse>(`/v1/audit_events?${fm.renderURLSearchParams(req, [])}`, {...initReq, method: "GET"})
+  }
+  static CreateAccessToken(req: CreateAccessTokenRequest, initReq?: fm.InitReq): Promise<CreateAccessTokenResponse> {
+    return fm.fetchReq<CreateAccessTokenRequest, CreateAccessTokenResponse>(`/v1/access_tokens`, {...initReq, method: "POST", body: JSON.stringify(req)})
+  }
+  static ListAccessTokens(req: ListAccessTokensRequest, initReq?: fm.InitReq): Promise<ListAccessTokensResponse> {
+    return fm.fetchReq<ListAccessTokensRequest, ListAccessTokensResponse>(`/v1/access_tokens?${fm.renderURLSearchParams(req, [])}`, {...initReq, method: "GET"})
+  }
+  static DeleteAccessToken(req: DeleteAccessTokenRequest, initReq?: fm.InitReq): Promise<DeleteAccessTokenResponse> {
+    return fm.fetchReq<DeleteAccessTokenRequest, DeleteAccessTokenResponse>(`/v1/access_tokens/${req["id"]}`, {...initReq, method: "DELETE"})
+  }
+  static ListSessions(req: ListSessionsRequest, initReq?: fm.InitReq): Promise<ListSessionsResponse> {
+    return fm.fetchReq<ListSessionsRequest, ListSessionsResponse>(`/v1/sessions?${fm.renderURLSearchParams(req, [])}`, {...initReq, method: "GET"})
+  }
+  static RevokeSessions(req: RevokeSessionsRequest, initReq?: fm.InitReq): Promise<RevokeSessionsResponse> {
+    return fm.fetchReq<RevokeSessionsRequest, RevokeSessionsResponse>(`/v1/sessions`, {...initReq, method: "DELETE"})
+  }
   static ListPolicies(req: ListPoliciesRequest, initReq?: fm.InitReq): Promise<ListPoliciesResponse> {
     return fm.fetchReq<ListPoliciesRequest, ListPoliciesResponse>(`/v1/policies?${fm.renderURLSearchParams(req, [])}`, {...initReq, method: "GET"})
   }
@@ -401,4 +806,7 @@ export class Core {
   static GetPolicyValidation(req: GetPolicyValidationRequest, initReq?: fm.InitReq): Promise<GetPolicyValidationResponse> {
     return fm.fetchReq<GetPolicyValidationRequest, GetPolicyValidationResponse>(`/v1/policyvalidations/${req["validationId"]}?${fm.renderURLSearchParams(req, ["validationId"])}`, {...initReq, method: "GET"})
   }
+  static GetPolicyValidationsSummary(req: GetPolicyValidationsSummaryRequest, initReq?: fm.InitReq): Promise<GetPolicyValidationsSummaryResponse> {
+    return fm.fetchReq<GetPolicyValidationsSummaryRequest, GetPolicyValidationsSummaryResponse>(`/v1/policyvalidations_summary?${fm.renderURLSearchParams(req, [])}`, {...initReq, method: "GET"})
+  }
 }
\ No newline at end of file
@@ -10,6 +10,7 @@ import Flex from "../components/Flex";
 import LoadingPage from "../components/LoadingPage";
 import { Auth } from "../contexts/AuthContext";
 import { useFeatureFlags } from "../hooks/featureflags";
+import { useOIDCProviders } from "../hooks/oidcProviders";
 import { useInDarkMode } from "../hooks/theme";
 import images from "../lib/images";
 import { withBasePath } from "../lib/utils";
@@ -91,8 +92,9 @@ function SignIn({ darkModeEnabled = true }: Props) {
   const [password, setPassword] = React.useState<string>("");
   const [username, setUsername] = React.useState<string>("");
   const [showPassword, setShowPassword] = React.useState<boolean>(false);
+  const { data: providers } = useOIDCProviders(isFlagEnabled("OIDC_AUTH"));
 
-  const handleOIDCSubmit = () => {
+  const handleOIDCSubmit = (provider?: string) => {
     const redirect = qs.parse(window.location.search).redirect || "";
 
     // Head to the BE to start the OIDC flow so we do not use any of
@@ -103,6 +105,7 @@ function SignIn({ darkModeEnabled = true }: Props) {
         // BE handles the redirect to return_url after authentication
         // so add the base path
         return_url: window.origin + withBasePath(redirect),
+        provider,
       }));
   };
 
@@ -164,16 +167,33 @@ function SignIn({ darkModeEnabled = true }: Props) {
             //extra padding-bottom for when both auth flags are enabled
             clusterAuth={isFlagEnabled("CLUSTER_USER_AUTH")}
           >
-            <Button
-              type="submit"
-              onClick={(e) => {
-                e.preventDefault();
-                handleOIDCSubmit();
-              }}
-            >
-              {flags.WEAVE_GITOPS_FEATURE_OIDC_BUTTON_LABEL ||
-                "LOGIN WITH OIDC PROVIDER"}
-            </Button>
+            {providers?.length > 1 ? (
+              <Flex column gap="8">
+                {providers.map((provider) => (
+                  <Button
+                    key={provider.name}
+                    type="submit"
+                    onClick={(e) => {
+                      e.preventDefault();
+                      handleOIDCSubmit(provider.name);
+                    }}
+                  >
+                    LOGIN WITH {provider.displayName}
+                  </Button>
+                ))}
+              </Flex>
+            ) : (
+              <Button
+                type="submit"
+                onClick={(e) => {
+                  e.preventDefault();
+                  handleOIDCSubmit();
+                }}
+              >
+                {flags.WEAVE_GITOPS_FEATURE_OIDC_BUTTON_LABEL ||
+                  "LOGIN WITH OIDC PROVIDER"}
+              </Button>
+            )}
           </OidcFlex>
         ) : null}
         {isFlagEnabled("CLUSTER_USER_AUTH") ? (
@@ -100,4 +100,41 @@ describe("SignIn", () => {
     expect(screen.queryByText(defaultButtonLabel)).toBeNull();
     expect(screen.queryByText(customLabel)).toBeTruthy();
   });
+
+  describe("with several OIDC providers", () => {
+    const fetch = window.fetch;
+
+    beforeEach(() => {
+      window.fetch = jest.fn().mockResolvedValue({
+        ok: true,
+        json: () =>
+          Promise.resolve([
+            { name: "oidc", displayName: "Dex" },
+            { name: "corp", displayName: "Azure AD" },
+          ]),
+      });
+    });
+
+    afterEach(() => {
+      window.fetch = fetch;
+    });
+
+    it("should show a button per provider", async () => {
+      renderSignIn({ OIDC_AUTH: "true" });
+
+      expect(await screen.findByText("LOGIN WITH Dex")).toBeTruthy();
+      expect(screen.queryByText("LOGIN WITH Azure AD")).toBeTruthy();
+      expect(screen.queryByText(defaultButtonLabel)).toBeNull();
+      expect(window.fetch).toHaveBeenCalledWith("/oauth2/providers", undefined);
+    });
+
+    it("should redirect to the oauth2 endpoint of the provider", async () => {
+      renderSignIn({ OIDC_AUTH: "true" });
+
+      fireEvent.click(await screen.findByText("LOGIN WITH Azure AD"));
+      expect(window.location.href).toEqual(
+        "/oauth2?provider=corp&return_url=http%3A%2F%2Flocalhost"
+      );
+    });
+  });
 });